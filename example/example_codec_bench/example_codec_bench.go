@@ -0,0 +1,115 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/goodwood511/ripple_lib/ripple-sdk/data"
+	"github.com/goodwood511/ripple_lib/ripple-sdk/websockets"
+	"github.com/sirupsen/logrus"
+)
+
+// Compares the generated codec with the reflection based one on the
+// transactions of real ledgers
+func main() {
+	remote, err := websockets.NewRemote("wss://s1.ripple.com:51233")
+	if err != nil {
+		logrus.Warnln(err)
+		return
+	}
+	defer remote.Close()
+
+	latest, err := remote.LedgerHeader("validated")
+	if err != nil {
+		logrus.Warnln(err)
+		return
+	}
+
+	var txs []*data.TransactionWithMetaData
+	for seq := latest.Ledger.LedgerSequence - 9; seq <= latest.Ledger.LedgerSequence; seq++ {
+		ledger, err := remote.Ledger(seq, true)
+		if err != nil {
+			logrus.Warnln(err)
+			return
+		}
+		txs = append(txs, ledger.Ledger.Transactions...)
+	}
+	logrus.Infoln("Transactions:", len(txs))
+
+	raws := make([][]byte, len(txs))
+	for i, tx := range txs {
+		data.GeneratedCodec = false
+		id, want, err := data.Raw(tx.Transaction)
+		if err != nil {
+			logrus.Warnln(tx.GetHash(), err)
+			return
+		}
+		data.GeneratedCodec = true
+		generatedId, got, err := data.Raw(tx.Transaction)
+		if err != nil {
+			logrus.Warnln(tx.GetHash(), err)
+			return
+		}
+		if !bytes.Equal(got, want) || generatedId != id || id != *tx.GetHash() {
+			logrus.Warnln("Different bytes for", tx.GetHash())
+			return
+		}
+		raws[i] = want
+	}
+
+	benchmarks := []struct {
+		name string
+		f    func() error
+	}{
+		{"Raw", func() error {
+			for _, tx := range txs {
+				if _, _, err := data.Raw(tx.Transaction); err != nil {
+					return err
+				}
+			}
+			return nil
+		}},
+		{"SigningHash", func() error {
+			for _, tx := range txs {
+				if _, _, err := data.SigningHash(tx.Transaction); err != nil {
+					return err
+				}
+			}
+			return nil
+		}},
+		{"NodeId", func() error {
+			for _, tx := range txs {
+				if _, err := data.NodeId(tx); err != nil {
+					return err
+				}
+			}
+			return nil
+		}},
+		{"ReadTransaction", func() error {
+			for _, raw := range raws {
+				if _, err := data.ReadTransaction(bytes.NewReader(raw)); err != nil {
+					return err
+				}
+			}
+			return nil
+		}},
+	}
+
+	for _, bm := range benchmarks {
+		for _, generated := range []bool{false, true} {
+			data.GeneratedCodec = generated
+			var err error
+			result := testing.Benchmark(func(b *testing.B) {
+				b.ReportAllocs()
+				for i := 0; i < b.N && err == nil; i++ {
+					err = bm.f()
+				}
+			})
+			if err != nil {
+				logrus.Warnln(bm.name, err)
+				return
+			}
+			logrus.Infoln(bm.name, "generated:", generated, result, result.MemString())
+		}
+	}
+}
@@ -1,8 +1,6 @@
 module github.com/goodwood511/ripple_lib
 
-go 1.23.0
-
-toolchain go1.24.0
+go 1.24.0
 
 require (
 	github.com/bits-and-blooms/bitset v1.22.0
@@ -45,6 +45,10 @@ func Base58Decode(b, alphabet string) ([]byte, error) {
 	val := make([]byte, flen, flen)
 	copy(val[numZeros:], tmpval)
 
+	if len(val) < 4 {
+		return nil, fmt.Errorf("Base58 string too short: %s", b)
+	}
+
 	// Check checksum
 	checksum := DoubleSha256(val[0 : len(val)-4])
 	expected := val[len(val)-4:]
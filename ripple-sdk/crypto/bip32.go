@@ -0,0 +1,135 @@
+package crypto
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+)
+
+// HardenedKeyStart is added to the index of a hardened BIP32 child, which
+// is written with a ' in a path
+const HardenedKeyStart uint32 = 0x80000000
+
+// RippleCoinType is the BIP44 coin type of XRP
+const RippleCoinType = 144
+
+// RippleBIP44Path is the path m/44'/144'/account'/0/index, which wallets
+// such as Ledger and Xumm derive XRP accounts with
+func RippleBIP44Path(account, index uint32) string {
+	return fmt.Sprintf("m/44'/%d'/%d'/0/%d", RippleCoinType, account, index)
+}
+
+// ExtendedKey is a BIP32 secp256k1 private key with its chain code
+type ExtendedKey struct {
+	privKey   *btcec.PrivateKey
+	chainCode []byte
+	depth     uint8
+	index     uint32
+}
+
+// NewMasterKey returns the BIP32 master key of a seed, such as that of
+// MnemonicToSeed
+func NewMasterKey(seed []byte) (*ExtendedKey, error) {
+	if len(seed) < 16 || len(seed) > 64 {
+		return nil, fmt.Errorf("Seed must be 16 to 64 bytes, got: %d", len(seed))
+	}
+	mac := hmac.New(sha512.New, []byte("Bitcoin seed"))
+	mac.Write(seed)
+	sum := mac.Sum(nil)
+	var k btcec.ModNScalar
+	if overflow := k.SetByteSlice(sum[:32]); overflow || k.IsZero() {
+		return nil, fmt.Errorf("Seed gives an invalid master key")
+	}
+	return &ExtendedKey{
+		privKey:   btcec.PrivKeyFromScalar(&k),
+		chainCode: sum[32:],
+	}, nil
+}
+
+// Child derives the child with the given index, which is hardened when it
+// is at least HardenedKeyStart
+func (k *ExtendedKey) Child(index uint32) (*ExtendedKey, error) {
+	data := make([]byte, 0, btcec.PubKeyBytesLenCompressed+4)
+	if index >= HardenedKeyStart {
+		data = append(data, 0)
+		data = append(data, k.privKey.Serialize()...)
+	} else {
+		data = append(data, k.privKey.PubKey().SerializeCompressed()...)
+	}
+	data = binary.BigEndian.AppendUint32(data, index)
+
+	mac := hmac.New(sha512.New, k.chainCode)
+	mac.Write(data)
+	sum := mac.Sum(nil)
+
+	var child btcec.ModNScalar
+	if overflow := child.SetByteSlice(sum[:32]); overflow {
+		return nil, fmt.Errorf("Invalid child key at index %d, use the next", index)
+	}
+	child.Add(&k.privKey.Key)
+	if child.IsZero() {
+		return nil, fmt.Errorf("Invalid child key at index %d, use the next", index)
+	}
+	return &ExtendedKey{
+		privKey:   btcec.PrivKeyFromScalar(&child),
+		chainCode: sum[32:],
+		depth:     k.depth + 1,
+		index:     index,
+	}, nil
+}
+
+// DerivePath derives the key at a path such as m/44'/144'/0'/0/0 from a
+// master key. h may be used in place of '.
+func (k *ExtendedKey) DerivePath(path string) (*ExtendedKey, error) {
+	parts := strings.Split(path, "/")
+	if parts[0] != "m" {
+		return nil, fmt.Errorf("Bad path: %s", path)
+	}
+	if k.depth != 0 {
+		return nil, fmt.Errorf("Path %s must be derived from a master key", path)
+	}
+	key := k
+	for _, part := range parts[1:] {
+		var hardened bool
+		if strings.HasSuffix(part, "'") || strings.HasSuffix(part, "h") {
+			part, hardened = part[:len(part)-1], true
+		}
+		index, err := strconv.ParseUint(part, 10, 32)
+		if err != nil || uint32(index) >= HardenedKeyStart {
+			return nil, fmt.Errorf("Bad path: %s", path)
+		}
+		if hardened {
+			index += uint64(HardenedKeyStart)
+		}
+		if key, err = key.Child(uint32(index)); err != nil {
+			return nil, err
+		}
+	}
+	return key, nil
+}
+
+// Depth is the number of derivations from the master key
+func (k *ExtendedKey) Depth() uint8 {
+	return k.depth
+}
+
+// Index is the index of the key in its parent
+func (k *ExtendedKey) Index() uint32 {
+	return k.index
+}
+
+// ChainCode is the BIP32 chain code of the key
+func (k *ExtendedKey) ChainCode() []byte {
+	return append([]byte(nil), k.chainCode...)
+}
+
+// Key is the secp256k1 Key, which is the account's key itself rather than
+// a family generator, so it must be used with a nil sequence
+func (k *ExtendedKey) Key() Key {
+	return &ecdsaKey{k.privKey}
+}
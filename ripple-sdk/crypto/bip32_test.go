@@ -0,0 +1,52 @@
+package crypto
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+// BIP32 test vector 1
+func TestBIP32Vector1(t *testing.T) {
+	seed, _ := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	master, err := NewMasterKey(seed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range []struct {
+		path, chainCode, private string
+	}{
+		{"m", "873dff81c02f525623fd1fe5167eac3a55a049de3d314bb42ee227ffed37d508", "e8f32e723decf4051aefac8e2c93c9c5b214313817cdb01a1494b917c8436b35"},
+		{"m/0'", "47fdacbd0f1097043b78c63c20c34ef4ed9a111d980047ad16282c7ae6236141", "edb2e14f9ee77d26dd93b4ecede8d16ed408ce149b6cd80b0715a2d911a0afea"},
+		{"m/0'/1", "2a7857631386ba23dacac34180dd1983734e444fdbf774041578e9b6adb37c19", "3c6cb8d0f6a264c91ea8b5030fadaa8e538b020f0a387421a12de9319dc93368"},
+		{"m/0h/1/2h", "04466b9cc8e161e966409ca52986c584f07e9dc81f735db683c3ff6ec7b1503f", "cbce0d719ecf7431d88e6a89fa1483e02e35092af60c042b1df2ff59fa424dca"},
+		{"m/0'/1/2'/2", "cfb71883f01676f587d023cc53a35bc7f88f724b1f8c2892ac1275ac822a3edd", "0f479245fb19a38a1954c5c7c0ebab2f9bdfd96a17563ef28a6a4b1a2a764ef4"},
+		{"m/0'/1/2'/2/1000000000", "c783e67b921d2beb8f6b389cc646d7263b4145701dadd2161548a8b078e65e9e", "471b76e389e528d6de6d816857e012c5455051cad6660850e58372a6c3e6e7c8"},
+	} {
+		key, err := master.DerivePath(test.path)
+		if err != nil {
+			t.Fatalf("%s: %v", test.path, err)
+		}
+		if chainCode := hex.EncodeToString(key.ChainCode()); chainCode != test.chainCode {
+			t.Errorf("%s: chain code is %s, want %s", test.path, chainCode, test.chainCode)
+		}
+		if private := hex.EncodeToString(key.Key().Private(nil)); private != test.private {
+			t.Errorf("%s: private key is %s, want %s", test.path, private, test.private)
+		}
+	}
+
+	key, err := master.DerivePath("m/0'/1/2'/2/1000000000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if key.Depth() != 5 || key.Index() != 1000000000 {
+		t.Errorf("depth is %d and index %d", key.Depth(), key.Index())
+	}
+	for _, bad := range []string{"", "0/1", "m/x", "m/2147483648", "m//1"} {
+		if _, err := master.DerivePath(bad); err == nil {
+			t.Errorf("%q: expected an error", bad)
+		}
+	}
+	if _, err := key.DerivePath("m/0"); err == nil {
+		t.Error("derived a path from a child key")
+	}
+}
@@ -0,0 +1,123 @@
+package crypto
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	_ "embed"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+// BIP39 mnemonics encode 128 to 256 bits of entropy, with a checksum of a
+// bit for every 32 bits, as a word for every 11 bits
+
+// The English wordlist of BIP39
+//
+//go:embed bip39_english.txt
+var bip39English string
+
+var (
+	bip39Words   = strings.Fields(bip39English)
+	bip39Indexes = make(map[string]int, len(bip39Words))
+)
+
+func init() {
+	if len(bip39Words) != 2048 {
+		panic(fmt.Sprintf("Bad BIP39 wordlist: %d words", len(bip39Words)))
+	}
+	for i, word := range bip39Words {
+		bip39Indexes[word] = i
+	}
+}
+
+func checkEntropyBits(bits int) error {
+	if bits < 128 || bits > 256 || bits%32 != 0 {
+		return fmt.Errorf("Entropy must be 128 to 256 bits in steps of 32, got: %d", bits)
+	}
+	return nil
+}
+
+// NewEntropy returns random entropy of the given number of bits for a
+// mnemonic, 128 for 12 words up to 256 for 24 words
+func NewEntropy(bits int) ([]byte, error) {
+	if err := checkEntropyBits(bits); err != nil {
+		return nil, err
+	}
+	entropy := make([]byte, bits/8)
+	if _, err := rand.Read(entropy); err != nil {
+		return nil, err
+	}
+	return entropy, nil
+}
+
+// NewMnemonic returns the BIP39 mnemonic of entropy
+func NewMnemonic(entropy []byte) (string, error) {
+	bits := len(entropy) * 8
+	if err := checkEntropyBits(bits); err != nil {
+		return "", err
+	}
+	checksum := sha256.Sum256(entropy)
+	b := append(append([]byte(nil), entropy...), checksum[0])
+	words := make([]string, (bits+bits/32)/11)
+	for i := range words {
+		var index int
+		for j := i * 11; j < (i+1)*11; j++ {
+			index = index<<1 | int(b[j/8]>>(7-uint(j%8))&1)
+		}
+		words[i] = bip39Words[index]
+	}
+	return strings.Join(words, " "), nil
+}
+
+// MnemonicToEntropy checks the words and checksum of a BIP39 mnemonic,
+// returning its entropy
+func MnemonicToEntropy(mnemonic string) ([]byte, error) {
+	words := strings.Fields(mnemonic)
+	switch len(words) {
+	case 12, 15, 18, 21, 24:
+	default:
+		return nil, fmt.Errorf("Mnemonic must have 12, 15, 18, 21 or 24 words, got: %d", len(words))
+	}
+	b := make([]byte, (len(words)*11+7)/8)
+	for i, word := range words {
+		index, ok := bip39Indexes[word]
+		if !ok {
+			return nil, fmt.Errorf("Unknown mnemonic word: %s", word)
+		}
+		for j := 0; j < 11; j++ {
+			if index&(1<<uint(10-j)) != 0 {
+				k := i*11 + j
+				b[k/8] |= 1 << (7 - uint(k%8))
+			}
+		}
+	}
+	bits := len(words) * 11 * 32 / 33
+	entropy := b[:bits/8]
+	checksum := sha256.Sum256(entropy)
+	mask := byte(0xFF) << uint(8-bits/32)
+	if b[bits/8]&mask != checksum[0]&mask {
+		return nil, fmt.Errorf("Bad mnemonic checksum")
+	}
+	return entropy, nil
+}
+
+// IsMnemonicValid is whether mnemonic is a BIP39 mnemonic with a good
+// checksum
+func IsMnemonicValid(mnemonic string) bool {
+	_, err := MnemonicToEntropy(mnemonic)
+	return err == nil
+}
+
+// MnemonicToSeed returns the 64 byte BIP39 seed of a mnemonic and
+// passphrase, which may be empty. The passphrase is used as given, so one
+// with characters outside ASCII must already be in Unicode NFKD form.
+func MnemonicToSeed(mnemonic, passphrase string) ([]byte, error) {
+	if _, err := MnemonicToEntropy(mnemonic); err != nil {
+		return nil, err
+	}
+	normalized := strings.Join(strings.Fields(mnemonic), " ")
+	return pbkdf2.Key([]byte(normalized), []byte("mnemonic"+passphrase), 2048, 64, sha512.New), nil
+}
@@ -18,6 +18,7 @@ const (
 	RIPPLE_FAMILY_SEED     HashVersion = 33
 	RIPPLE_ACCOUNT_PRIVATE HashVersion = 34
 	RIPPLE_ACCOUNT_PUBLIC  HashVersion = 35
+	RIPPLE_SECRET_SHARE    HashVersion = 55
 )
 
 var hashTypes = [...]struct {
@@ -30,6 +31,7 @@ var hashTypes = [...]struct {
 	RIPPLE_NODE_PUBLIC:     {"Validation public key for node.", 'n', 33, 53},
 	RIPPLE_NODE_PRIVATE:    {"Validation private key for node.", 'p', 32, 52},
 	RIPPLE_FAMILY_SEED:     {"Family seed.", 's', 16, 29},
-	RIPPLE_ACCOUNT_PRIVATE: {"Account private key.", 'p', 32, 52},
+	RIPPLE_ACCOUNT_PRIVATE: {"Account private key.", 'p', 33, 53}, // 33 for Ed25519
 	RIPPLE_ACCOUNT_PUBLIC:  {"Account public key.", 'a', 33, 53},
+	RIPPLE_SECRET_SHARE:    {"Share of a split seed or private key.", 's', 41, 63}, // 's' for seeds
 }
@@ -0,0 +1,39 @@
+package crypto
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestECDSASerialize(t *testing.T) {
+	seed, err := NewRippleHashCheck("snoPBrXtMeMyMHUVTgbuqAfg1SUTb", RIPPLE_FAMILY_SEED)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := NewECDSAKey(seed.Payload())
+	if err != nil {
+		t.Fatal(err)
+	}
+	priv := key.Serialize()
+	if len(priv) != 32 || !bytes.Equal(priv, key.Private(nil)) {
+		t.Fatalf("Serialize is %X, the root private key is %X", priv, key.Private(nil))
+	}
+
+	// The serialized key is the family's root key, from which the
+	// account's key is still derived
+	restored, err := NewKeyFromPrivate(priv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(restored.Public(nil), key.Public(nil)) {
+		t.Errorf("restored public key is %X, want %X", restored.Public(nil), key.Public(nil))
+	}
+	var sequence uint32
+	account, err := NewAccountId(restored.Id(&sequence))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if account.String() != "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh" {
+		t.Errorf("account is %s", account)
+	}
+}
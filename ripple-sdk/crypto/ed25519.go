@@ -32,6 +32,13 @@ func (e *ed25519key) Private(seq *uint32) []byte {
 	return e.priv[:]
 }
 
+// Ed25519 account private keys are exported as their 32 byte seed
+// prefixed with 0xED, as rippled does, to tell them apart from
+// secp256k1 keys
+func (e *ed25519key) export() []byte {
+	return append([]byte{0xED}, e.priv[:ed25519.SeedSize]...)
+}
+
 func NewEd25519Key(seed []byte) (*ed25519key, error) {
 	r := rand.Reader
 	if seed != nil {
@@ -29,13 +29,20 @@ func NewRippleHashCheck(s string, version HashVersion) (Hash, error) {
 		return nil, err
 	}
 	if hash.Version() != version {
-		want := hashTypes[version].Description
-		got := hashTypes[hash.Version()].Description
+		want := versionDescription(version)
+		got := versionDescription(hash.Version())
 		return nil, fmt.Errorf("Bad version for: %s expected: %s got: %s ", s, want, got)
 	}
 	return hash, nil
 }
 
+func versionDescription(version HashVersion) string {
+	if int(version) >= len(hashTypes) || hashTypes[version].Description == "" {
+		return fmt.Sprintf("Unknown version %d.", version)
+	}
+	return hashTypes[version].Description
+}
+
 func NewAccountId(b []byte) (Hash, error) {
 	return newHash(b, RIPPLE_ACCOUNT_ID)
 }
@@ -69,6 +76,10 @@ func AccountPublicKey(key Key, sequence *uint32) (Hash, error) {
 }
 
 func AccountPrivateKey(key Key, sequence *uint32) (Hash, error) {
+	if k, ok := key.(*ed25519key); ok {
+		checkSequenceIsNil(sequence)
+		return NewAccountPrivateKey(k.export())
+	}
 	return NewAccountPrivateKey(key.Private(sequence))
 }
 
@@ -97,6 +108,9 @@ func newHashFromString(s string) (Hash, error) {
 	if err != nil {
 		return nil, err
 	}
+	if len(decoded) < 5 {
+		return nil, fmt.Errorf("Hash has no version: %s", s)
+	}
 	return hash(decoded[:len(decoded)-4]), nil
 }
 
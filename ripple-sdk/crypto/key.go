@@ -0,0 +1,52 @@
+package crypto
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"golang.org/x/crypto/ed25519"
+)
+
+// NewKeyFromPrivate returns the Key for a raw account private key, which
+// is 33 bytes prefixed with 0xED for Ed25519 or 32 bytes for secp256k1.
+// The Key must be used with a nil sequence.
+func NewKeyFromPrivate(b []byte) (Key, error) {
+	switch {
+	case len(b) == ed25519.SeedSize+1 && b[0] == 0xED:
+		var key ed25519key
+		copy(key.priv[:], ed25519.NewKeyFromSeed(b[1:]))
+		return &key, nil
+	case len(b) == btcec.PrivKeyBytesLen:
+		privKey, _ := btcec.PrivKeyFromBytes(b)
+		return &ecdsaKey{privKey}, nil
+	default:
+		return nil, fmt.Errorf("Unknown private key format")
+	}
+}
+
+// KeySigner signs with a key which need not be held in memory, such as one
+// in an HSM or a remote signing service. Public returns the 33 byte public
+// key, prefixed with 0xED for Ed25519. Sign is given both the hash, which
+// secp256k1 keys sign, and the full message, which Ed25519 keys sign.
+type KeySigner interface {
+	Public() []byte
+	Sign(hash, msg []byte) ([]byte, error)
+}
+
+type keySigner struct {
+	key      Key
+	sequence *uint32
+}
+
+// NewKeySigner returns a KeySigner for an in-memory key
+func NewKeySigner(key Key, sequence *uint32) KeySigner {
+	return &keySigner{key: key, sequence: sequence}
+}
+
+func (k *keySigner) Public() []byte {
+	return k.key.Public(k.sequence)
+}
+
+func (k *keySigner) Sign(hash, msg []byte) ([]byte, error) {
+	return Sign(k.key.Private(k.sequence), hash, msg)
+}
@@ -0,0 +1,101 @@
+package crypto
+
+import (
+	_ "embed"
+	"encoding/binary"
+	"fmt"
+	"strings"
+)
+
+// RFC1751 writes each 64 bits of a key as six words of 11 bits, the last
+// two bits of which are a parity of the 64. Words of up to three letters
+// are the first 571 of the dictionary and those of four letters the rest.
+
+// The dictionary of RFC1751
+//
+//go:embed rfc1751_words.txt
+var rfc1751Dictionary string
+
+var (
+	rfc1751Words   = strings.Fields(rfc1751Dictionary)
+	rfc1751Indexes = make(map[string]int, len(rfc1751Words))
+)
+
+func init() {
+	if len(rfc1751Words) != 2048 {
+		panic(fmt.Sprintf("Bad RFC1751 dictionary: %d words", len(rfc1751Words)))
+	}
+	for i, word := range rfc1751Words {
+		rfc1751Indexes[word] = i
+	}
+}
+
+func rfc1751Parity(block uint64) uint64 {
+	var parity uint64
+	for i := 0; i < 64; i += 2 {
+		parity += block >> uint(i) & 3
+	}
+	return parity & 3
+}
+
+// KeyToRFC1751 returns the words of a key whose length is a multiple of
+// eight bytes, six words for each eight
+func KeyToRFC1751(key []byte) (string, error) {
+	if len(key) == 0 || len(key)%8 != 0 {
+		return "", fmt.Errorf("Key must be a multiple of 8 bytes, got: %d", len(key))
+	}
+	words := make([]string, 0, len(key)/8*6)
+	for i := 0; i < len(key); i += 8 {
+		block := binary.BigEndian.Uint64(key[i:])
+		parity := rfc1751Parity(block)
+		for j := 0; j < 6; j++ {
+			// bits 11*j to 11*j+10 of the block followed by the parity
+			shift := 66 - 11*(j+1)
+			var index uint64
+			if shift >= 2 {
+				index = block >> uint(shift-2)
+			} else {
+				index = block<<uint(2-shift) | parity>>uint(shift)
+			}
+			words = append(words, rfc1751Words[index&0x7FF])
+		}
+	}
+	return strings.Join(words, " "), nil
+}
+
+// rfc1751Standard is a word in upper case, with the digits which RFC1751
+// reads as letters replaced
+func rfc1751Standard(word string) string {
+	return strings.NewReplacer("1", "L", "0", "O", "5", "S").Replace(strings.ToUpper(word))
+}
+
+// RFC1751ToKey returns the key of words written by KeyToRFC1751, in any
+// case, checking the parity of each six
+func RFC1751ToKey(s string) ([]byte, error) {
+	words := strings.Fields(s)
+	if len(words) == 0 || len(words)%6 != 0 {
+		return nil, fmt.Errorf("RFC1751 key must be a multiple of 6 words, got: %d", len(words))
+	}
+	key := make([]byte, len(words)/6*8)
+	for i := 0; i < len(words); i += 6 {
+		var block, parity uint64
+		for j, word := range words[i : i+6] {
+			word = rfc1751Standard(word)
+			index, ok := rfc1751Indexes[word]
+			if !ok {
+				return nil, fmt.Errorf("Unknown RFC1751 word: %s", word)
+			}
+			if j == 5 {
+				block = block<<9 | uint64(index)>>2
+				parity = uint64(index) & 3
+			} else {
+				block = block<<11 | uint64(index)
+			}
+		}
+		if parity != rfc1751Parity(block) {
+			return nil, fmt.Errorf("Bad RFC1751 parity in: %s", strings.Join(words[i:i+6], " "))
+		}
+		binary.BigEndian.PutUint64(key[i/6*8:], block)
+	}
+	return key, nil
+}
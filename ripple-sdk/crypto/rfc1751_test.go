@@ -0,0 +1,49 @@
+package crypto
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+// The examples of RFC1751
+func TestRFC1751(t *testing.T) {
+	for _, test := range []struct {
+		key, words string
+	}{
+		{"EB33F77EE73D4053", "TIDE ITCH SLOW REIN RULE MOT"},
+		{"CCAC2AED591056BE4F90FD441C534766", "RASH BUSH MILK LOOK BAD BRIM AVID GAFF BAIT ROT POD LOVE"},
+		{"EFF81F9BFBC65350920CDD7416DE8009", "TROD MUTE TAIL WARM CHAR KONG HAAG CITY BORE O TEAL AWL"},
+	} {
+		key, _ := hex.DecodeString(test.key)
+		words, err := KeyToRFC1751(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if words != test.words {
+			t.Errorf("%s: words are %q, want %q", test.key, words, test.words)
+		}
+		for _, s := range []string{test.words, strings.ToLower(test.words)} {
+			decoded, err := RFC1751ToKey(s)
+			if err != nil {
+				t.Fatalf("%q: %v", s, err)
+			}
+			if got := strings.ToUpper(hex.EncodeToString(decoded)); got != test.key {
+				t.Errorf("%q: key is %s, want %s", s, got, test.key)
+			}
+		}
+	}
+
+	for _, bad := range []string{
+		"TIDE ITCH SLOW REIN RULE MOTH", // parity
+		"TIDE ITCH SLOW REIN RULE",
+		"TIDE ITCH SLOW REIN RULE XYZZY",
+	} {
+		if _, err := RFC1751ToKey(bad); err == nil {
+			t.Errorf("%q: expected an error", bad)
+		}
+	}
+	if _, err := KeyToRFC1751(make([]byte, 7)); err == nil {
+		t.Error("encoded a key of 7 bytes")
+	}
+}
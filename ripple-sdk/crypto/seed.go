@@ -0,0 +1,45 @@
+package crypto
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// rippled writes the seeds of Ed25519 keys with a three byte prefix, so
+// that they start with "sEd", rather than the family seed's version. The
+// 16 byte payload is the same.
+
+var ed25519SeedPrefix = []byte{0x01, 0xE1, 0x4B} // "sEd..."
+
+// EncodeEd25519Seed encodes a 16 byte seed as an "sEd..." seed
+func EncodeEd25519Seed(seed []byte) (string, error) {
+	if len(seed) != hashTypes[RIPPLE_FAMILY_SEED].Payload {
+		return "", fmt.Errorf("Seed is wrong size, expected: %d got: %d", hashTypes[RIPPLE_FAMILY_SEED].Payload, len(seed))
+	}
+	return Base58Encode(append(append([]byte(nil), ed25519SeedPrefix...), seed...), ALPHABET), nil
+}
+
+// DecodeSeed returns the payload of a family seed or an "sEd..." seed and
+// whether it is the latter
+func DecodeSeed(s string) ([]byte, bool, error) {
+	decoded, err := Base58Decode(s, ALPHABET)
+	if err != nil {
+		return nil, false, err
+	}
+	b := decoded[:len(decoded)-4]
+	size := hashTypes[RIPPLE_FAMILY_SEED].Payload
+	switch {
+	case len(b) == len(ed25519SeedPrefix)+size && bytes.HasPrefix(b, ed25519SeedPrefix):
+		if encoded, _ := EncodeEd25519Seed(b[len(ed25519SeedPrefix):]); encoded != s {
+			return nil, false, fmt.Errorf("Bad seed: %s", s)
+		}
+		return b[len(ed25519SeedPrefix):], true, nil
+	case len(b) == 1+size && HashVersion(b[0]) == RIPPLE_FAMILY_SEED:
+		if hash(b).String() != s {
+			return nil, false, fmt.Errorf("Bad seed: %s", s)
+		}
+		return b[1:], false, nil
+	default:
+		return nil, false, fmt.Errorf("Not a seed: %s", s)
+	}
+}
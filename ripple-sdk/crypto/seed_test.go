@@ -0,0 +1,43 @@
+package crypto
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestDecodeSeed(t *testing.T) {
+	entropy := []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
+	for _, test := range []struct {
+		seed    string
+		ed25519 bool
+	}{
+		{"sEdSKaCy2JT7JaM7v95H9SxkhP9wS2r", true},
+		{"sp5fghtJtpUorTwvof1NpDXAzNwf5", false},
+	} {
+		b, ed25519, err := DecodeSeed(test.seed)
+		if err != nil {
+			t.Fatalf("%s: %v", test.seed, err)
+		}
+		if !bytes.Equal(b, entropy) || ed25519 != test.ed25519 {
+			t.Errorf("%s: decoded %X %v", test.seed, b, ed25519)
+		}
+	}
+
+	encoded, err := EncodeEd25519Seed(entropy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if encoded != "sEdSKaCy2JT7JaM7v95H9SxkhP9wS2r" {
+		t.Errorf("encoded %s", encoded)
+	}
+
+	for _, bad := range []string{
+		"sEdSKaCy2JT7JaM7v95H9SxkhP9wS2s", // checksum
+		"rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+		"",
+	} {
+		if _, _, err := DecodeSeed(bad); err == nil {
+			t.Errorf("%q: expected an error", bad)
+		}
+	}
+}
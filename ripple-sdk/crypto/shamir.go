@@ -0,0 +1,168 @@
+package crypto
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"fmt"
+)
+
+// Shamir's secret sharing over GF(2^8), a byte at a time. The secret is
+// shared with a checksum after it, so that combining shares of different
+// secrets, or too few, is found out without the checksum being seen in any
+// share. Each share is the payload of a RIPPLE_SECRET_SHARE hash:
+//
+//	id (2 bytes) | threshold | x | y of the secret and its checksum
+//
+// where id is random for each split, so shares of different splits are not
+// mixed. The shares of a family seed look like seeds, starting with s.
+
+const (
+	shareHeader   = 4
+	shareChecksum = 4
+)
+
+var gfExp, gfLog [256]byte
+
+func init() {
+	// 3 generates the multiplicative group of GF(2^8) modulo x^8+x^4+x^3+x+1
+	x := byte(1)
+	for i := 0; i < 255; i++ {
+		gfExp[i] = x
+		gfLog[x] = byte(i)
+		x ^= x<<1 ^ (x>>7)*0x1b
+	}
+	gfExp[255] = gfExp[0]
+}
+
+func gfMul(a, b byte) byte {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	return gfExp[(int(gfLog[a])+int(gfLog[b]))%255]
+}
+
+func gfDiv(a, b byte) byte {
+	if a == 0 {
+		return 0
+	}
+	return gfExp[(int(gfLog[a])-int(gfLog[b])+255)%255]
+}
+
+func secretChecksum(secret []byte) []byte {
+	sum := sha256.Sum256(secret)
+	return sum[:shareChecksum]
+}
+
+// SplitSecret splits a secret, such as a 16 byte seed or 32 byte private
+// key, into count shares, any threshold of which recover it
+func SplitSecret(secret []byte, threshold, count int) ([]Hash, error) {
+	max := hashTypes[RIPPLE_SECRET_SHARE].Payload - shareHeader - shareChecksum
+	switch {
+	case len(secret) == 0 || len(secret) > max:
+		return nil, fmt.Errorf("Secret must be 1 to %d bytes, got: %d", max, len(secret))
+	case threshold < 1 || threshold > count || count > 255:
+		return nil, fmt.Errorf("Bad threshold %d of %d shares", threshold, count)
+	}
+	values := append(append([]byte(nil), secret...), secretChecksum(secret)...)
+	defer wipe(values)
+
+	// Each value is the constant of a random polynomial of degree threshold-1
+	coefficients := make([]byte, len(values)*(threshold-1))
+	defer wipe(coefficients)
+	if _, err := rand.Read(coefficients); err != nil {
+		return nil, err
+	}
+	var id [2]byte
+	if _, err := rand.Read(id[:]); err != nil {
+		return nil, err
+	}
+
+	shares := make([]Hash, count)
+	for i := range shares {
+		x := byte(i + 1)
+		b := append(id[:], byte(threshold), x)
+		for j, value := range values {
+			// Horner's method, from the highest coefficient down
+			var y byte
+			for k := threshold - 2; k >= 0; k-- {
+				y = gfMul(y, x) ^ coefficients[j*(threshold-1)+k]
+			}
+			b = append(b, gfMul(y, x)^value)
+		}
+		share, err := newHash(b, RIPPLE_SECRET_SHARE)
+		if err != nil {
+			return nil, err
+		}
+		shares[i] = share
+	}
+	return shares, nil
+}
+
+// NewSecretShare expects a share returned by SplitSecret in base58 form
+func NewSecretShare(s string) (Hash, error) {
+	share, err := NewRippleHashCheck(s, RIPPLE_SECRET_SHARE)
+	if err != nil {
+		return nil, err
+	}
+	if len(share.Payload()) <= shareHeader+shareChecksum {
+		return nil, fmt.Errorf("Share is too short: %s", s)
+	}
+	return share, nil
+}
+
+// CombineShares recovers the secret from at least the threshold of shares
+// of one split
+func CombineShares(shares []Hash) ([]byte, error) {
+	if len(shares) == 0 {
+		return nil, fmt.Errorf("No shares")
+	}
+	first := shares[0].Payload()
+	if len(first) <= shareHeader+shareChecksum {
+		return nil, fmt.Errorf("Share is too short")
+	}
+	threshold := int(first[2])
+	if len(shares) < threshold {
+		return nil, fmt.Errorf("Need %d shares, got: %d", threshold, len(shares))
+	}
+	xs := make([]byte, threshold)
+	ys := make([][]byte, threshold)
+	for i, share := range shares[:threshold] {
+		b := share.Payload()
+		switch {
+		case share.Version() != RIPPLE_SECRET_SHARE:
+			return nil, fmt.Errorf("Not a share: %s", share)
+		case len(b) != len(first) || !bytes.Equal(b[:3], first[:3]):
+			return nil, fmt.Errorf("Share %s is from another split", share)
+		case b[3] == 0 || bytes.IndexByte(xs[:i], b[3]) >= 0:
+			return nil, fmt.Errorf("Repeated or bad share: %s", share)
+		}
+		xs[i], ys[i] = b[3], b[shareHeader:]
+	}
+
+	// Lagrange interpolation at 0
+	values := make([]byte, len(first)-shareHeader)
+	for i := range xs {
+		basis := byte(1)
+		for j := range xs {
+			if i != j {
+				basis = gfMul(basis, gfDiv(xs[j], xs[j]^xs[i]))
+			}
+		}
+		for k := range values {
+			values[k] ^= gfMul(basis, ys[i][k])
+		}
+	}
+	secret := values[:len(values)-shareChecksum]
+	if !bytes.Equal(secretChecksum(secret), values[len(secret):]) {
+		wipe(values)
+		return nil, fmt.Errorf("Bad checksum, the shares are of different secrets or corrupt")
+	}
+	return secret, nil
+}
+
+func wipe(b []byte) {
+	for i := range b {
+		b[i] = 0
+	}
+}
@@ -0,0 +1,96 @@
+package crypto
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestGF256(t *testing.T) {
+	for a := 1; a < 256; a++ {
+		for b := 1; b < 256; b++ {
+			if got := gfMul(gfDiv(byte(a), byte(b)), byte(b)); got != byte(a) {
+				t.Fatalf("%d / %d * %d = %d", a, b, b, got)
+			}
+		}
+	}
+}
+
+func TestShamir3Of5(t *testing.T) {
+	for _, secret := range [][]byte{
+		{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16},
+		bytes.Repeat([]byte{0xAB}, 32),
+	} {
+		shares, err := SplitSecret(secret, 3, 5)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(shares) != 5 {
+			t.Fatalf("%d shares", len(shares))
+		}
+		for _, share := range shares {
+			again, err := NewSecretShare(share.String())
+			if err != nil || !bytes.Equal(again.Payload(), share.Payload()) {
+				t.Errorf("%s: %v", share, err)
+			}
+		}
+
+		// Every three of the five, in any order
+		for i := 0; i < 5; i++ {
+			for j := i + 1; j < 5; j++ {
+				for k := j + 1; k < 5; k++ {
+					combined, err := CombineShares([]Hash{shares[k], shares[i], shares[j]})
+					if err != nil {
+						t.Fatalf("shares %d, %d and %d: %v", i, j, k, err)
+					}
+					if !bytes.Equal(combined, secret) {
+						t.Errorf("shares %d, %d and %d give %X", i, j, k, combined)
+					}
+				}
+			}
+		}
+
+		if _, err := CombineShares(shares[:2]); err == nil {
+			t.Error("combined two shares")
+		}
+		if _, err := CombineShares([]Hash{shares[0], shares[0], shares[1]}); err == nil {
+			t.Error("combined a repeated share")
+		}
+		other, err := SplitSecret(secret, 3, 5)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := CombineShares([]Hash{shares[0], shares[1], other[2]}); err == nil {
+			t.Error("combined shares of different splits")
+		}
+		corrupt := append(hash(nil), shares[2].(hash)...)
+		corrupt[len(corrupt)-1] ^= 1
+		if _, err := CombineShares([]Hash{shares[0], shares[1], corrupt}); err == nil {
+			t.Error("combined a corrupt share")
+		}
+	}
+
+	for _, bad := range []struct{ threshold, count int }{{0, 5}, {6, 5}, {3, 256}} {
+		if _, err := SplitSecret(make([]byte, 16), bad.threshold, bad.count); err == nil {
+			t.Errorf("split %d of %d", bad.threshold, bad.count)
+		}
+	}
+}
+
+func TestNewSecretShareVersion(t *testing.T) {
+	for _, b := range [][]byte{
+		append([]byte{200}, make([]byte, 20)...),
+		append([]byte{byte(RIPPLE_FAMILY_SEED)}, make([]byte, 16)...),
+		{byte(RIPPLE_SECRET_SHARE)},
+		{},
+	} {
+		s := Base58Encode(b, ALPHABET)
+		if _, err := NewSecretShare(s); err == nil {
+			t.Errorf("%s: %X is a share", s, b)
+		}
+	}
+	for _, s := range []string{"ppppp", "r", ""} {
+		if _, err := NewSecretShare(s); err == nil {
+			t.Errorf("%q is a share", s)
+		}
+	}
+}
@@ -3,15 +3,21 @@ package crypto
 import (
 	"crypto/sha256"
 	"crypto/sha512"
+	stdhash "hash"
+	"sync"
 
 	"golang.org/x/crypto/ripemd160"
 )
 
 // Write operations in a hash.Hash never return an error
 
+var sha512Pool = sync.Pool{New: func() interface{} { return sha512.New() }}
+
 // Returns first 32 bytes of a SHA512 of the input bytes
 func Sha512Half(b []byte) []byte {
-	hasher := sha512.New()
+	hasher := sha512Pool.Get().(stdhash.Hash)
+	defer sha512Pool.Put(hasher)
+	hasher.Reset()
 	hasher.Write(b)
 	return hasher.Sum(nil)[:32]
 }
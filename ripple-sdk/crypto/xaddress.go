@@ -0,0 +1,85 @@
+package crypto
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+)
+
+// X-addresses (XLS-5d) are an account id and an optional destination tag
+// in one base58 string. The payload is a two byte network prefix, the
+// account id, a flag byte which is 1 when there is a tag and the tag as
+// eight little endian bytes, of which only the first four may be used.
+
+var (
+	xAddressMainPrefix = []byte{0x05, 0x44} // "X..."
+	xAddressTestPrefix = []byte{0x04, 0x93} // "T..."
+)
+
+const xAddressLength = 2 + 20 + 1 + 8
+
+// EncodeXAddress encodes a 20 byte account id and tag, which may be nil,
+// for the main network or, if test is true, for a test network
+func EncodeXAddress(account []byte, tag *uint32, test bool) (string, error) {
+	if len(account) != hashTypes[RIPPLE_ACCOUNT_ID].Payload {
+		return "", fmt.Errorf("Account id is wrong size, expected: %d got: %d", hashTypes[RIPPLE_ACCOUNT_ID].Payload, len(account))
+	}
+	b := make([]byte, 0, xAddressLength)
+	if test {
+		b = append(b, xAddressTestPrefix...)
+	} else {
+		b = append(b, xAddressMainPrefix...)
+	}
+	b = append(b, account...)
+	var flag byte
+	var value [8]byte
+	if tag != nil {
+		flag = 1
+		binary.LittleEndian.PutUint32(value[:], *tag)
+	}
+	b = append(append(b, flag), value[:]...)
+	return Base58Encode(b, ALPHABET), nil
+}
+
+// DecodeXAddress returns the account id, tag, which is nil when there is
+// none, and whether s is for a test network
+func DecodeXAddress(s string) ([]byte, *uint32, bool, error) {
+	decoded, err := Base58Decode(s, ALPHABET)
+	if err != nil {
+		return nil, nil, false, err
+	}
+	b := decoded[:len(decoded)-4]
+	if len(b) != xAddressLength {
+		return nil, nil, false, fmt.Errorf("Bad X-address length: %s", s)
+	}
+	var test bool
+	switch {
+	case bytes.Equal(b[:2], xAddressMainPrefix):
+	case bytes.Equal(b[:2], xAddressTestPrefix):
+		test = true
+	default:
+		return nil, nil, false, fmt.Errorf("Bad X-address prefix: %s", s)
+	}
+	account, flag, value := b[2:22], b[22], b[23:]
+	if binary.LittleEndian.Uint32(value[4:]) != 0 {
+		return nil, nil, false, fmt.Errorf("Unsupported 64 bit tag in X-address: %s", s)
+	}
+	switch flag {
+	case 0:
+		if binary.LittleEndian.Uint32(value) != 0 {
+			return nil, nil, false, fmt.Errorf("Tag without flag in X-address: %s", s)
+		}
+		return account, nil, test, nil
+	case 1:
+		tag := binary.LittleEndian.Uint32(value)
+		return account, &tag, test, nil
+	default:
+		return nil, nil, false, fmt.Errorf("Bad X-address flags: %d", flag)
+	}
+}
+
+// IsXAddress is whether s looks like an X-address rather than a classic
+// address, without checking it
+func IsXAddress(s string) bool {
+	return len(s) > 0 && (s[0] == 'X' || s[0] == 'T')
+}
@@ -0,0 +1,53 @@
+package crypto
+
+import (
+	"bytes"
+	"testing"
+)
+
+// The XLS-5d test vectors for rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf
+func TestXAddress(t *testing.T) {
+	hash, err := NewRippleHashCheck("rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf", RIPPLE_ACCOUNT_ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	account := hash.Payload()
+	tag := func(tag uint32) *uint32 { return &tag }
+	for _, test := range []struct {
+		tag        *uint32
+		main, test string
+	}{
+		{nil, "XVLhHMPHU98es4dbozjVtdWzVrDjtV5fdx1mHp98tDMoQXb", "TVE26TYGhfLC7tQDno7G8dGtxSkYQn49b3qD26PK7FcGSKE"},
+		{tag(0), "XVLhHMPHU98es4dbozjVtdWzVrDjtV8AqEL4xcZj5whKbmc", "TVE26TYGhfLC7tQDno7G8dGtxSkYQnSy8RHqGHoGJ59spi2"},
+		{tag(1), "XVLhHMPHU98es4dbozjVtdWzVrDjtV8xvjGQTYPiAx6gwDC", "TVE26TYGhfLC7tQDno7G8dGtxSkYQnSz1uDimDdPYXzSpyw"},
+		{tag(14), "XVLhHMPHU98es4dbozjVtdWzVrDjtVoD9z4jAcBVsnb97sM", "TVE26TYGhfLC7tQDno7G8dGtxSkYQnTEfwLUEHRrdC8Pfa8"},
+		{tag(11747), "XVLhHMPHU98es4dbozjVtdWzVrDjtV1N75zgFKga4R1B9Mk", "TVE26TYGhfLC7tQDno7G8dGtxSkYQnXGRtx9fzucypAhEJD"},
+		{tag(4294967295), "XVLhHMPHU98es4dbozjVtdWzVrDjtV18pX8yuPT7y4xaEHi", "TVE26TYGhfLC7tQDno7G8dGtxSkYQnXoy6kSDh6rZzApc69"},
+	} {
+		for _, network := range []struct {
+			address string
+			test    bool
+		}{{test.main, false}, {test.test, true}} {
+			encoded, err := EncodeXAddress(account, test.tag, network.test)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if encoded != network.address {
+				t.Errorf("tag %v: encoded %s, want %s", test.tag, encoded, network.address)
+			}
+			decoded, decodedTag, isTest, err := DecodeXAddress(network.address)
+			switch {
+			case err != nil:
+				t.Errorf("%s: %v", network.address, err)
+			case !bytes.Equal(decoded, account) || isTest != network.test:
+				t.Errorf("%s: decoded %X %v", network.address, decoded, isTest)
+			case (decodedTag == nil) != (test.tag == nil) || (test.tag != nil && *decodedTag != *test.tag):
+				t.Errorf("%s: tag is %v, want %v", network.address, decodedTag, test.tag)
+			}
+		}
+	}
+
+	if _, _, _, err := DecodeXAddress("rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf"); err == nil {
+		t.Error("decoded a classic address")
+	}
+}
@@ -0,0 +1,180 @@
+package data
+
+import (
+	"crypto/sha512"
+	"fmt"
+	"math/big"
+)
+
+// The helpers below follow XLS-30 for an AMM with equal weights. The
+// pool balances are not part of the AMM entry: they are the balances of
+// its Account, as returned by amm_info. XRP pools are counted in drops.
+
+const ammFeeOffset = -5 // TradingFee is in units of 1/100000
+
+// AMMLPTokenCurrency is the currency of the LP tokens of an AMM, which are
+// issued by its Account
+func AMMLPTokenCurrency(a, b Currency) Currency {
+	if b.Less(a) {
+		a, b = b, a
+	}
+	hash := sha512.New()
+	hash.Write(a[:])
+	hash.Write(b[:])
+	var c Currency
+	c[0] = 0x03
+	copy(c[1:], hash.Sum(nil))
+	return c
+}
+
+// AMMCreateLPTokens is the LP token balance of a new AMM
+func AMMCreateLPTokens(amount, amount2 Amount) (*Value, error) {
+	a, b, err := nonNativePair(*amount.Value, *amount2.Value)
+	if err != nil {
+		return nil, err
+	}
+	product, err := a.Multiply(*b)
+	if err != nil {
+		return nil, err
+	}
+	return sqrtValue(*product)
+}
+
+// SpotPrice is the price of Asset in units of Asset2, including the
+// trading fee. As with Offer.Ratio, XRP is priced at face value rather
+// than in drops.
+func (a *AMMRoot) SpotPrice(pool, pool2 Amount) (*Value, error) {
+	ratio, err := pool2.Value.Ratio(*pool.Value)
+	if err != nil {
+		return nil, err
+	}
+	feeMultiplier, err := a.feeMultiplier(1)
+	if err != nil {
+		return nil, err
+	}
+	return ratio.Divide(*feeMultiplier)
+}
+
+// DepositLPTokens is the LP tokens received for depositing amount, along
+// with the same proportion of the other asset
+func (a *AMMRoot) DepositLPTokens(pool, amount Amount) (*Value, error) {
+	share, err := a.share(pool, amount)
+	if err != nil {
+		return nil, err
+	}
+	return a.LPTokenBalance.Value.Multiply(*share)
+}
+
+// SingleAssetDepositLPTokens is the LP tokens received for depositing
+// amount alone, which pays the trading fee on the half that is swapped
+func (a *AMMRoot) SingleAssetDepositLPTokens(pool, amount Amount) (*Value, error) {
+	share, err := a.share(pool, amount)
+	if err != nil {
+		return nil, err
+	}
+	feeMultiplier, err := a.feeMultiplier(2)
+	if err != nil {
+		return nil, err
+	}
+	if share, err = share.Multiply(*feeMultiplier); err != nil {
+		return nil, err
+	}
+	one, err := NewNonNativeValue(1, 0)
+	if err != nil {
+		return nil, err
+	}
+	if share, err = one.Add(*share); err != nil {
+		return nil, err
+	}
+	if share, err = sqrtValue(*share); err != nil {
+		return nil, err
+	}
+	if share, err = share.Subtract(*one); err != nil {
+		return nil, err
+	}
+	return a.LPTokenBalance.Value.Multiply(*share)
+}
+
+// WithdrawAmount is the amount of the pool's asset returned for
+// redeeming lpTokens, along with the same proportion of the other asset
+func (a *AMMRoot) WithdrawAmount(pool Amount, lpTokens Value) (*Amount, error) {
+	if a.LPTokenBalance == nil || a.LPTokenBalance.IsZero() {
+		return nil, fmt.Errorf("AMM has no LP tokens")
+	}
+	share, err := lpTokens.Divide(*a.LPTokenBalance.Value)
+	if err != nil {
+		return nil, err
+	}
+	value, err := pool.Value.NonNative()
+	if err != nil {
+		return nil, err
+	}
+	if value, err = value.Multiply(*share); err != nil {
+		return nil, err
+	}
+	if pool.IsNative() {
+		if value, err = value.Native(); err != nil {
+			return nil, err
+		}
+	}
+	return newAmount(value, pool.Currency, pool.Issuer), nil
+}
+
+// share is amount as a proportion of pool
+func (a *AMMRoot) share(pool, amount Amount) (*Value, error) {
+	if a.LPTokenBalance == nil {
+		return nil, fmt.Errorf("AMM has no LP tokens")
+	}
+	if !pool.Currency.Equals(amount.Currency) {
+		return nil, fmt.Errorf("Cannot deposit %s in a %s pool", amount.Currency, pool.Currency)
+	}
+	p, v, err := nonNativePair(*pool.Value, *amount.Value)
+	if err != nil {
+		return nil, err
+	}
+	return v.Divide(*p)
+}
+
+// feeMultiplier is 1 - TradingFee/divisor
+func (a *AMMRoot) feeMultiplier(divisor int64) (*Value, error) {
+	var fee int64
+	if a.TradingFee != nil {
+		fee = int64(*a.TradingFee)
+	}
+	one, err := NewNonNativeValue(1, 0)
+	if err != nil {
+		return nil, err
+	}
+	f, err := NewNonNativeValue(fee, ammFeeOffset)
+	if err != nil {
+		return nil, err
+	}
+	d, err := NewNonNativeValue(divisor, 0)
+	if err != nil {
+		return nil, err
+	}
+	if f, err = f.Divide(*d); err != nil {
+		return nil, err
+	}
+	return one.Subtract(*f)
+}
+
+func nonNativePair(a, b Value) (*Value, *Value, error) {
+	x, err := a.NonNative()
+	if err != nil {
+		return nil, nil, err
+	}
+	y, err := b.NonNative()
+	if err != nil {
+		return nil, nil, err
+	}
+	return x, y, nil
+}
+
+func sqrtValue(v Value) (*Value, error) {
+	if v.IsNegative() {
+		return nil, fmt.Errorf("Square root of negative value: %s", v.debug())
+	}
+	f := new(big.Float).SetPrec(128).SetRat(v.Rat())
+	return NewValue(f.Sqrt(f).Text('e', 15), false)
+}
@@ -0,0 +1,201 @@
+package data
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+// The XRP/TST pool of the amm_info example on xrpl.org, with its XRP in
+// drops as amm_info returns it
+const (
+	testAMMAccount    = "rp9E3FN3gNmvePGhYnf414T2TkUuoxu8vM"
+	testAMMXRP        = "227345303"
+	testAMMTST        = "2521.398428064588/TST/rP9jPyP5kyvFRb6ZiRghAGw5u8SGAmU4bd"
+	testAMMLPCurrency = "039C99CD9AB0B70B32ECDA51EAAE471625608EA2"
+	testAMMLPTokens   = "71150.53584131501"
+	testAMMTradingFee = 600
+)
+
+func mustAmount(t *testing.T, s string) Amount {
+	t.Helper()
+	amount, err := NewAmount(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return *amount
+}
+
+func mustValue(t *testing.T, s string) Value {
+	t.Helper()
+	v, err := NewValue(s, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return *v
+}
+
+func testAMM(t *testing.T) *AMMRoot {
+	account, err := NewAccountFromAddress(testAMMAccount)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lpTokens := mustAmount(t, testAMMLPTokens+"/"+testAMMLPCurrency+"/"+testAMMAccount)
+	fee := uint16(testAMMTradingFee)
+	return &AMMRoot{
+		Account:        account,
+		TradingFee:     &fee,
+		LPTokenBalance: &lpTokens,
+	}
+}
+
+// bigFloat parses s exactly, for computing expected values independently
+// of Value
+func bigFloat(t *testing.T, s string) *big.Float {
+	t.Helper()
+	f, _, err := big.ParseFloat(s, 10, 256, big.ToNearestEven)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return f
+}
+
+// checkClose compares v with expected to the 16 significant digits of
+// a non-native Value
+func checkClose(t *testing.T, name string, v *Value, expected *big.Float) {
+	t.Helper()
+	want, _ := expected.Float64()
+	if got := v.Float(); math.Abs(got-want) > math.Abs(want)*1e-14 {
+		t.Errorf("%s: got %s, want %s", name, v, expected.Text('g', 20))
+	}
+}
+
+func TestAMMLPTokenCurrency(t *testing.T) {
+	tst, err := NewCurrency("TST")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var xrp Currency
+	for _, c := range []Currency{AMMLPTokenCurrency(xrp, tst), AMMLPTokenCurrency(tst, xrp)} {
+		if c.Machine() != testAMMLPCurrency {
+			t.Errorf("LP token currency %s, want %s", c.Machine(), testAMMLPCurrency)
+		}
+	}
+}
+
+func TestAMMCreateLPTokens(t *testing.T) {
+	for _, test := range []struct {
+		amount, amount2, lpTokens string
+	}{
+		// XRP is counted in drops
+		{"10000000", "10/TST/rP9jPyP5kyvFRb6ZiRghAGw5u8SGAmU4bd", "10000"},
+		{"100/USD/rP9jPyP5kyvFRb6ZiRghAGw5u8SGAmU4bd", "400/EUR/rP9jPyP5kyvFRb6ZiRghAGw5u8SGAmU4bd", "200"},
+		{"2/USD/rP9jPyP5kyvFRb6ZiRghAGw5u8SGAmU4bd", "1/EUR/rP9jPyP5kyvFRb6ZiRghAGw5u8SGAmU4bd", "1.414213562373095"},
+	} {
+		lpTokens, err := AMMCreateLPTokens(mustAmount(t, test.amount), mustAmount(t, test.amount2))
+		if err != nil {
+			t.Fatal(err)
+		}
+		checkClose(t, test.amount+" and "+test.amount2, lpTokens, bigFloat(t, test.lpTokens))
+	}
+}
+
+func TestAMMSpotPrice(t *testing.T) {
+	amm := testAMM(t)
+	xrp, tst := mustAmount(t, testAMMXRP), mustAmount(t, testAMMTST)
+	feeMultiplier := bigFloat(t, "0.994") // 1 - 600/100000
+
+	// Prices are per XRP, not per drop, although the pool is in drops
+	price, err := amm.SpotPrice(xrp, tst)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := new(big.Float).Quo(bigFloat(t, "2521.398428064588"), bigFloat(t, "227.345303"))
+	checkClose(t, "TST per XRP", price, expected.Quo(expected, feeMultiplier))
+
+	price, err = amm.SpotPrice(tst, xrp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected = new(big.Float).Quo(bigFloat(t, "227.345303"), bigFloat(t, "2521.398428064588"))
+	checkClose(t, "XRP per TST", price, expected.Quo(expected, feeMultiplier))
+}
+
+func TestAMMDeposit(t *testing.T) {
+	amm := testAMM(t)
+	xrp, tst := mustAmount(t, testAMMXRP), mustAmount(t, testAMMTST)
+	lpTokens := bigFloat(t, testAMMLPTokens)
+
+	for _, test := range []struct {
+		name          string
+		pool, deposit Amount
+		share         *big.Float
+	}{
+		{"XRP", xrp, mustAmount(t, "22734530"), new(big.Float).Quo(bigFloat(t, "22734530"), bigFloat(t, testAMMXRP))},
+		{"TST", tst, mustAmount(t, "252.1398428064588/TST/rP9jPyP5kyvFRb6ZiRghAGw5u8SGAmU4bd"), bigFloat(t, "0.1")},
+	} {
+		proportional, err := amm.DepositLPTokens(test.pool, test.deposit)
+		if err != nil {
+			t.Fatal(err)
+		}
+		checkClose(t, test.name+" proportional", proportional, new(big.Float).Mul(lpTokens, test.share))
+
+		// LPTokens * (sqrt(1 + share * (1 - fee/2)) - 1)
+		single, err := amm.SingleAssetDepositLPTokens(test.pool, test.deposit)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected := new(big.Float).Mul(test.share, bigFloat(t, "0.997"))
+		expected.Add(expected, big.NewFloat(1))
+		expected.Sqrt(expected)
+		expected.Sub(expected, big.NewFloat(1))
+		checkClose(t, test.name+" single asset", single, expected.Mul(expected, lpTokens))
+
+		if single.Compare(*proportional) >= 0 {
+			t.Errorf("%s: depositing one asset alone should earn fewer LP tokens", test.name)
+		}
+	}
+
+	if _, err := amm.DepositLPTokens(xrp, mustAmount(t, "1/TST/rP9jPyP5kyvFRb6ZiRghAGw5u8SGAmU4bd")); err == nil {
+		t.Error("Deposited TST in the XRP pool")
+	}
+}
+
+func TestAMMWithdrawAmount(t *testing.T) {
+	amm := testAMM(t)
+	xrp, tst := mustAmount(t, testAMMXRP), mustAmount(t, testAMMTST)
+
+	// A tenth of the LP tokens redeems a tenth of each pool, with XRP
+	// rounded down to whole drops
+	tenth := mustValue(t, "7115.053584131501")
+	withdrawn, err := amm.WithdrawAmount(xrp, tenth)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !withdrawn.IsNative() || withdrawn.Value.String() != "22.73453" {
+		t.Errorf("XRP withdrawn: %s", withdrawn)
+	}
+	withdrawn, err = amm.WithdrawAmount(tst, tenth)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if withdrawn.IsNative() || withdrawn.Currency != tst.Currency || withdrawn.Issuer != tst.Issuer {
+		t.Errorf("TST withdrawn in the wrong asset: %s", withdrawn)
+	}
+	checkClose(t, "TST withdrawn", withdrawn.Value, bigFloat(t, "252.1398428064588"))
+
+	// Everything
+	withdrawn, err = amm.WithdrawAmount(xrp, *amm.LPTokenBalance.Value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if withdrawn.Value.String() != "227.345303" {
+		t.Errorf("Whole XRP pool withdrawn: %s", withdrawn)
+	}
+
+	empty := testAMM(t)
+	empty.LPTokenBalance = nil
+	if _, err := empty.WithdrawAmount(xrp, tenth); err == nil {
+		t.Error("Withdrew from an AMM without LP tokens")
+	}
+}
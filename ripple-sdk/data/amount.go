@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/goodwood511/ripple_lib/rubblelabs/ripple/crypto"
+	"github.com/goodwood511/ripple_lib/ripple-sdk/crypto"
 )
 
 type Amount struct {
@@ -0,0 +1,248 @@
+package data
+
+import (
+	"bytes"
+	"crypto/sha512"
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"io"
+	"reflect"
+	"sync"
+)
+
+//go:generate go run -tags codecgen ./internal/codecgen -o codec_gen.go
+
+// GeneratedCodec selects the encoders and decoders in codec_gen.go, which
+// are generated from the Go types by internal/codecgen and write the same
+// bytes as the reflection based encoder without walking each struct. With
+// it false, everything is encoded and decoded with reflection, for
+// comparing the two. It must not be changed while anything is being
+// encoded or decoded.
+var GeneratedCodec = true
+
+// fieldEncoder is implemented in codec_gen.go. nested is true for the
+// fields of an AffectedNode, which leave out LedgerEntryType.
+type fieldEncoder interface {
+	encodeFields(fw *fieldWriter, nested bool)
+}
+
+// fieldDecoder is implemented in codec_gen.go. It reads the fields which
+// are not objects or arrays, returning false for those it does not have.
+type fieldDecoder interface {
+	decodeField(r Reader, e enc) (bool, error)
+}
+
+var (
+	hasherPool = sync.Pool{New: func() interface{} { return sha512.New() }}
+	bufferPool = sync.Pool{New: func() interface{} { return new(bytes.Buffer) }}
+)
+
+func getHasher() hash.Hash {
+	h := hasherPool.Get().(hash.Hash)
+	h.Reset()
+	return h
+}
+
+func getBuffer() *bytes.Buffer {
+	b := bufferPool.Get().(*bytes.Buffer)
+	b.Reset()
+	return b
+}
+
+var (
+	endOfObject = enc{ST_OBJECT, 1}
+	endOfArray  = enc{ST_ARRAY, 1}
+)
+
+// fieldWriter writes the fields of an object, which the generated encoders
+// give it in order. The fields in Fields, which are not known until then,
+// are written among them.
+type fieldWriter struct {
+	w                   io.Writer
+	ignoreSigningFields bool
+	extra               fieldSlice
+	scratch             [8]byte
+	err                 error
+}
+
+// child is a writer for the fields of an object or of an element of an
+// array
+func (fw *fieldWriter) child() fieldWriter {
+	return fieldWriter{w: fw.w, ignoreSigningFields: fw.ignoreSigningFields, err: fw.err}
+}
+
+// close finishes the fields of c, a child of fw
+func (fw *fieldWriter) close(c *fieldWriter) {
+	fw.err = c.finish()
+}
+
+// end writes the end of an object or array
+func (fw *fieldWriter) end(e enc) {
+	if fw.err == nil {
+		fw.err = fw.writeEncoding(e)
+	}
+}
+
+func (fw *fieldWriter) setExtra(f Fields) {
+	if len(f) == 0 {
+		return
+	}
+	fw.extra = f.fieldSlice()
+	if fw.ignoreSigningFields {
+		fw.extra = fw.extra.withoutSigningFields()
+	}
+}
+
+// flush writes the extra fields which come before e
+func (fw *fieldWriter) flush(e enc) {
+	for len(fw.extra) > 0 && fw.err == nil && fw.extra[0].encoding.Priority() < e.Priority() {
+		fw.err = fw.extra[:1].Each(func(e enc, v interface{}) error {
+			return writeField(fw.w, e, v)
+		})
+		fw.extra = fw.extra[1:]
+	}
+}
+
+func (fw *fieldWriter) finish() error {
+	fw.flush(enc{0xFF, 0xFF})
+	return fw.err
+}
+
+func (fw *fieldWriter) writeEncoding(e enc) error {
+	b := fw.scratch[:0]
+	switch {
+	case e.typ < 16 && e.field < 16:
+		b = append(b, e.typ<<4|e.field)
+	case e.typ < 16:
+		b = append(b, e.typ<<4, e.field)
+	case e.field < 16:
+		b = append(b, e.field, e.typ)
+	default:
+		b = append(b, 0, e.typ, e.field)
+	}
+	_, err := fw.w.Write(b)
+	return err
+}
+
+// header writes e after the extra fields before it, returning whether the
+// value can follow
+func (fw *fieldWriter) header(e enc) bool {
+	fw.flush(e)
+	if fw.err == nil {
+		fw.err = fw.writeEncoding(e)
+	}
+	return fw.err == nil
+}
+
+func (fw *fieldWriter) wire(e enc, v Wire) {
+	if fw.header(e) {
+		fw.err = v.Marshal(fw.w)
+	}
+}
+
+func (fw *fieldWriter) value(e enc, v interface{}) {
+	if fw.header(e) {
+		fw.err = write(fw.w, v)
+	}
+}
+
+func (fw *fieldWriter) uint8(e enc, v uint8) {
+	if fw.header(e) {
+		fw.scratch[0] = v
+		_, fw.err = fw.w.Write(fw.scratch[:1])
+	}
+}
+
+func (fw *fieldWriter) uint16(e enc, v uint16) {
+	if fw.header(e) {
+		binary.BigEndian.PutUint16(fw.scratch[:], v)
+		_, fw.err = fw.w.Write(fw.scratch[:2])
+	}
+}
+
+func (fw *fieldWriter) uint32(e enc, v uint32) {
+	if fw.header(e) {
+		binary.BigEndian.PutUint32(fw.scratch[:], v)
+		_, fw.err = fw.w.Write(fw.scratch[:4])
+	}
+}
+
+func (fw *fieldWriter) uint64(e enc, v uint64) {
+	if fw.header(e) {
+		binary.BigEndian.PutUint64(fw.scratch[:], v)
+		_, fw.err = fw.w.Write(fw.scratch[:8])
+	}
+}
+
+// unsupported fails for an array which cannot be written, such as the
+// Signers of an AccountRoot, which the reflection based encoder cannot
+// write either
+func (fw *fieldWriter) unsupported(name string) {
+	if fw.err == nil {
+		fw.err = fmt.Errorf("Unsupported field: %s", name)
+	}
+}
+
+// entry writes the fields of a ledger entry in an AffectedNode
+func (fw *fieldWriter) entry(le LedgerEntry) {
+	if fe, ok := le.(fieldEncoder); ok {
+		fe.encodeFields(fw, true)
+		return
+	}
+	v := reflect.Indirect(reflect.ValueOf(le))
+	fields := getFields(&v, 3)
+	if fw.ignoreSigningFields {
+		fields = fields.withoutSigningFields()
+	}
+	fw.extra = append(fw.extra, fields...)
+	fw.extra.Sort()
+}
+
+// present is whether an AffectedNode has le, as it is encoded
+func present(le LedgerEntry) bool {
+	return le != nil && !reflect.ValueOf(le).IsNil()
+}
+
+func readUint8(r Reader) (uint8, error) {
+	return r.ReadByte()
+}
+
+func readUint16(r Reader) (uint16, error) {
+	var b [2]byte
+	if err := readFull(r, b[:]); err != nil {
+		return 0, err
+	}
+	return binary.BigEndian.Uint16(b[:]), nil
+}
+
+func readUint32(r Reader) (uint32, error) {
+	var b [4]byte
+	if err := readFull(r, b[:]); err != nil {
+		return 0, err
+	}
+	return binary.BigEndian.Uint32(b[:]), nil
+}
+
+func readUint64(r Reader) (uint64, error) {
+	var b [8]byte
+	if err := readFull(r, b[:]); err != nil {
+		return 0, err
+	}
+	return binary.BigEndian.Uint64(b[:]), nil
+}
+
+// readFull reads b a byte at a time, so that b need not escape
+func readFull(r Reader, b []byte) error {
+	for i := range b {
+		c, err := r.ReadByte()
+		switch {
+		case err == io.EOF && i > 0:
+			return io.ErrUnexpectedEOF
+		case err != nil:
+			return err
+		}
+		b[i] = c
+	}
+	return nil
+}
@@ -0,0 +1,6787 @@
+// Code generated by codecgen; DO NOT EDIT.
+
+//go:build !codecgen
+
+package data
+
+func (t *AMMBid) encodeFields(fw *fieldWriter, nested bool) {
+	fw.setExtra(t.TxBase.Fields)
+	fw.uint16(enc{ST_UINT16, 2}, uint16(t.TxBase.TransactionType)) // TransactionType
+	if t.TxBase.Flags != nil {
+		fw.uint32(enc{ST_UINT32, 2}, uint32((*t.TxBase.Flags))) // Flags
+	}
+	if t.TxBase.SourceTag != nil {
+		fw.uint32(enc{ST_UINT32, 3}, uint32((*t.TxBase.SourceTag))) // SourceTag
+	}
+	fw.uint32(enc{ST_UINT32, 4}, uint32(t.TxBase.Sequence)) // Sequence
+	if t.TxBase.LastLedgerSequence != nil {
+		fw.uint32(enc{ST_UINT32, 27}, uint32((*t.TxBase.LastLedgerSequence))) // LastLedgerSequence
+	}
+	if t.TxBase.TicketSequence != nil {
+		fw.uint32(enc{ST_UINT32, 41}, uint32((*t.TxBase.TicketSequence))) // TicketSequence
+	}
+	if t.TxBase.PreviousTxnID != nil {
+		fw.wire(enc{ST_HASH256, 5}, t.TxBase.PreviousTxnID) // PreviousTxnID
+	}
+	if t.TxBase.AccountTxnID != nil {
+		fw.wire(enc{ST_HASH256, 9}, t.TxBase.AccountTxnID) // AccountTxnID
+	}
+	fw.wire(enc{ST_AMOUNT, 8}, &t.TxBase.Fee) // Fee
+	if t.BidMin != nil {
+		fw.wire(enc{ST_AMOUNT, 12}, t.BidMin) // BidMin
+	}
+	if t.BidMax != nil {
+		fw.wire(enc{ST_AMOUNT, 13}, t.BidMax) // BidMax
+	}
+	if t.TxBase.SigningPubKey != nil {
+		fw.wire(enc{ST_VL, 3}, t.TxBase.SigningPubKey) // SigningPubKey
+	}
+	if !fw.ignoreSigningFields && t.TxBase.TxnSignature != nil && len((*t.TxBase.TxnSignature)) > 0 {
+		fw.wire(enc{ST_VL, 4}, t.TxBase.TxnSignature) // TxnSignature
+	}
+	fw.wire(enc{ST_ACCOUNT, 1}, &t.TxBase.Account) // Account
+	if !fw.ignoreSigningFields && len(t.TxBase.Signers) > 0 {
+		fw.header(enc{ST_ARRAY, 3}) // Signers
+		for i := range t.TxBase.Signers {
+			e1 := &t.TxBase.Signers[i]
+			c2 := fw.child()
+			c2.header(enc{ST_OBJECT, 16}) // Signer
+			c3 := c2.child()
+			if e1.Signer.SigningPubKey != nil {
+				c3.wire(enc{ST_VL, 3}, e1.Signer.SigningPubKey) // SigningPubKey
+			}
+			if !fw.ignoreSigningFields && e1.Signer.TxnSignature != nil && len((*e1.Signer.TxnSignature)) > 0 {
+				c3.wire(enc{ST_VL, 4}, e1.Signer.TxnSignature) // TxnSignature
+			}
+			c3.wire(enc{ST_ACCOUNT, 1}, &e1.Signer.Account) // Account
+			c2.close(&c3)
+			c2.end(endOfObject)
+			fw.close(&c2)
+		}
+		fw.end(endOfArray)
+	}
+	if len(t.TxBase.Memos) > 0 {
+		fw.header(enc{ST_ARRAY, 9}) // Memos
+		for i := range t.TxBase.Memos {
+			e4 := &t.TxBase.Memos[i]
+			c5 := fw.child()
+			c5.header(enc{ST_OBJECT, 10}) // Memo
+			c6 := c5.child()
+			if len(e4.Memo.MemoType) > 0 {
+				c6.wire(enc{ST_VL, 12}, &e4.Memo.MemoType) // MemoType
+			}
+			if len(e4.Memo.MemoData) > 0 {
+				c6.wire(enc{ST_VL, 13}, &e4.Memo.MemoData) // MemoData
+			}
+			if len(e4.Memo.MemoFormat) > 0 {
+				c6.wire(enc{ST_VL, 14}, &e4.Memo.MemoFormat) // MemoFormat
+			}
+			c5.close(&c6)
+			c5.end(endOfObject)
+			fw.close(&c5)
+		}
+		fw.end(endOfArray)
+	}
+	if len(t.AuthAccounts) > 0 {
+		fw.header(enc{ST_ARRAY, 25}) // AuthAccounts
+		for i := range t.AuthAccounts {
+			e7 := &t.AuthAccounts[i]
+			c8 := fw.child()
+			c8.header(enc{ST_OBJECT, 27}) // AuthAccount
+			c9 := c8.child()
+			c9.wire(enc{ST_ACCOUNT, 1}, &e7.AuthAccount.Account) // Account
+			c8.close(&c9)
+			c8.end(endOfObject)
+			fw.close(&c8)
+		}
+		fw.end(endOfArray)
+	}
+	fw.wire(enc{ST_ISSUE, 3}, &t.Asset)  // Asset
+	fw.wire(enc{ST_ISSUE, 4}, &t.Asset2) // Asset2
+}
+
+func (t *AMMBid) decodeField(r Reader, e enc) (bool, error) {
+	switch e {
+	case enc{ST_UINT16, 2}: // TransactionType
+		v, err := readUint16(r)
+		t.TxBase.TransactionType = TransactionType(v)
+		return true, err
+	case enc{ST_UINT32, 2}: // Flags
+		t.TxBase.Flags = new(TransactionFlag)
+		v, err := readUint32(r)
+		*t.TxBase.Flags = TransactionFlag(v)
+		return true, err
+	case enc{ST_UINT32, 3}: // SourceTag
+		t.TxBase.SourceTag = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.SourceTag = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 4}: // Sequence
+		v, err := readUint32(r)
+		t.TxBase.Sequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 27}: // LastLedgerSequence
+		t.TxBase.LastLedgerSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.LastLedgerSequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 41}: // TicketSequence
+		t.TxBase.TicketSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.TicketSequence = uint32(v)
+		return true, err
+	case enc{ST_HASH256, 5}: // PreviousTxnID
+		t.TxBase.PreviousTxnID = new(Hash256)
+		return true, t.TxBase.PreviousTxnID.Unmarshal(r)
+	case enc{ST_HASH256, 9}: // AccountTxnID
+		t.TxBase.AccountTxnID = new(Hash256)
+		return true, t.TxBase.AccountTxnID.Unmarshal(r)
+	case enc{ST_AMOUNT, 8}: // Fee
+		return true, t.TxBase.Fee.Unmarshal(r)
+	case enc{ST_AMOUNT, 12}: // BidMin
+		t.BidMin = new(Amount)
+		return true, t.BidMin.Unmarshal(r)
+	case enc{ST_AMOUNT, 13}: // BidMax
+		t.BidMax = new(Amount)
+		return true, t.BidMax.Unmarshal(r)
+	case enc{ST_VL, 3}: // SigningPubKey
+		t.TxBase.SigningPubKey = new(PublicKey)
+		return true, t.TxBase.SigningPubKey.Unmarshal(r)
+	case enc{ST_VL, 4}: // TxnSignature
+		t.TxBase.TxnSignature = new(VariableLength)
+		return true, t.TxBase.TxnSignature.Unmarshal(r)
+	case enc{ST_ACCOUNT, 1}: // Account
+		return true, t.TxBase.Account.Unmarshal(r)
+	case enc{ST_ISSUE, 3}: // Asset
+		return true, t.Asset.Unmarshal(r)
+	case enc{ST_ISSUE, 4}: // Asset2
+		return true, t.Asset2.Unmarshal(r)
+	}
+	return false, nil
+}
+
+func (t *AMMCreate) encodeFields(fw *fieldWriter, nested bool) {
+	fw.setExtra(t.TxBase.Fields)
+	fw.uint16(enc{ST_UINT16, 2}, uint16(t.TxBase.TransactionType)) // TransactionType
+	fw.uint16(enc{ST_UINT16, 5}, uint16(t.TradingFee))             // TradingFee
+	if t.TxBase.Flags != nil {
+		fw.uint32(enc{ST_UINT32, 2}, uint32((*t.TxBase.Flags))) // Flags
+	}
+	if t.TxBase.SourceTag != nil {
+		fw.uint32(enc{ST_UINT32, 3}, uint32((*t.TxBase.SourceTag))) // SourceTag
+	}
+	fw.uint32(enc{ST_UINT32, 4}, uint32(t.TxBase.Sequence)) // Sequence
+	if t.TxBase.LastLedgerSequence != nil {
+		fw.uint32(enc{ST_UINT32, 27}, uint32((*t.TxBase.LastLedgerSequence))) // LastLedgerSequence
+	}
+	if t.TxBase.TicketSequence != nil {
+		fw.uint32(enc{ST_UINT32, 41}, uint32((*t.TxBase.TicketSequence))) // TicketSequence
+	}
+	if t.TxBase.PreviousTxnID != nil {
+		fw.wire(enc{ST_HASH256, 5}, t.TxBase.PreviousTxnID) // PreviousTxnID
+	}
+	if t.TxBase.AccountTxnID != nil {
+		fw.wire(enc{ST_HASH256, 9}, t.TxBase.AccountTxnID) // AccountTxnID
+	}
+	fw.wire(enc{ST_AMOUNT, 1}, &t.Amount)     // Amount
+	fw.wire(enc{ST_AMOUNT, 8}, &t.TxBase.Fee) // Fee
+	fw.wire(enc{ST_AMOUNT, 11}, &t.Amount2)   // Amount2
+	if t.TxBase.SigningPubKey != nil {
+		fw.wire(enc{ST_VL, 3}, t.TxBase.SigningPubKey) // SigningPubKey
+	}
+	if !fw.ignoreSigningFields && t.TxBase.TxnSignature != nil && len((*t.TxBase.TxnSignature)) > 0 {
+		fw.wire(enc{ST_VL, 4}, t.TxBase.TxnSignature) // TxnSignature
+	}
+	fw.wire(enc{ST_ACCOUNT, 1}, &t.TxBase.Account) // Account
+	if !fw.ignoreSigningFields && len(t.TxBase.Signers) > 0 {
+		fw.header(enc{ST_ARRAY, 3}) // Signers
+		for i := range t.TxBase.Signers {
+			e10 := &t.TxBase.Signers[i]
+			c11 := fw.child()
+			c11.header(enc{ST_OBJECT, 16}) // Signer
+			c12 := c11.child()
+			if e10.Signer.SigningPubKey != nil {
+				c12.wire(enc{ST_VL, 3}, e10.Signer.SigningPubKey) // SigningPubKey
+			}
+			if !fw.ignoreSigningFields && e10.Signer.TxnSignature != nil && len((*e10.Signer.TxnSignature)) > 0 {
+				c12.wire(enc{ST_VL, 4}, e10.Signer.TxnSignature) // TxnSignature
+			}
+			c12.wire(enc{ST_ACCOUNT, 1}, &e10.Signer.Account) // Account
+			c11.close(&c12)
+			c11.end(endOfObject)
+			fw.close(&c11)
+		}
+		fw.end(endOfArray)
+	}
+	if len(t.TxBase.Memos) > 0 {
+		fw.header(enc{ST_ARRAY, 9}) // Memos
+		for i := range t.TxBase.Memos {
+			e13 := &t.TxBase.Memos[i]
+			c14 := fw.child()
+			c14.header(enc{ST_OBJECT, 10}) // Memo
+			c15 := c14.child()
+			if len(e13.Memo.MemoType) > 0 {
+				c15.wire(enc{ST_VL, 12}, &e13.Memo.MemoType) // MemoType
+			}
+			if len(e13.Memo.MemoData) > 0 {
+				c15.wire(enc{ST_VL, 13}, &e13.Memo.MemoData) // MemoData
+			}
+			if len(e13.Memo.MemoFormat) > 0 {
+				c15.wire(enc{ST_VL, 14}, &e13.Memo.MemoFormat) // MemoFormat
+			}
+			c14.close(&c15)
+			c14.end(endOfObject)
+			fw.close(&c14)
+		}
+		fw.end(endOfArray)
+	}
+}
+
+func (t *AMMCreate) decodeField(r Reader, e enc) (bool, error) {
+	switch e {
+	case enc{ST_UINT16, 2}: // TransactionType
+		v, err := readUint16(r)
+		t.TxBase.TransactionType = TransactionType(v)
+		return true, err
+	case enc{ST_UINT16, 5}: // TradingFee
+		v, err := readUint16(r)
+		t.TradingFee = uint16(v)
+		return true, err
+	case enc{ST_UINT32, 2}: // Flags
+		t.TxBase.Flags = new(TransactionFlag)
+		v, err := readUint32(r)
+		*t.TxBase.Flags = TransactionFlag(v)
+		return true, err
+	case enc{ST_UINT32, 3}: // SourceTag
+		t.TxBase.SourceTag = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.SourceTag = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 4}: // Sequence
+		v, err := readUint32(r)
+		t.TxBase.Sequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 27}: // LastLedgerSequence
+		t.TxBase.LastLedgerSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.LastLedgerSequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 41}: // TicketSequence
+		t.TxBase.TicketSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.TicketSequence = uint32(v)
+		return true, err
+	case enc{ST_HASH256, 5}: // PreviousTxnID
+		t.TxBase.PreviousTxnID = new(Hash256)
+		return true, t.TxBase.PreviousTxnID.Unmarshal(r)
+	case enc{ST_HASH256, 9}: // AccountTxnID
+		t.TxBase.AccountTxnID = new(Hash256)
+		return true, t.TxBase.AccountTxnID.Unmarshal(r)
+	case enc{ST_AMOUNT, 1}: // Amount
+		return true, t.Amount.Unmarshal(r)
+	case enc{ST_AMOUNT, 8}: // Fee
+		return true, t.TxBase.Fee.Unmarshal(r)
+	case enc{ST_AMOUNT, 11}: // Amount2
+		return true, t.Amount2.Unmarshal(r)
+	case enc{ST_VL, 3}: // SigningPubKey
+		t.TxBase.SigningPubKey = new(PublicKey)
+		return true, t.TxBase.SigningPubKey.Unmarshal(r)
+	case enc{ST_VL, 4}: // TxnSignature
+		t.TxBase.TxnSignature = new(VariableLength)
+		return true, t.TxBase.TxnSignature.Unmarshal(r)
+	case enc{ST_ACCOUNT, 1}: // Account
+		return true, t.TxBase.Account.Unmarshal(r)
+	}
+	return false, nil
+}
+
+func (t *AMMDelete) encodeFields(fw *fieldWriter, nested bool) {
+	fw.setExtra(t.TxBase.Fields)
+	fw.uint16(enc{ST_UINT16, 2}, uint16(t.TxBase.TransactionType)) // TransactionType
+	if t.TxBase.Flags != nil {
+		fw.uint32(enc{ST_UINT32, 2}, uint32((*t.TxBase.Flags))) // Flags
+	}
+	if t.TxBase.SourceTag != nil {
+		fw.uint32(enc{ST_UINT32, 3}, uint32((*t.TxBase.SourceTag))) // SourceTag
+	}
+	fw.uint32(enc{ST_UINT32, 4}, uint32(t.TxBase.Sequence)) // Sequence
+	if t.TxBase.LastLedgerSequence != nil {
+		fw.uint32(enc{ST_UINT32, 27}, uint32((*t.TxBase.LastLedgerSequence))) // LastLedgerSequence
+	}
+	if t.TxBase.TicketSequence != nil {
+		fw.uint32(enc{ST_UINT32, 41}, uint32((*t.TxBase.TicketSequence))) // TicketSequence
+	}
+	if t.TxBase.PreviousTxnID != nil {
+		fw.wire(enc{ST_HASH256, 5}, t.TxBase.PreviousTxnID) // PreviousTxnID
+	}
+	if t.TxBase.AccountTxnID != nil {
+		fw.wire(enc{ST_HASH256, 9}, t.TxBase.AccountTxnID) // AccountTxnID
+	}
+	fw.wire(enc{ST_AMOUNT, 8}, &t.TxBase.Fee) // Fee
+	if t.TxBase.SigningPubKey != nil {
+		fw.wire(enc{ST_VL, 3}, t.TxBase.SigningPubKey) // SigningPubKey
+	}
+	if !fw.ignoreSigningFields && t.TxBase.TxnSignature != nil && len((*t.TxBase.TxnSignature)) > 0 {
+		fw.wire(enc{ST_VL, 4}, t.TxBase.TxnSignature) // TxnSignature
+	}
+	fw.wire(enc{ST_ACCOUNT, 1}, &t.TxBase.Account) // Account
+	if !fw.ignoreSigningFields && len(t.TxBase.Signers) > 0 {
+		fw.header(enc{ST_ARRAY, 3}) // Signers
+		for i := range t.TxBase.Signers {
+			e16 := &t.TxBase.Signers[i]
+			c17 := fw.child()
+			c17.header(enc{ST_OBJECT, 16}) // Signer
+			c18 := c17.child()
+			if e16.Signer.SigningPubKey != nil {
+				c18.wire(enc{ST_VL, 3}, e16.Signer.SigningPubKey) // SigningPubKey
+			}
+			if !fw.ignoreSigningFields && e16.Signer.TxnSignature != nil && len((*e16.Signer.TxnSignature)) > 0 {
+				c18.wire(enc{ST_VL, 4}, e16.Signer.TxnSignature) // TxnSignature
+			}
+			c18.wire(enc{ST_ACCOUNT, 1}, &e16.Signer.Account) // Account
+			c17.close(&c18)
+			c17.end(endOfObject)
+			fw.close(&c17)
+		}
+		fw.end(endOfArray)
+	}
+	if len(t.TxBase.Memos) > 0 {
+		fw.header(enc{ST_ARRAY, 9}) // Memos
+		for i := range t.TxBase.Memos {
+			e19 := &t.TxBase.Memos[i]
+			c20 := fw.child()
+			c20.header(enc{ST_OBJECT, 10}) // Memo
+			c21 := c20.child()
+			if len(e19.Memo.MemoType) > 0 {
+				c21.wire(enc{ST_VL, 12}, &e19.Memo.MemoType) // MemoType
+			}
+			if len(e19.Memo.MemoData) > 0 {
+				c21.wire(enc{ST_VL, 13}, &e19.Memo.MemoData) // MemoData
+			}
+			if len(e19.Memo.MemoFormat) > 0 {
+				c21.wire(enc{ST_VL, 14}, &e19.Memo.MemoFormat) // MemoFormat
+			}
+			c20.close(&c21)
+			c20.end(endOfObject)
+			fw.close(&c20)
+		}
+		fw.end(endOfArray)
+	}
+	fw.wire(enc{ST_ISSUE, 3}, &t.Asset)  // Asset
+	fw.wire(enc{ST_ISSUE, 4}, &t.Asset2) // Asset2
+}
+
+func (t *AMMDelete) decodeField(r Reader, e enc) (bool, error) {
+	switch e {
+	case enc{ST_UINT16, 2}: // TransactionType
+		v, err := readUint16(r)
+		t.TxBase.TransactionType = TransactionType(v)
+		return true, err
+	case enc{ST_UINT32, 2}: // Flags
+		t.TxBase.Flags = new(TransactionFlag)
+		v, err := readUint32(r)
+		*t.TxBase.Flags = TransactionFlag(v)
+		return true, err
+	case enc{ST_UINT32, 3}: // SourceTag
+		t.TxBase.SourceTag = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.SourceTag = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 4}: // Sequence
+		v, err := readUint32(r)
+		t.TxBase.Sequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 27}: // LastLedgerSequence
+		t.TxBase.LastLedgerSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.LastLedgerSequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 41}: // TicketSequence
+		t.TxBase.TicketSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.TicketSequence = uint32(v)
+		return true, err
+	case enc{ST_HASH256, 5}: // PreviousTxnID
+		t.TxBase.PreviousTxnID = new(Hash256)
+		return true, t.TxBase.PreviousTxnID.Unmarshal(r)
+	case enc{ST_HASH256, 9}: // AccountTxnID
+		t.TxBase.AccountTxnID = new(Hash256)
+		return true, t.TxBase.AccountTxnID.Unmarshal(r)
+	case enc{ST_AMOUNT, 8}: // Fee
+		return true, t.TxBase.Fee.Unmarshal(r)
+	case enc{ST_VL, 3}: // SigningPubKey
+		t.TxBase.SigningPubKey = new(PublicKey)
+		return true, t.TxBase.SigningPubKey.Unmarshal(r)
+	case enc{ST_VL, 4}: // TxnSignature
+		t.TxBase.TxnSignature = new(VariableLength)
+		return true, t.TxBase.TxnSignature.Unmarshal(r)
+	case enc{ST_ACCOUNT, 1}: // Account
+		return true, t.TxBase.Account.Unmarshal(r)
+	case enc{ST_ISSUE, 3}: // Asset
+		return true, t.Asset.Unmarshal(r)
+	case enc{ST_ISSUE, 4}: // Asset2
+		return true, t.Asset2.Unmarshal(r)
+	}
+	return false, nil
+}
+
+func (t *AMMDeposit) encodeFields(fw *fieldWriter, nested bool) {
+	fw.setExtra(t.TxBase.Fields)
+	fw.uint16(enc{ST_UINT16, 2}, uint16(t.TxBase.TransactionType)) // TransactionType
+	if t.TradingFee != nil {
+		fw.uint16(enc{ST_UINT16, 5}, uint16((*t.TradingFee))) // TradingFee
+	}
+	if t.TxBase.Flags != nil {
+		fw.uint32(enc{ST_UINT32, 2}, uint32((*t.TxBase.Flags))) // Flags
+	}
+	if t.TxBase.SourceTag != nil {
+		fw.uint32(enc{ST_UINT32, 3}, uint32((*t.TxBase.SourceTag))) // SourceTag
+	}
+	fw.uint32(enc{ST_UINT32, 4}, uint32(t.TxBase.Sequence)) // Sequence
+	if t.TxBase.LastLedgerSequence != nil {
+		fw.uint32(enc{ST_UINT32, 27}, uint32((*t.TxBase.LastLedgerSequence))) // LastLedgerSequence
+	}
+	if t.TxBase.TicketSequence != nil {
+		fw.uint32(enc{ST_UINT32, 41}, uint32((*t.TxBase.TicketSequence))) // TicketSequence
+	}
+	if t.TxBase.PreviousTxnID != nil {
+		fw.wire(enc{ST_HASH256, 5}, t.TxBase.PreviousTxnID) // PreviousTxnID
+	}
+	if t.TxBase.AccountTxnID != nil {
+		fw.wire(enc{ST_HASH256, 9}, t.TxBase.AccountTxnID) // AccountTxnID
+	}
+	if t.Amount != nil {
+		fw.wire(enc{ST_AMOUNT, 1}, t.Amount) // Amount
+	}
+	fw.wire(enc{ST_AMOUNT, 8}, &t.TxBase.Fee) // Fee
+	if t.Amount2 != nil {
+		fw.wire(enc{ST_AMOUNT, 11}, t.Amount2) // Amount2
+	}
+	if t.LPTokenOut != nil {
+		fw.wire(enc{ST_AMOUNT, 25}, t.LPTokenOut) // LPTokenOut
+	}
+	if t.EPrice != nil {
+		fw.wire(enc{ST_AMOUNT, 27}, t.EPrice) // EPrice
+	}
+	if t.TxBase.SigningPubKey != nil {
+		fw.wire(enc{ST_VL, 3}, t.TxBase.SigningPubKey) // SigningPubKey
+	}
+	if !fw.ignoreSigningFields && t.TxBase.TxnSignature != nil && len((*t.TxBase.TxnSignature)) > 0 {
+		fw.wire(enc{ST_VL, 4}, t.TxBase.TxnSignature) // TxnSignature
+	}
+	fw.wire(enc{ST_ACCOUNT, 1}, &t.TxBase.Account) // Account
+	if !fw.ignoreSigningFields && len(t.TxBase.Signers) > 0 {
+		fw.header(enc{ST_ARRAY, 3}) // Signers
+		for i := range t.TxBase.Signers {
+			e22 := &t.TxBase.Signers[i]
+			c23 := fw.child()
+			c23.header(enc{ST_OBJECT, 16}) // Signer
+			c24 := c23.child()
+			if e22.Signer.SigningPubKey != nil {
+				c24.wire(enc{ST_VL, 3}, e22.Signer.SigningPubKey) // SigningPubKey
+			}
+			if !fw.ignoreSigningFields && e22.Signer.TxnSignature != nil && len((*e22.Signer.TxnSignature)) > 0 {
+				c24.wire(enc{ST_VL, 4}, e22.Signer.TxnSignature) // TxnSignature
+			}
+			c24.wire(enc{ST_ACCOUNT, 1}, &e22.Signer.Account) // Account
+			c23.close(&c24)
+			c23.end(endOfObject)
+			fw.close(&c23)
+		}
+		fw.end(endOfArray)
+	}
+	if len(t.TxBase.Memos) > 0 {
+		fw.header(enc{ST_ARRAY, 9}) // Memos
+		for i := range t.TxBase.Memos {
+			e25 := &t.TxBase.Memos[i]
+			c26 := fw.child()
+			c26.header(enc{ST_OBJECT, 10}) // Memo
+			c27 := c26.child()
+			if len(e25.Memo.MemoType) > 0 {
+				c27.wire(enc{ST_VL, 12}, &e25.Memo.MemoType) // MemoType
+			}
+			if len(e25.Memo.MemoData) > 0 {
+				c27.wire(enc{ST_VL, 13}, &e25.Memo.MemoData) // MemoData
+			}
+			if len(e25.Memo.MemoFormat) > 0 {
+				c27.wire(enc{ST_VL, 14}, &e25.Memo.MemoFormat) // MemoFormat
+			}
+			c26.close(&c27)
+			c26.end(endOfObject)
+			fw.close(&c26)
+		}
+		fw.end(endOfArray)
+	}
+	fw.wire(enc{ST_ISSUE, 3}, &t.Asset)  // Asset
+	fw.wire(enc{ST_ISSUE, 4}, &t.Asset2) // Asset2
+}
+
+func (t *AMMDeposit) decodeField(r Reader, e enc) (bool, error) {
+	switch e {
+	case enc{ST_UINT16, 2}: // TransactionType
+		v, err := readUint16(r)
+		t.TxBase.TransactionType = TransactionType(v)
+		return true, err
+	case enc{ST_UINT16, 5}: // TradingFee
+		t.TradingFee = new(uint16)
+		v, err := readUint16(r)
+		*t.TradingFee = uint16(v)
+		return true, err
+	case enc{ST_UINT32, 2}: // Flags
+		t.TxBase.Flags = new(TransactionFlag)
+		v, err := readUint32(r)
+		*t.TxBase.Flags = TransactionFlag(v)
+		return true, err
+	case enc{ST_UINT32, 3}: // SourceTag
+		t.TxBase.SourceTag = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.SourceTag = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 4}: // Sequence
+		v, err := readUint32(r)
+		t.TxBase.Sequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 27}: // LastLedgerSequence
+		t.TxBase.LastLedgerSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.LastLedgerSequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 41}: // TicketSequence
+		t.TxBase.TicketSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.TicketSequence = uint32(v)
+		return true, err
+	case enc{ST_HASH256, 5}: // PreviousTxnID
+		t.TxBase.PreviousTxnID = new(Hash256)
+		return true, t.TxBase.PreviousTxnID.Unmarshal(r)
+	case enc{ST_HASH256, 9}: // AccountTxnID
+		t.TxBase.AccountTxnID = new(Hash256)
+		return true, t.TxBase.AccountTxnID.Unmarshal(r)
+	case enc{ST_AMOUNT, 1}: // Amount
+		t.Amount = new(Amount)
+		return true, t.Amount.Unmarshal(r)
+	case enc{ST_AMOUNT, 8}: // Fee
+		return true, t.TxBase.Fee.Unmarshal(r)
+	case enc{ST_AMOUNT, 11}: // Amount2
+		t.Amount2 = new(Amount)
+		return true, t.Amount2.Unmarshal(r)
+	case enc{ST_AMOUNT, 25}: // LPTokenOut
+		t.LPTokenOut = new(Amount)
+		return true, t.LPTokenOut.Unmarshal(r)
+	case enc{ST_AMOUNT, 27}: // EPrice
+		t.EPrice = new(Amount)
+		return true, t.EPrice.Unmarshal(r)
+	case enc{ST_VL, 3}: // SigningPubKey
+		t.TxBase.SigningPubKey = new(PublicKey)
+		return true, t.TxBase.SigningPubKey.Unmarshal(r)
+	case enc{ST_VL, 4}: // TxnSignature
+		t.TxBase.TxnSignature = new(VariableLength)
+		return true, t.TxBase.TxnSignature.Unmarshal(r)
+	case enc{ST_ACCOUNT, 1}: // Account
+		return true, t.TxBase.Account.Unmarshal(r)
+	case enc{ST_ISSUE, 3}: // Asset
+		return true, t.Asset.Unmarshal(r)
+	case enc{ST_ISSUE, 4}: // Asset2
+		return true, t.Asset2.Unmarshal(r)
+	}
+	return false, nil
+}
+
+func (t *AMMRoot) encodeFields(fw *fieldWriter, nested bool) {
+	fw.setExtra(t.leBase.Fields)
+	if !nested {
+		fw.uint16(enc{ST_UINT16, 1}, uint16(t.leBase.LedgerEntryType)) // LedgerEntryType
+	}
+	if t.TradingFee != nil {
+		fw.uint16(enc{ST_UINT16, 5}, uint16((*t.TradingFee))) // TradingFee
+	}
+	if t.Flags != nil {
+		fw.uint32(enc{ST_UINT32, 2}, uint32((*t.Flags))) // Flags
+	}
+	if t.leBase.PreviousTxnLgrSeq != nil {
+		fw.uint32(enc{ST_UINT32, 5}, uint32((*t.leBase.PreviousTxnLgrSeq))) // PreviousTxnLgrSeq
+	}
+	if t.OwnerNode != nil {
+		fw.uint64(enc{ST_UINT64, 4}, uint64((*t.OwnerNode))) // OwnerNode
+	}
+	if t.leBase.PreviousTxnID != nil {
+		fw.wire(enc{ST_HASH256, 5}, t.leBase.PreviousTxnID) // PreviousTxnID
+	}
+	if t.LPTokenBalance != nil {
+		fw.wire(enc{ST_AMOUNT, 31}, t.LPTokenBalance) // LPTokenBalance
+	}
+	if t.Account != nil {
+		fw.wire(enc{ST_ACCOUNT, 1}, t.Account) // Account
+	}
+	if t.AuctionSlot != nil {
+		fw.header(enc{ST_OBJECT, 26}) // AuctionSlot
+		c31 := fw.child()
+		if (*t.AuctionSlot).DiscountedFee != nil {
+			c31.uint16(enc{ST_UINT16, 6}, uint16((*(*t.AuctionSlot).DiscountedFee))) // DiscountedFee
+		}
+		c31.uint32(enc{ST_UINT32, 10}, uint32((*t.AuctionSlot).Expiration)) // Expiration
+		c31.wire(enc{ST_AMOUNT, 28}, &(*t.AuctionSlot).Price)               // Price
+		c31.wire(enc{ST_ACCOUNT, 1}, &(*t.AuctionSlot).Account)             // Account
+		if len((*t.AuctionSlot).AuthAccounts) > 0 {
+			c31.header(enc{ST_ARRAY, 25}) // AuthAccounts
+			for i := range (*t.AuctionSlot).AuthAccounts {
+				e32 := &(*t.AuctionSlot).AuthAccounts[i]
+				c33 := c31.child()
+				c33.header(enc{ST_OBJECT, 27}) // AuthAccount
+				c34 := c33.child()
+				c34.wire(enc{ST_ACCOUNT, 1}, &e32.AuthAccount.Account) // Account
+				c33.close(&c34)
+				c33.end(endOfObject)
+				c31.close(&c33)
+			}
+			c31.end(endOfArray)
+		}
+		fw.close(&c31)
+		fw.end(endOfObject)
+	}
+	if len(t.VoteSlots) > 0 {
+		fw.header(enc{ST_ARRAY, 12}) // VoteSlots
+		for i := range t.VoteSlots {
+			e28 := &t.VoteSlots[i]
+			c29 := fw.child()
+			c29.header(enc{ST_OBJECT, 25}) // VoteEntry
+			c30 := c29.child()
+			if e28.VoteEntry.TradingFee != nil {
+				c30.uint16(enc{ST_UINT16, 5}, uint16((*e28.VoteEntry.TradingFee))) // TradingFee
+			}
+			c30.uint32(enc{ST_UINT32, 48}, uint32(e28.VoteEntry.VoteWeight)) // VoteWeight
+			c30.wire(enc{ST_ACCOUNT, 1}, &e28.VoteEntry.Account)             // Account
+			c29.close(&c30)
+			c29.end(endOfObject)
+			fw.close(&c29)
+		}
+		fw.end(endOfArray)
+	}
+	if t.Asset != nil {
+		fw.wire(enc{ST_ISSUE, 3}, t.Asset) // Asset
+	}
+	if t.Asset2 != nil {
+		fw.wire(enc{ST_ISSUE, 4}, t.Asset2) // Asset2
+	}
+}
+
+func (t *AMMRoot) decodeField(r Reader, e enc) (bool, error) {
+	switch e {
+	case enc{ST_UINT16, 1}: // LedgerEntryType
+		v, err := readUint16(r)
+		t.leBase.LedgerEntryType = LedgerEntryType(v)
+		return true, err
+	case enc{ST_UINT16, 5}: // TradingFee
+		t.TradingFee = new(uint16)
+		v, err := readUint16(r)
+		*t.TradingFee = uint16(v)
+		return true, err
+	case enc{ST_UINT32, 2}: // Flags
+		t.Flags = new(LedgerEntryFlag)
+		v, err := readUint32(r)
+		*t.Flags = LedgerEntryFlag(v)
+		return true, err
+	case enc{ST_UINT32, 5}: // PreviousTxnLgrSeq
+		t.leBase.PreviousTxnLgrSeq = new(uint32)
+		v, err := readUint32(r)
+		*t.leBase.PreviousTxnLgrSeq = uint32(v)
+		return true, err
+	case enc{ST_UINT64, 4}: // OwnerNode
+		t.OwnerNode = new(NodeIndex)
+		v, err := readUint64(r)
+		*t.OwnerNode = NodeIndex(v)
+		return true, err
+	case enc{ST_HASH256, 5}: // PreviousTxnID
+		t.leBase.PreviousTxnID = new(Hash256)
+		return true, t.leBase.PreviousTxnID.Unmarshal(r)
+	case enc{ST_HASH256, 6}: // LedgerIndex
+		t.leBase.LedgerIndex = new(Hash256)
+		return true, t.leBase.LedgerIndex.Unmarshal(r)
+	case enc{ST_AMOUNT, 31}: // LPTokenBalance
+		t.LPTokenBalance = new(Amount)
+		return true, t.LPTokenBalance.Unmarshal(r)
+	case enc{ST_ACCOUNT, 1}: // Account
+		t.Account = new(Account)
+		return true, t.Account.Unmarshal(r)
+	case enc{ST_ISSUE, 3}: // Asset
+		t.Asset = new(Issue)
+		return true, t.Asset.Unmarshal(r)
+	case enc{ST_ISSUE, 4}: // Asset2
+		t.Asset2 = new(Issue)
+		return true, t.Asset2.Unmarshal(r)
+	}
+	return false, nil
+}
+
+func (t *AMMVote) encodeFields(fw *fieldWriter, nested bool) {
+	fw.setExtra(t.TxBase.Fields)
+	fw.uint16(enc{ST_UINT16, 2}, uint16(t.TxBase.TransactionType)) // TransactionType
+	fw.uint16(enc{ST_UINT16, 5}, uint16(t.TradingFee))             // TradingFee
+	if t.TxBase.Flags != nil {
+		fw.uint32(enc{ST_UINT32, 2}, uint32((*t.TxBase.Flags))) // Flags
+	}
+	if t.TxBase.SourceTag != nil {
+		fw.uint32(enc{ST_UINT32, 3}, uint32((*t.TxBase.SourceTag))) // SourceTag
+	}
+	fw.uint32(enc{ST_UINT32, 4}, uint32(t.TxBase.Sequence)) // Sequence
+	if t.TxBase.LastLedgerSequence != nil {
+		fw.uint32(enc{ST_UINT32, 27}, uint32((*t.TxBase.LastLedgerSequence))) // LastLedgerSequence
+	}
+	if t.TxBase.TicketSequence != nil {
+		fw.uint32(enc{ST_UINT32, 41}, uint32((*t.TxBase.TicketSequence))) // TicketSequence
+	}
+	if t.TxBase.PreviousTxnID != nil {
+		fw.wire(enc{ST_HASH256, 5}, t.TxBase.PreviousTxnID) // PreviousTxnID
+	}
+	if t.TxBase.AccountTxnID != nil {
+		fw.wire(enc{ST_HASH256, 9}, t.TxBase.AccountTxnID) // AccountTxnID
+	}
+	fw.wire(enc{ST_AMOUNT, 8}, &t.TxBase.Fee) // Fee
+	if t.TxBase.SigningPubKey != nil {
+		fw.wire(enc{ST_VL, 3}, t.TxBase.SigningPubKey) // SigningPubKey
+	}
+	if !fw.ignoreSigningFields && t.TxBase.TxnSignature != nil && len((*t.TxBase.TxnSignature)) > 0 {
+		fw.wire(enc{ST_VL, 4}, t.TxBase.TxnSignature) // TxnSignature
+	}
+	fw.wire(enc{ST_ACCOUNT, 1}, &t.TxBase.Account) // Account
+	if !fw.ignoreSigningFields && len(t.TxBase.Signers) > 0 {
+		fw.header(enc{ST_ARRAY, 3}) // Signers
+		for i := range t.TxBase.Signers {
+			e35 := &t.TxBase.Signers[i]
+			c36 := fw.child()
+			c36.header(enc{ST_OBJECT, 16}) // Signer
+			c37 := c36.child()
+			if e35.Signer.SigningPubKey != nil {
+				c37.wire(enc{ST_VL, 3}, e35.Signer.SigningPubKey) // SigningPubKey
+			}
+			if !fw.ignoreSigningFields && e35.Signer.TxnSignature != nil && len((*e35.Signer.TxnSignature)) > 0 {
+				c37.wire(enc{ST_VL, 4}, e35.Signer.TxnSignature) // TxnSignature
+			}
+			c37.wire(enc{ST_ACCOUNT, 1}, &e35.Signer.Account) // Account
+			c36.close(&c37)
+			c36.end(endOfObject)
+			fw.close(&c36)
+		}
+		fw.end(endOfArray)
+	}
+	if len(t.TxBase.Memos) > 0 {
+		fw.header(enc{ST_ARRAY, 9}) // Memos
+		for i := range t.TxBase.Memos {
+			e38 := &t.TxBase.Memos[i]
+			c39 := fw.child()
+			c39.header(enc{ST_OBJECT, 10}) // Memo
+			c40 := c39.child()
+			if len(e38.Memo.MemoType) > 0 {
+				c40.wire(enc{ST_VL, 12}, &e38.Memo.MemoType) // MemoType
+			}
+			if len(e38.Memo.MemoData) > 0 {
+				c40.wire(enc{ST_VL, 13}, &e38.Memo.MemoData) // MemoData
+			}
+			if len(e38.Memo.MemoFormat) > 0 {
+				c40.wire(enc{ST_VL, 14}, &e38.Memo.MemoFormat) // MemoFormat
+			}
+			c39.close(&c40)
+			c39.end(endOfObject)
+			fw.close(&c39)
+		}
+		fw.end(endOfArray)
+	}
+	fw.wire(enc{ST_ISSUE, 3}, &t.Asset)  // Asset
+	fw.wire(enc{ST_ISSUE, 4}, &t.Asset2) // Asset2
+}
+
+func (t *AMMVote) decodeField(r Reader, e enc) (bool, error) {
+	switch e {
+	case enc{ST_UINT16, 2}: // TransactionType
+		v, err := readUint16(r)
+		t.TxBase.TransactionType = TransactionType(v)
+		return true, err
+	case enc{ST_UINT16, 5}: // TradingFee
+		v, err := readUint16(r)
+		t.TradingFee = uint16(v)
+		return true, err
+	case enc{ST_UINT32, 2}: // Flags
+		t.TxBase.Flags = new(TransactionFlag)
+		v, err := readUint32(r)
+		*t.TxBase.Flags = TransactionFlag(v)
+		return true, err
+	case enc{ST_UINT32, 3}: // SourceTag
+		t.TxBase.SourceTag = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.SourceTag = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 4}: // Sequence
+		v, err := readUint32(r)
+		t.TxBase.Sequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 27}: // LastLedgerSequence
+		t.TxBase.LastLedgerSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.LastLedgerSequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 41}: // TicketSequence
+		t.TxBase.TicketSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.TicketSequence = uint32(v)
+		return true, err
+	case enc{ST_HASH256, 5}: // PreviousTxnID
+		t.TxBase.PreviousTxnID = new(Hash256)
+		return true, t.TxBase.PreviousTxnID.Unmarshal(r)
+	case enc{ST_HASH256, 9}: // AccountTxnID
+		t.TxBase.AccountTxnID = new(Hash256)
+		return true, t.TxBase.AccountTxnID.Unmarshal(r)
+	case enc{ST_AMOUNT, 8}: // Fee
+		return true, t.TxBase.Fee.Unmarshal(r)
+	case enc{ST_VL, 3}: // SigningPubKey
+		t.TxBase.SigningPubKey = new(PublicKey)
+		return true, t.TxBase.SigningPubKey.Unmarshal(r)
+	case enc{ST_VL, 4}: // TxnSignature
+		t.TxBase.TxnSignature = new(VariableLength)
+		return true, t.TxBase.TxnSignature.Unmarshal(r)
+	case enc{ST_ACCOUNT, 1}: // Account
+		return true, t.TxBase.Account.Unmarshal(r)
+	case enc{ST_ISSUE, 3}: // Asset
+		return true, t.Asset.Unmarshal(r)
+	case enc{ST_ISSUE, 4}: // Asset2
+		return true, t.Asset2.Unmarshal(r)
+	}
+	return false, nil
+}
+
+func (t *AMMWithdraw) encodeFields(fw *fieldWriter, nested bool) {
+	fw.setExtra(t.TxBase.Fields)
+	fw.uint16(enc{ST_UINT16, 2}, uint16(t.TxBase.TransactionType)) // TransactionType
+	if t.TxBase.Flags != nil {
+		fw.uint32(enc{ST_UINT32, 2}, uint32((*t.TxBase.Flags))) // Flags
+	}
+	if t.TxBase.SourceTag != nil {
+		fw.uint32(enc{ST_UINT32, 3}, uint32((*t.TxBase.SourceTag))) // SourceTag
+	}
+	fw.uint32(enc{ST_UINT32, 4}, uint32(t.TxBase.Sequence)) // Sequence
+	if t.TxBase.LastLedgerSequence != nil {
+		fw.uint32(enc{ST_UINT32, 27}, uint32((*t.TxBase.LastLedgerSequence))) // LastLedgerSequence
+	}
+	if t.TxBase.TicketSequence != nil {
+		fw.uint32(enc{ST_UINT32, 41}, uint32((*t.TxBase.TicketSequence))) // TicketSequence
+	}
+	if t.TxBase.PreviousTxnID != nil {
+		fw.wire(enc{ST_HASH256, 5}, t.TxBase.PreviousTxnID) // PreviousTxnID
+	}
+	if t.TxBase.AccountTxnID != nil {
+		fw.wire(enc{ST_HASH256, 9}, t.TxBase.AccountTxnID) // AccountTxnID
+	}
+	if t.Amount != nil {
+		fw.wire(enc{ST_AMOUNT, 1}, t.Amount) // Amount
+	}
+	fw.wire(enc{ST_AMOUNT, 8}, &t.TxBase.Fee) // Fee
+	if t.Amount2 != nil {
+		fw.wire(enc{ST_AMOUNT, 11}, t.Amount2) // Amount2
+	}
+	if t.LPTokenIn != nil {
+		fw.wire(enc{ST_AMOUNT, 26}, t.LPTokenIn) // LPTokenIn
+	}
+	if t.EPrice != nil {
+		fw.wire(enc{ST_AMOUNT, 27}, t.EPrice) // EPrice
+	}
+	if t.TxBase.SigningPubKey != nil {
+		fw.wire(enc{ST_VL, 3}, t.TxBase.SigningPubKey) // SigningPubKey
+	}
+	if !fw.ignoreSigningFields && t.TxBase.TxnSignature != nil && len((*t.TxBase.TxnSignature)) > 0 {
+		fw.wire(enc{ST_VL, 4}, t.TxBase.TxnSignature) // TxnSignature
+	}
+	fw.wire(enc{ST_ACCOUNT, 1}, &t.TxBase.Account) // Account
+	if !fw.ignoreSigningFields && len(t.TxBase.Signers) > 0 {
+		fw.header(enc{ST_ARRAY, 3}) // Signers
+		for i := range t.TxBase.Signers {
+			e41 := &t.TxBase.Signers[i]
+			c42 := fw.child()
+			c42.header(enc{ST_OBJECT, 16}) // Signer
+			c43 := c42.child()
+			if e41.Signer.SigningPubKey != nil {
+				c43.wire(enc{ST_VL, 3}, e41.Signer.SigningPubKey) // SigningPubKey
+			}
+			if !fw.ignoreSigningFields && e41.Signer.TxnSignature != nil && len((*e41.Signer.TxnSignature)) > 0 {
+				c43.wire(enc{ST_VL, 4}, e41.Signer.TxnSignature) // TxnSignature
+			}
+			c43.wire(enc{ST_ACCOUNT, 1}, &e41.Signer.Account) // Account
+			c42.close(&c43)
+			c42.end(endOfObject)
+			fw.close(&c42)
+		}
+		fw.end(endOfArray)
+	}
+	if len(t.TxBase.Memos) > 0 {
+		fw.header(enc{ST_ARRAY, 9}) // Memos
+		for i := range t.TxBase.Memos {
+			e44 := &t.TxBase.Memos[i]
+			c45 := fw.child()
+			c45.header(enc{ST_OBJECT, 10}) // Memo
+			c46 := c45.child()
+			if len(e44.Memo.MemoType) > 0 {
+				c46.wire(enc{ST_VL, 12}, &e44.Memo.MemoType) // MemoType
+			}
+			if len(e44.Memo.MemoData) > 0 {
+				c46.wire(enc{ST_VL, 13}, &e44.Memo.MemoData) // MemoData
+			}
+			if len(e44.Memo.MemoFormat) > 0 {
+				c46.wire(enc{ST_VL, 14}, &e44.Memo.MemoFormat) // MemoFormat
+			}
+			c45.close(&c46)
+			c45.end(endOfObject)
+			fw.close(&c45)
+		}
+		fw.end(endOfArray)
+	}
+	fw.wire(enc{ST_ISSUE, 3}, &t.Asset)  // Asset
+	fw.wire(enc{ST_ISSUE, 4}, &t.Asset2) // Asset2
+}
+
+func (t *AMMWithdraw) decodeField(r Reader, e enc) (bool, error) {
+	switch e {
+	case enc{ST_UINT16, 2}: // TransactionType
+		v, err := readUint16(r)
+		t.TxBase.TransactionType = TransactionType(v)
+		return true, err
+	case enc{ST_UINT32, 2}: // Flags
+		t.TxBase.Flags = new(TransactionFlag)
+		v, err := readUint32(r)
+		*t.TxBase.Flags = TransactionFlag(v)
+		return true, err
+	case enc{ST_UINT32, 3}: // SourceTag
+		t.TxBase.SourceTag = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.SourceTag = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 4}: // Sequence
+		v, err := readUint32(r)
+		t.TxBase.Sequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 27}: // LastLedgerSequence
+		t.TxBase.LastLedgerSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.LastLedgerSequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 41}: // TicketSequence
+		t.TxBase.TicketSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.TicketSequence = uint32(v)
+		return true, err
+	case enc{ST_HASH256, 5}: // PreviousTxnID
+		t.TxBase.PreviousTxnID = new(Hash256)
+		return true, t.TxBase.PreviousTxnID.Unmarshal(r)
+	case enc{ST_HASH256, 9}: // AccountTxnID
+		t.TxBase.AccountTxnID = new(Hash256)
+		return true, t.TxBase.AccountTxnID.Unmarshal(r)
+	case enc{ST_AMOUNT, 1}: // Amount
+		t.Amount = new(Amount)
+		return true, t.Amount.Unmarshal(r)
+	case enc{ST_AMOUNT, 8}: // Fee
+		return true, t.TxBase.Fee.Unmarshal(r)
+	case enc{ST_AMOUNT, 11}: // Amount2
+		t.Amount2 = new(Amount)
+		return true, t.Amount2.Unmarshal(r)
+	case enc{ST_AMOUNT, 26}: // LPTokenIn
+		t.LPTokenIn = new(Amount)
+		return true, t.LPTokenIn.Unmarshal(r)
+	case enc{ST_AMOUNT, 27}: // EPrice
+		t.EPrice = new(Amount)
+		return true, t.EPrice.Unmarshal(r)
+	case enc{ST_VL, 3}: // SigningPubKey
+		t.TxBase.SigningPubKey = new(PublicKey)
+		return true, t.TxBase.SigningPubKey.Unmarshal(r)
+	case enc{ST_VL, 4}: // TxnSignature
+		t.TxBase.TxnSignature = new(VariableLength)
+		return true, t.TxBase.TxnSignature.Unmarshal(r)
+	case enc{ST_ACCOUNT, 1}: // Account
+		return true, t.TxBase.Account.Unmarshal(r)
+	case enc{ST_ISSUE, 3}: // Asset
+		return true, t.Asset.Unmarshal(r)
+	case enc{ST_ISSUE, 4}: // Asset2
+		return true, t.Asset2.Unmarshal(r)
+	}
+	return false, nil
+}
+
+func (t *AccountDelete) encodeFields(fw *fieldWriter, nested bool) {
+	fw.setExtra(t.TxBase.Fields)
+	fw.uint16(enc{ST_UINT16, 2}, uint16(t.TxBase.TransactionType)) // TransactionType
+	if t.TxBase.Flags != nil {
+		fw.uint32(enc{ST_UINT32, 2}, uint32((*t.TxBase.Flags))) // Flags
+	}
+	if t.TxBase.SourceTag != nil {
+		fw.uint32(enc{ST_UINT32, 3}, uint32((*t.TxBase.SourceTag))) // SourceTag
+	}
+	fw.uint32(enc{ST_UINT32, 4}, uint32(t.TxBase.Sequence)) // Sequence
+	if t.DestinationTag != nil {
+		fw.uint32(enc{ST_UINT32, 14}, uint32((*t.DestinationTag))) // DestinationTag
+	}
+	if t.TxBase.LastLedgerSequence != nil {
+		fw.uint32(enc{ST_UINT32, 27}, uint32((*t.TxBase.LastLedgerSequence))) // LastLedgerSequence
+	}
+	if t.TxBase.TicketSequence != nil {
+		fw.uint32(enc{ST_UINT32, 41}, uint32((*t.TxBase.TicketSequence))) // TicketSequence
+	}
+	if t.TxBase.PreviousTxnID != nil {
+		fw.wire(enc{ST_HASH256, 5}, t.TxBase.PreviousTxnID) // PreviousTxnID
+	}
+	if t.TxBase.AccountTxnID != nil {
+		fw.wire(enc{ST_HASH256, 9}, t.TxBase.AccountTxnID) // AccountTxnID
+	}
+	fw.wire(enc{ST_AMOUNT, 8}, &t.TxBase.Fee) // Fee
+	if t.TxBase.SigningPubKey != nil {
+		fw.wire(enc{ST_VL, 3}, t.TxBase.SigningPubKey) // SigningPubKey
+	}
+	if !fw.ignoreSigningFields && t.TxBase.TxnSignature != nil && len((*t.TxBase.TxnSignature)) > 0 {
+		fw.wire(enc{ST_VL, 4}, t.TxBase.TxnSignature) // TxnSignature
+	}
+	fw.wire(enc{ST_ACCOUNT, 1}, &t.TxBase.Account) // Account
+	fw.wire(enc{ST_ACCOUNT, 3}, &t.Destination)    // Destination
+	if !fw.ignoreSigningFields && len(t.TxBase.Signers) > 0 {
+		fw.header(enc{ST_ARRAY, 3}) // Signers
+		for i := range t.TxBase.Signers {
+			e47 := &t.TxBase.Signers[i]
+			c48 := fw.child()
+			c48.header(enc{ST_OBJECT, 16}) // Signer
+			c49 := c48.child()
+			if e47.Signer.SigningPubKey != nil {
+				c49.wire(enc{ST_VL, 3}, e47.Signer.SigningPubKey) // SigningPubKey
+			}
+			if !fw.ignoreSigningFields && e47.Signer.TxnSignature != nil && len((*e47.Signer.TxnSignature)) > 0 {
+				c49.wire(enc{ST_VL, 4}, e47.Signer.TxnSignature) // TxnSignature
+			}
+			c49.wire(enc{ST_ACCOUNT, 1}, &e47.Signer.Account) // Account
+			c48.close(&c49)
+			c48.end(endOfObject)
+			fw.close(&c48)
+		}
+		fw.end(endOfArray)
+	}
+	if len(t.TxBase.Memos) > 0 {
+		fw.header(enc{ST_ARRAY, 9}) // Memos
+		for i := range t.TxBase.Memos {
+			e50 := &t.TxBase.Memos[i]
+			c51 := fw.child()
+			c51.header(enc{ST_OBJECT, 10}) // Memo
+			c52 := c51.child()
+			if len(e50.Memo.MemoType) > 0 {
+				c52.wire(enc{ST_VL, 12}, &e50.Memo.MemoType) // MemoType
+			}
+			if len(e50.Memo.MemoData) > 0 {
+				c52.wire(enc{ST_VL, 13}, &e50.Memo.MemoData) // MemoData
+			}
+			if len(e50.Memo.MemoFormat) > 0 {
+				c52.wire(enc{ST_VL, 14}, &e50.Memo.MemoFormat) // MemoFormat
+			}
+			c51.close(&c52)
+			c51.end(endOfObject)
+			fw.close(&c51)
+		}
+		fw.end(endOfArray)
+	}
+}
+
+func (t *AccountDelete) decodeField(r Reader, e enc) (bool, error) {
+	switch e {
+	case enc{ST_UINT16, 2}: // TransactionType
+		v, err := readUint16(r)
+		t.TxBase.TransactionType = TransactionType(v)
+		return true, err
+	case enc{ST_UINT32, 2}: // Flags
+		t.TxBase.Flags = new(TransactionFlag)
+		v, err := readUint32(r)
+		*t.TxBase.Flags = TransactionFlag(v)
+		return true, err
+	case enc{ST_UINT32, 3}: // SourceTag
+		t.TxBase.SourceTag = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.SourceTag = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 4}: // Sequence
+		v, err := readUint32(r)
+		t.TxBase.Sequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 14}: // DestinationTag
+		t.DestinationTag = new(uint32)
+		v, err := readUint32(r)
+		*t.DestinationTag = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 27}: // LastLedgerSequence
+		t.TxBase.LastLedgerSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.LastLedgerSequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 41}: // TicketSequence
+		t.TxBase.TicketSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.TicketSequence = uint32(v)
+		return true, err
+	case enc{ST_HASH256, 5}: // PreviousTxnID
+		t.TxBase.PreviousTxnID = new(Hash256)
+		return true, t.TxBase.PreviousTxnID.Unmarshal(r)
+	case enc{ST_HASH256, 9}: // AccountTxnID
+		t.TxBase.AccountTxnID = new(Hash256)
+		return true, t.TxBase.AccountTxnID.Unmarshal(r)
+	case enc{ST_AMOUNT, 8}: // Fee
+		return true, t.TxBase.Fee.Unmarshal(r)
+	case enc{ST_VL, 3}: // SigningPubKey
+		t.TxBase.SigningPubKey = new(PublicKey)
+		return true, t.TxBase.SigningPubKey.Unmarshal(r)
+	case enc{ST_VL, 4}: // TxnSignature
+		t.TxBase.TxnSignature = new(VariableLength)
+		return true, t.TxBase.TxnSignature.Unmarshal(r)
+	case enc{ST_ACCOUNT, 1}: // Account
+		return true, t.TxBase.Account.Unmarshal(r)
+	case enc{ST_ACCOUNT, 3}: // Destination
+		return true, t.Destination.Unmarshal(r)
+	}
+	return false, nil
+}
+
+func (t *AccountRoot) encodeFields(fw *fieldWriter, nested bool) {
+	fw.setExtra(t.leBase.Fields)
+	if !nested {
+		fw.uint16(enc{ST_UINT16, 1}, uint16(t.leBase.LedgerEntryType)) // LedgerEntryType
+	}
+	if t.Flags != nil {
+		fw.uint32(enc{ST_UINT32, 2}, uint32((*t.Flags))) // Flags
+	}
+	if t.Sequence != nil {
+		fw.uint32(enc{ST_UINT32, 4}, uint32((*t.Sequence))) // Sequence
+	}
+	if t.leBase.PreviousTxnLgrSeq != nil {
+		fw.uint32(enc{ST_UINT32, 5}, uint32((*t.leBase.PreviousTxnLgrSeq))) // PreviousTxnLgrSeq
+	}
+	if t.TransferRate != nil {
+		fw.uint32(enc{ST_UINT32, 11}, uint32((*t.TransferRate))) // TransferRate
+	}
+	if t.WalletSize != nil {
+		fw.uint32(enc{ST_UINT32, 12}, uint32((*t.WalletSize))) // WalletSize
+	}
+	if t.OwnerCount != nil {
+		fw.uint32(enc{ST_UINT32, 13}, uint32((*t.OwnerCount))) // OwnerCount
+	}
+	if t.TicketCount != nil {
+		fw.uint32(enc{ST_UINT32, 40}, uint32((*t.TicketCount))) // TicketCount
+	}
+	if t.MintedNFTokens != nil {
+		fw.uint32(enc{ST_UINT32, 43}, uint32((*t.MintedNFTokens))) // MintedNFTokens
+	}
+	if t.BurnedNFTokens != nil {
+		fw.uint32(enc{ST_UINT32, 44}, uint32((*t.BurnedNFTokens))) // BurnedNFTokens
+	}
+	if t.FirstNFTokenSequence != nil {
+		fw.uint32(enc{ST_UINT32, 50}, uint32((*t.FirstNFTokenSequence))) // FirstNFTokenSequence
+	}
+	if t.EmailHash != nil {
+		fw.wire(enc{ST_HASH128, 1}, t.EmailHash) // EmailHash
+	}
+	if t.leBase.PreviousTxnID != nil {
+		fw.wire(enc{ST_HASH256, 5}, t.leBase.PreviousTxnID) // PreviousTxnID
+	}
+	if t.WalletLocator != nil {
+		fw.wire(enc{ST_HASH256, 7}, t.WalletLocator) // WalletLocator
+	}
+	if t.AccountTxnID != nil {
+		fw.wire(enc{ST_HASH256, 9}, t.AccountTxnID) // AccountTxnID
+	}
+	if t.AMMID != nil {
+		fw.wire(enc{ST_HASH256, 14}, t.AMMID) // AMMID
+	}
+	if t.Balance != nil {
+		fw.wire(enc{ST_AMOUNT, 2}, t.Balance) // Balance
+	}
+	if t.MessageKey != nil && len((*t.MessageKey)) > 0 {
+		fw.wire(enc{ST_VL, 2}, t.MessageKey) // MessageKey
+	}
+	if t.Domain != nil && len((*t.Domain)) > 0 {
+		fw.wire(enc{ST_VL, 7}, t.Domain) // Domain
+	}
+	if t.Account != nil {
+		fw.wire(enc{ST_ACCOUNT, 1}, t.Account) // Account
+	}
+	if t.RegularKey != nil {
+		fw.wire(enc{ST_ACCOUNT, 8}, t.RegularKey) // RegularKey
+	}
+	if t.NFTokenMinter != nil {
+		fw.wire(enc{ST_ACCOUNT, 9}, t.NFTokenMinter) // NFTokenMinter
+	}
+	if !fw.ignoreSigningFields && t.Signers != nil && len((*t.Signers)) > 0 {
+		fw.unsupported("Signers")
+	}
+	if t.TickSize != nil {
+		fw.uint8(enc{ST_UINT8, 16}, uint8((*t.TickSize))) // TickSize
+	}
+}
+
+func (t *AccountRoot) decodeField(r Reader, e enc) (bool, error) {
+	switch e {
+	case enc{ST_UINT16, 1}: // LedgerEntryType
+		v, err := readUint16(r)
+		t.leBase.LedgerEntryType = LedgerEntryType(v)
+		return true, err
+	case enc{ST_UINT32, 2}: // Flags
+		t.Flags = new(LedgerEntryFlag)
+		v, err := readUint32(r)
+		*t.Flags = LedgerEntryFlag(v)
+		return true, err
+	case enc{ST_UINT32, 4}: // Sequence
+		t.Sequence = new(uint32)
+		v, err := readUint32(r)
+		*t.Sequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 5}: // PreviousTxnLgrSeq
+		t.leBase.PreviousTxnLgrSeq = new(uint32)
+		v, err := readUint32(r)
+		*t.leBase.PreviousTxnLgrSeq = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 11}: // TransferRate
+		t.TransferRate = new(uint32)
+		v, err := readUint32(r)
+		*t.TransferRate = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 12}: // WalletSize
+		t.WalletSize = new(uint32)
+		v, err := readUint32(r)
+		*t.WalletSize = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 13}: // OwnerCount
+		t.OwnerCount = new(uint32)
+		v, err := readUint32(r)
+		*t.OwnerCount = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 40}: // TicketCount
+		t.TicketCount = new(uint32)
+		v, err := readUint32(r)
+		*t.TicketCount = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 43}: // MintedNFTokens
+		t.MintedNFTokens = new(uint32)
+		v, err := readUint32(r)
+		*t.MintedNFTokens = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 44}: // BurnedNFTokens
+		t.BurnedNFTokens = new(uint32)
+		v, err := readUint32(r)
+		*t.BurnedNFTokens = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 50}: // FirstNFTokenSequence
+		t.FirstNFTokenSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.FirstNFTokenSequence = uint32(v)
+		return true, err
+	case enc{ST_HASH128, 1}: // EmailHash
+		t.EmailHash = new(Hash128)
+		return true, t.EmailHash.Unmarshal(r)
+	case enc{ST_HASH256, 5}: // PreviousTxnID
+		t.leBase.PreviousTxnID = new(Hash256)
+		return true, t.leBase.PreviousTxnID.Unmarshal(r)
+	case enc{ST_HASH256, 6}: // LedgerIndex
+		t.leBase.LedgerIndex = new(Hash256)
+		return true, t.leBase.LedgerIndex.Unmarshal(r)
+	case enc{ST_HASH256, 7}: // WalletLocator
+		t.WalletLocator = new(Hash256)
+		return true, t.WalletLocator.Unmarshal(r)
+	case enc{ST_HASH256, 9}: // AccountTxnID
+		t.AccountTxnID = new(Hash256)
+		return true, t.AccountTxnID.Unmarshal(r)
+	case enc{ST_HASH256, 14}: // AMMID
+		t.AMMID = new(Hash256)
+		return true, t.AMMID.Unmarshal(r)
+	case enc{ST_AMOUNT, 2}: // Balance
+		t.Balance = new(Value)
+		return true, t.Balance.Unmarshal(r)
+	case enc{ST_VL, 2}: // MessageKey
+		t.MessageKey = new(VariableLength)
+		return true, t.MessageKey.Unmarshal(r)
+	case enc{ST_VL, 7}: // Domain
+		t.Domain = new(VariableLength)
+		return true, t.Domain.Unmarshal(r)
+	case enc{ST_ACCOUNT, 1}: // Account
+		t.Account = new(Account)
+		return true, t.Account.Unmarshal(r)
+	case enc{ST_ACCOUNT, 8}: // RegularKey
+		t.RegularKey = new(RegularKey)
+		return true, t.RegularKey.Unmarshal(r)
+	case enc{ST_ACCOUNT, 9}: // NFTokenMinter
+		t.NFTokenMinter = new(Account)
+		return true, t.NFTokenMinter.Unmarshal(r)
+	case enc{ST_UINT8, 16}: // TickSize
+		t.TickSize = new(uint8)
+		v, err := readUint8(r)
+		*t.TickSize = uint8(v)
+		return true, err
+	}
+	return false, nil
+}
+
+func (t *AccountSet) encodeFields(fw *fieldWriter, nested bool) {
+	fw.setExtra(t.TxBase.Fields)
+	fw.uint16(enc{ST_UINT16, 2}, uint16(t.TxBase.TransactionType)) // TransactionType
+	if t.TxBase.Flags != nil {
+		fw.uint32(enc{ST_UINT32, 2}, uint32((*t.TxBase.Flags))) // Flags
+	}
+	if t.TxBase.SourceTag != nil {
+		fw.uint32(enc{ST_UINT32, 3}, uint32((*t.TxBase.SourceTag))) // SourceTag
+	}
+	fw.uint32(enc{ST_UINT32, 4}, uint32(t.TxBase.Sequence)) // Sequence
+	if t.TransferRate != nil {
+		fw.uint32(enc{ST_UINT32, 11}, uint32((*t.TransferRate))) // TransferRate
+	}
+	if t.WalletSize != nil {
+		fw.uint32(enc{ST_UINT32, 12}, uint32((*t.WalletSize))) // WalletSize
+	}
+	if t.TxBase.LastLedgerSequence != nil {
+		fw.uint32(enc{ST_UINT32, 27}, uint32((*t.TxBase.LastLedgerSequence))) // LastLedgerSequence
+	}
+	if t.SetFlag != nil {
+		fw.uint32(enc{ST_UINT32, 33}, uint32((*t.SetFlag))) // SetFlag
+	}
+	if t.ClearFlag != nil {
+		fw.uint32(enc{ST_UINT32, 34}, uint32((*t.ClearFlag))) // ClearFlag
+	}
+	if t.TxBase.TicketSequence != nil {
+		fw.uint32(enc{ST_UINT32, 41}, uint32((*t.TxBase.TicketSequence))) // TicketSequence
+	}
+	if t.EmailHash != nil {
+		fw.wire(enc{ST_HASH128, 1}, t.EmailHash) // EmailHash
+	}
+	if t.TxBase.PreviousTxnID != nil {
+		fw.wire(enc{ST_HASH256, 5}, t.TxBase.PreviousTxnID) // PreviousTxnID
+	}
+	if t.WalletLocator != nil {
+		fw.wire(enc{ST_HASH256, 7}, t.WalletLocator) // WalletLocator
+	}
+	if t.TxBase.AccountTxnID != nil {
+		fw.wire(enc{ST_HASH256, 9}, t.TxBase.AccountTxnID) // AccountTxnID
+	}
+	fw.wire(enc{ST_AMOUNT, 8}, &t.TxBase.Fee) // Fee
+	if t.MessageKey != nil && len((*t.MessageKey)) > 0 {
+		fw.wire(enc{ST_VL, 2}, t.MessageKey) // MessageKey
+	}
+	if t.TxBase.SigningPubKey != nil {
+		fw.wire(enc{ST_VL, 3}, t.TxBase.SigningPubKey) // SigningPubKey
+	}
+	if !fw.ignoreSigningFields && t.TxBase.TxnSignature != nil && len((*t.TxBase.TxnSignature)) > 0 {
+		fw.wire(enc{ST_VL, 4}, t.TxBase.TxnSignature) // TxnSignature
+	}
+	if t.Domain != nil && len((*t.Domain)) > 0 {
+		fw.wire(enc{ST_VL, 7}, t.Domain) // Domain
+	}
+	fw.wire(enc{ST_ACCOUNT, 1}, &t.TxBase.Account) // Account
+	if !fw.ignoreSigningFields && len(t.TxBase.Signers) > 0 {
+		fw.header(enc{ST_ARRAY, 3}) // Signers
+		for i := range t.TxBase.Signers {
+			e53 := &t.TxBase.Signers[i]
+			c54 := fw.child()
+			c54.header(enc{ST_OBJECT, 16}) // Signer
+			c55 := c54.child()
+			if e53.Signer.SigningPubKey != nil {
+				c55.wire(enc{ST_VL, 3}, e53.Signer.SigningPubKey) // SigningPubKey
+			}
+			if !fw.ignoreSigningFields && e53.Signer.TxnSignature != nil && len((*e53.Signer.TxnSignature)) > 0 {
+				c55.wire(enc{ST_VL, 4}, e53.Signer.TxnSignature) // TxnSignature
+			}
+			c55.wire(enc{ST_ACCOUNT, 1}, &e53.Signer.Account) // Account
+			c54.close(&c55)
+			c54.end(endOfObject)
+			fw.close(&c54)
+		}
+		fw.end(endOfArray)
+	}
+	if len(t.TxBase.Memos) > 0 {
+		fw.header(enc{ST_ARRAY, 9}) // Memos
+		for i := range t.TxBase.Memos {
+			e56 := &t.TxBase.Memos[i]
+			c57 := fw.child()
+			c57.header(enc{ST_OBJECT, 10}) // Memo
+			c58 := c57.child()
+			if len(e56.Memo.MemoType) > 0 {
+				c58.wire(enc{ST_VL, 12}, &e56.Memo.MemoType) // MemoType
+			}
+			if len(e56.Memo.MemoData) > 0 {
+				c58.wire(enc{ST_VL, 13}, &e56.Memo.MemoData) // MemoData
+			}
+			if len(e56.Memo.MemoFormat) > 0 {
+				c58.wire(enc{ST_VL, 14}, &e56.Memo.MemoFormat) // MemoFormat
+			}
+			c57.close(&c58)
+			c57.end(endOfObject)
+			fw.close(&c57)
+		}
+		fw.end(endOfArray)
+	}
+	if t.TickSize != nil {
+		fw.uint8(enc{ST_UINT8, 16}, uint8((*t.TickSize))) // TickSize
+	}
+}
+
+func (t *AccountSet) decodeField(r Reader, e enc) (bool, error) {
+	switch e {
+	case enc{ST_UINT16, 2}: // TransactionType
+		v, err := readUint16(r)
+		t.TxBase.TransactionType = TransactionType(v)
+		return true, err
+	case enc{ST_UINT32, 2}: // Flags
+		t.TxBase.Flags = new(TransactionFlag)
+		v, err := readUint32(r)
+		*t.TxBase.Flags = TransactionFlag(v)
+		return true, err
+	case enc{ST_UINT32, 3}: // SourceTag
+		t.TxBase.SourceTag = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.SourceTag = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 4}: // Sequence
+		v, err := readUint32(r)
+		t.TxBase.Sequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 11}: // TransferRate
+		t.TransferRate = new(uint32)
+		v, err := readUint32(r)
+		*t.TransferRate = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 12}: // WalletSize
+		t.WalletSize = new(uint32)
+		v, err := readUint32(r)
+		*t.WalletSize = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 27}: // LastLedgerSequence
+		t.TxBase.LastLedgerSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.LastLedgerSequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 33}: // SetFlag
+		t.SetFlag = new(uint32)
+		v, err := readUint32(r)
+		*t.SetFlag = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 34}: // ClearFlag
+		t.ClearFlag = new(uint32)
+		v, err := readUint32(r)
+		*t.ClearFlag = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 41}: // TicketSequence
+		t.TxBase.TicketSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.TicketSequence = uint32(v)
+		return true, err
+	case enc{ST_HASH128, 1}: // EmailHash
+		t.EmailHash = new(Hash128)
+		return true, t.EmailHash.Unmarshal(r)
+	case enc{ST_HASH256, 5}: // PreviousTxnID
+		t.TxBase.PreviousTxnID = new(Hash256)
+		return true, t.TxBase.PreviousTxnID.Unmarshal(r)
+	case enc{ST_HASH256, 7}: // WalletLocator
+		t.WalletLocator = new(Hash256)
+		return true, t.WalletLocator.Unmarshal(r)
+	case enc{ST_HASH256, 9}: // AccountTxnID
+		t.TxBase.AccountTxnID = new(Hash256)
+		return true, t.TxBase.AccountTxnID.Unmarshal(r)
+	case enc{ST_AMOUNT, 8}: // Fee
+		return true, t.TxBase.Fee.Unmarshal(r)
+	case enc{ST_VL, 2}: // MessageKey
+		t.MessageKey = new(VariableLength)
+		return true, t.MessageKey.Unmarshal(r)
+	case enc{ST_VL, 3}: // SigningPubKey
+		t.TxBase.SigningPubKey = new(PublicKey)
+		return true, t.TxBase.SigningPubKey.Unmarshal(r)
+	case enc{ST_VL, 4}: // TxnSignature
+		t.TxBase.TxnSignature = new(VariableLength)
+		return true, t.TxBase.TxnSignature.Unmarshal(r)
+	case enc{ST_VL, 7}: // Domain
+		t.Domain = new(VariableLength)
+		return true, t.Domain.Unmarshal(r)
+	case enc{ST_ACCOUNT, 1}: // Account
+		return true, t.TxBase.Account.Unmarshal(r)
+	case enc{ST_UINT8, 16}: // TickSize
+		t.TickSize = new(uint8)
+		v, err := readUint8(r)
+		*t.TickSize = uint8(v)
+		return true, err
+	}
+	return false, nil
+}
+
+func (t *AffectedNode) encodeFields(fw *fieldWriter, nested bool) {
+	fw.uint16(enc{ST_UINT16, 1}, uint16(t.LedgerEntryType)) // LedgerEntryType
+	if t.PreviousTxnLgrSeq != nil {
+		fw.uint32(enc{ST_UINT32, 5}, uint32((*t.PreviousTxnLgrSeq))) // PreviousTxnLgrSeq
+	}
+	if t.PreviousTxnID != nil {
+		fw.wire(enc{ST_HASH256, 5}, t.PreviousTxnID) // PreviousTxnID
+	}
+	if t.LedgerIndex != nil {
+		fw.wire(enc{ST_HASH256, 6}, t.LedgerIndex) // LedgerIndex
+	}
+	if present(t.PreviousFields) {
+		fw.header(enc{ST_OBJECT, 6}) // PreviousFields
+		c60 := fw.child()
+		c60.entry(t.PreviousFields)
+		fw.close(&c60)
+		fw.end(endOfObject)
+	}
+	if present(t.FinalFields) {
+		fw.header(enc{ST_OBJECT, 7}) // FinalFields
+		c59 := fw.child()
+		c59.entry(t.FinalFields)
+		fw.close(&c59)
+		fw.end(endOfObject)
+	}
+	if present(t.NewFields) {
+		fw.header(enc{ST_OBJECT, 8}) // NewFields
+		c61 := fw.child()
+		c61.entry(t.NewFields)
+		fw.close(&c61)
+		fw.end(endOfObject)
+	}
+}
+
+func (t *AffectedNode) decodeField(r Reader, e enc) (bool, error) {
+	switch e {
+	case enc{ST_UINT16, 1}: // LedgerEntryType
+		v, err := readUint16(r)
+		t.LedgerEntryType = LedgerEntryType(v)
+		return true, err
+	case enc{ST_UINT32, 5}: // PreviousTxnLgrSeq
+		t.PreviousTxnLgrSeq = new(uint32)
+		v, err := readUint32(r)
+		*t.PreviousTxnLgrSeq = uint32(v)
+		return true, err
+	case enc{ST_HASH256, 5}: // PreviousTxnID
+		t.PreviousTxnID = new(Hash256)
+		return true, t.PreviousTxnID.Unmarshal(r)
+	case enc{ST_HASH256, 6}: // LedgerIndex
+		t.LedgerIndex = new(Hash256)
+		return true, t.LedgerIndex.Unmarshal(r)
+	}
+	return false, nil
+}
+
+func (t *Amendment) encodeFields(fw *fieldWriter, nested bool) {
+	fw.setExtra(t.TxBase.Fields)
+	fw.uint16(enc{ST_UINT16, 2}, uint16(t.TxBase.TransactionType)) // TransactionType
+	if t.TxBase.Flags != nil {
+		fw.uint32(enc{ST_UINT32, 2}, uint32((*t.TxBase.Flags))) // Flags
+	}
+	if t.TxBase.SourceTag != nil {
+		fw.uint32(enc{ST_UINT32, 3}, uint32((*t.TxBase.SourceTag))) // SourceTag
+	}
+	fw.uint32(enc{ST_UINT32, 4}, uint32(t.TxBase.Sequence)) // Sequence
+	if t.TxBase.LastLedgerSequence != nil {
+		fw.uint32(enc{ST_UINT32, 27}, uint32((*t.TxBase.LastLedgerSequence))) // LastLedgerSequence
+	}
+	if t.TxBase.TicketSequence != nil {
+		fw.uint32(enc{ST_UINT32, 41}, uint32((*t.TxBase.TicketSequence))) // TicketSequence
+	}
+	if t.TxBase.PreviousTxnID != nil {
+		fw.wire(enc{ST_HASH256, 5}, t.TxBase.PreviousTxnID) // PreviousTxnID
+	}
+	if t.TxBase.AccountTxnID != nil {
+		fw.wire(enc{ST_HASH256, 9}, t.TxBase.AccountTxnID) // AccountTxnID
+	}
+	fw.wire(enc{ST_HASH256, 19}, &t.Amendment) // Amendment
+	fw.wire(enc{ST_AMOUNT, 8}, &t.TxBase.Fee)  // Fee
+	if t.TxBase.SigningPubKey != nil {
+		fw.wire(enc{ST_VL, 3}, t.TxBase.SigningPubKey) // SigningPubKey
+	}
+	if !fw.ignoreSigningFields && t.TxBase.TxnSignature != nil && len((*t.TxBase.TxnSignature)) > 0 {
+		fw.wire(enc{ST_VL, 4}, t.TxBase.TxnSignature) // TxnSignature
+	}
+	fw.wire(enc{ST_ACCOUNT, 1}, &t.TxBase.Account) // Account
+	if !fw.ignoreSigningFields && len(t.TxBase.Signers) > 0 {
+		fw.header(enc{ST_ARRAY, 3}) // Signers
+		for i := range t.TxBase.Signers {
+			e62 := &t.TxBase.Signers[i]
+			c63 := fw.child()
+			c63.header(enc{ST_OBJECT, 16}) // Signer
+			c64 := c63.child()
+			if e62.Signer.SigningPubKey != nil {
+				c64.wire(enc{ST_VL, 3}, e62.Signer.SigningPubKey) // SigningPubKey
+			}
+			if !fw.ignoreSigningFields && e62.Signer.TxnSignature != nil && len((*e62.Signer.TxnSignature)) > 0 {
+				c64.wire(enc{ST_VL, 4}, e62.Signer.TxnSignature) // TxnSignature
+			}
+			c64.wire(enc{ST_ACCOUNT, 1}, &e62.Signer.Account) // Account
+			c63.close(&c64)
+			c63.end(endOfObject)
+			fw.close(&c63)
+		}
+		fw.end(endOfArray)
+	}
+	if len(t.TxBase.Memos) > 0 {
+		fw.header(enc{ST_ARRAY, 9}) // Memos
+		for i := range t.TxBase.Memos {
+			e65 := &t.TxBase.Memos[i]
+			c66 := fw.child()
+			c66.header(enc{ST_OBJECT, 10}) // Memo
+			c67 := c66.child()
+			if len(e65.Memo.MemoType) > 0 {
+				c67.wire(enc{ST_VL, 12}, &e65.Memo.MemoType) // MemoType
+			}
+			if len(e65.Memo.MemoData) > 0 {
+				c67.wire(enc{ST_VL, 13}, &e65.Memo.MemoData) // MemoData
+			}
+			if len(e65.Memo.MemoFormat) > 0 {
+				c67.wire(enc{ST_VL, 14}, &e65.Memo.MemoFormat) // MemoFormat
+			}
+			c66.close(&c67)
+			c66.end(endOfObject)
+			fw.close(&c66)
+		}
+		fw.end(endOfArray)
+	}
+}
+
+func (t *Amendment) decodeField(r Reader, e enc) (bool, error) {
+	switch e {
+	case enc{ST_UINT16, 2}: // TransactionType
+		v, err := readUint16(r)
+		t.TxBase.TransactionType = TransactionType(v)
+		return true, err
+	case enc{ST_UINT32, 2}: // Flags
+		t.TxBase.Flags = new(TransactionFlag)
+		v, err := readUint32(r)
+		*t.TxBase.Flags = TransactionFlag(v)
+		return true, err
+	case enc{ST_UINT32, 3}: // SourceTag
+		t.TxBase.SourceTag = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.SourceTag = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 4}: // Sequence
+		v, err := readUint32(r)
+		t.TxBase.Sequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 27}: // LastLedgerSequence
+		t.TxBase.LastLedgerSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.LastLedgerSequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 41}: // TicketSequence
+		t.TxBase.TicketSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.TicketSequence = uint32(v)
+		return true, err
+	case enc{ST_HASH256, 5}: // PreviousTxnID
+		t.TxBase.PreviousTxnID = new(Hash256)
+		return true, t.TxBase.PreviousTxnID.Unmarshal(r)
+	case enc{ST_HASH256, 9}: // AccountTxnID
+		t.TxBase.AccountTxnID = new(Hash256)
+		return true, t.TxBase.AccountTxnID.Unmarshal(r)
+	case enc{ST_HASH256, 19}: // Amendment
+		return true, t.Amendment.Unmarshal(r)
+	case enc{ST_AMOUNT, 8}: // Fee
+		return true, t.TxBase.Fee.Unmarshal(r)
+	case enc{ST_VL, 3}: // SigningPubKey
+		t.TxBase.SigningPubKey = new(PublicKey)
+		return true, t.TxBase.SigningPubKey.Unmarshal(r)
+	case enc{ST_VL, 4}: // TxnSignature
+		t.TxBase.TxnSignature = new(VariableLength)
+		return true, t.TxBase.TxnSignature.Unmarshal(r)
+	case enc{ST_ACCOUNT, 1}: // Account
+		return true, t.TxBase.Account.Unmarshal(r)
+	}
+	return false, nil
+}
+
+func (t *Amendments) encodeFields(fw *fieldWriter, nested bool) {
+	fw.setExtra(t.leBase.Fields)
+	if !nested {
+		fw.uint16(enc{ST_UINT16, 1}, uint16(t.leBase.LedgerEntryType)) // LedgerEntryType
+	}
+	if t.Flags != nil {
+		fw.uint32(enc{ST_UINT32, 2}, uint32((*t.Flags))) // Flags
+	}
+	if t.leBase.PreviousTxnLgrSeq != nil {
+		fw.uint32(enc{ST_UINT32, 5}, uint32((*t.leBase.PreviousTxnLgrSeq))) // PreviousTxnLgrSeq
+	}
+	if t.leBase.PreviousTxnID != nil {
+		fw.wire(enc{ST_HASH256, 5}, t.leBase.PreviousTxnID) // PreviousTxnID
+	}
+	if len(t.Majorities) > 0 {
+		fw.header(enc{ST_ARRAY, 16}) // Majorities
+		for i := range t.Majorities {
+			e68 := &t.Majorities[i]
+			c69 := fw.child()
+			c69.header(enc{ST_OBJECT, 18}) // Majority
+			c70 := c69.child()
+			if e68.Majority.CloseTime != nil {
+				c70.uint32(enc{ST_UINT32, 7}, uint32((*e68.Majority.CloseTime))) // CloseTime
+			}
+			if e68.Majority.Amendment != nil {
+				c70.wire(enc{ST_HASH256, 19}, e68.Majority.Amendment) // Amendment
+			}
+			c69.close(&c70)
+			c69.end(endOfObject)
+			fw.close(&c69)
+		}
+		fw.end(endOfArray)
+	}
+	if t.Amendments != nil && len((*t.Amendments)) > 0 {
+		fw.wire(enc{ST_VECTOR256, 3}, t.Amendments) // Amendments
+	}
+}
+
+func (t *Amendments) decodeField(r Reader, e enc) (bool, error) {
+	switch e {
+	case enc{ST_UINT16, 1}: // LedgerEntryType
+		v, err := readUint16(r)
+		t.leBase.LedgerEntryType = LedgerEntryType(v)
+		return true, err
+	case enc{ST_UINT32, 2}: // Flags
+		t.Flags = new(LedgerEntryFlag)
+		v, err := readUint32(r)
+		*t.Flags = LedgerEntryFlag(v)
+		return true, err
+	case enc{ST_UINT32, 5}: // PreviousTxnLgrSeq
+		t.leBase.PreviousTxnLgrSeq = new(uint32)
+		v, err := readUint32(r)
+		*t.leBase.PreviousTxnLgrSeq = uint32(v)
+		return true, err
+	case enc{ST_HASH256, 5}: // PreviousTxnID
+		t.leBase.PreviousTxnID = new(Hash256)
+		return true, t.leBase.PreviousTxnID.Unmarshal(r)
+	case enc{ST_HASH256, 6}: // LedgerIndex
+		t.leBase.LedgerIndex = new(Hash256)
+		return true, t.leBase.LedgerIndex.Unmarshal(r)
+	case enc{ST_VECTOR256, 3}: // Amendments
+		t.Amendments = new(Vector256)
+		return true, t.Amendments.Unmarshal(r)
+	}
+	return false, nil
+}
+
+func (t *Check) encodeFields(fw *fieldWriter, nested bool) {
+	fw.setExtra(t.leBase.Fields)
+	if !nested {
+		fw.uint16(enc{ST_UINT16, 1}, uint16(t.leBase.LedgerEntryType)) // LedgerEntryType
+	}
+	if t.Sequence != nil {
+		fw.uint32(enc{ST_UINT32, 4}, uint32((*t.Sequence))) // Sequence
+	}
+	if t.leBase.PreviousTxnLgrSeq != nil {
+		fw.uint32(enc{ST_UINT32, 5}, uint32((*t.leBase.PreviousTxnLgrSeq))) // PreviousTxnLgrSeq
+	}
+	if t.Expiration != nil {
+		fw.uint32(enc{ST_UINT32, 10}, uint32((*t.Expiration))) // Expiration
+	}
+	if t.leBase.PreviousTxnID != nil {
+		fw.wire(enc{ST_HASH256, 5}, t.leBase.PreviousTxnID) // PreviousTxnID
+	}
+	if t.SendMax != nil {
+		fw.wire(enc{ST_AMOUNT, 9}, t.SendMax) // SendMax
+	}
+	if t.Account != nil {
+		fw.wire(enc{ST_ACCOUNT, 1}, t.Account) // Account
+	}
+	if t.Destination != nil {
+		fw.wire(enc{ST_ACCOUNT, 3}, t.Destination) // Destination
+	}
+}
+
+func (t *Check) decodeField(r Reader, e enc) (bool, error) {
+	switch e {
+	case enc{ST_UINT16, 1}: // LedgerEntryType
+		v, err := readUint16(r)
+		t.leBase.LedgerEntryType = LedgerEntryType(v)
+		return true, err
+	case enc{ST_UINT32, 4}: // Sequence
+		t.Sequence = new(uint32)
+		v, err := readUint32(r)
+		*t.Sequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 5}: // PreviousTxnLgrSeq
+		t.leBase.PreviousTxnLgrSeq = new(uint32)
+		v, err := readUint32(r)
+		*t.leBase.PreviousTxnLgrSeq = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 10}: // Expiration
+		t.Expiration = new(uint32)
+		v, err := readUint32(r)
+		*t.Expiration = uint32(v)
+		return true, err
+	case enc{ST_HASH256, 5}: // PreviousTxnID
+		t.leBase.PreviousTxnID = new(Hash256)
+		return true, t.leBase.PreviousTxnID.Unmarshal(r)
+	case enc{ST_HASH256, 6}: // LedgerIndex
+		t.leBase.LedgerIndex = new(Hash256)
+		return true, t.leBase.LedgerIndex.Unmarshal(r)
+	case enc{ST_AMOUNT, 9}: // SendMax
+		t.SendMax = new(Amount)
+		return true, t.SendMax.Unmarshal(r)
+	case enc{ST_ACCOUNT, 1}: // Account
+		t.Account = new(Account)
+		return true, t.Account.Unmarshal(r)
+	case enc{ST_ACCOUNT, 3}: // Destination
+		t.Destination = new(Account)
+		return true, t.Destination.Unmarshal(r)
+	}
+	return false, nil
+}
+
+func (t *CheckCancel) encodeFields(fw *fieldWriter, nested bool) {
+	fw.setExtra(t.TxBase.Fields)
+	fw.uint16(enc{ST_UINT16, 2}, uint16(t.TxBase.TransactionType)) // TransactionType
+	if t.TxBase.Flags != nil {
+		fw.uint32(enc{ST_UINT32, 2}, uint32((*t.TxBase.Flags))) // Flags
+	}
+	if t.TxBase.SourceTag != nil {
+		fw.uint32(enc{ST_UINT32, 3}, uint32((*t.TxBase.SourceTag))) // SourceTag
+	}
+	fw.uint32(enc{ST_UINT32, 4}, uint32(t.TxBase.Sequence)) // Sequence
+	if t.TxBase.LastLedgerSequence != nil {
+		fw.uint32(enc{ST_UINT32, 27}, uint32((*t.TxBase.LastLedgerSequence))) // LastLedgerSequence
+	}
+	if t.TxBase.TicketSequence != nil {
+		fw.uint32(enc{ST_UINT32, 41}, uint32((*t.TxBase.TicketSequence))) // TicketSequence
+	}
+	if t.TxBase.PreviousTxnID != nil {
+		fw.wire(enc{ST_HASH256, 5}, t.TxBase.PreviousTxnID) // PreviousTxnID
+	}
+	if t.TxBase.AccountTxnID != nil {
+		fw.wire(enc{ST_HASH256, 9}, t.TxBase.AccountTxnID) // AccountTxnID
+	}
+	fw.wire(enc{ST_HASH256, 24}, &t.CheckID)  // CheckID
+	fw.wire(enc{ST_AMOUNT, 8}, &t.TxBase.Fee) // Fee
+	if t.TxBase.SigningPubKey != nil {
+		fw.wire(enc{ST_VL, 3}, t.TxBase.SigningPubKey) // SigningPubKey
+	}
+	if !fw.ignoreSigningFields && t.TxBase.TxnSignature != nil && len((*t.TxBase.TxnSignature)) > 0 {
+		fw.wire(enc{ST_VL, 4}, t.TxBase.TxnSignature) // TxnSignature
+	}
+	fw.wire(enc{ST_ACCOUNT, 1}, &t.TxBase.Account) // Account
+	if !fw.ignoreSigningFields && len(t.TxBase.Signers) > 0 {
+		fw.header(enc{ST_ARRAY, 3}) // Signers
+		for i := range t.TxBase.Signers {
+			e71 := &t.TxBase.Signers[i]
+			c72 := fw.child()
+			c72.header(enc{ST_OBJECT, 16}) // Signer
+			c73 := c72.child()
+			if e71.Signer.SigningPubKey != nil {
+				c73.wire(enc{ST_VL, 3}, e71.Signer.SigningPubKey) // SigningPubKey
+			}
+			if !fw.ignoreSigningFields && e71.Signer.TxnSignature != nil && len((*e71.Signer.TxnSignature)) > 0 {
+				c73.wire(enc{ST_VL, 4}, e71.Signer.TxnSignature) // TxnSignature
+			}
+			c73.wire(enc{ST_ACCOUNT, 1}, &e71.Signer.Account) // Account
+			c72.close(&c73)
+			c72.end(endOfObject)
+			fw.close(&c72)
+		}
+		fw.end(endOfArray)
+	}
+	if len(t.TxBase.Memos) > 0 {
+		fw.header(enc{ST_ARRAY, 9}) // Memos
+		for i := range t.TxBase.Memos {
+			e74 := &t.TxBase.Memos[i]
+			c75 := fw.child()
+			c75.header(enc{ST_OBJECT, 10}) // Memo
+			c76 := c75.child()
+			if len(e74.Memo.MemoType) > 0 {
+				c76.wire(enc{ST_VL, 12}, &e74.Memo.MemoType) // MemoType
+			}
+			if len(e74.Memo.MemoData) > 0 {
+				c76.wire(enc{ST_VL, 13}, &e74.Memo.MemoData) // MemoData
+			}
+			if len(e74.Memo.MemoFormat) > 0 {
+				c76.wire(enc{ST_VL, 14}, &e74.Memo.MemoFormat) // MemoFormat
+			}
+			c75.close(&c76)
+			c75.end(endOfObject)
+			fw.close(&c75)
+		}
+		fw.end(endOfArray)
+	}
+}
+
+func (t *CheckCancel) decodeField(r Reader, e enc) (bool, error) {
+	switch e {
+	case enc{ST_UINT16, 2}: // TransactionType
+		v, err := readUint16(r)
+		t.TxBase.TransactionType = TransactionType(v)
+		return true, err
+	case enc{ST_UINT32, 2}: // Flags
+		t.TxBase.Flags = new(TransactionFlag)
+		v, err := readUint32(r)
+		*t.TxBase.Flags = TransactionFlag(v)
+		return true, err
+	case enc{ST_UINT32, 3}: // SourceTag
+		t.TxBase.SourceTag = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.SourceTag = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 4}: // Sequence
+		v, err := readUint32(r)
+		t.TxBase.Sequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 27}: // LastLedgerSequence
+		t.TxBase.LastLedgerSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.LastLedgerSequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 41}: // TicketSequence
+		t.TxBase.TicketSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.TicketSequence = uint32(v)
+		return true, err
+	case enc{ST_HASH256, 5}: // PreviousTxnID
+		t.TxBase.PreviousTxnID = new(Hash256)
+		return true, t.TxBase.PreviousTxnID.Unmarshal(r)
+	case enc{ST_HASH256, 9}: // AccountTxnID
+		t.TxBase.AccountTxnID = new(Hash256)
+		return true, t.TxBase.AccountTxnID.Unmarshal(r)
+	case enc{ST_HASH256, 24}: // CheckID
+		return true, t.CheckID.Unmarshal(r)
+	case enc{ST_AMOUNT, 8}: // Fee
+		return true, t.TxBase.Fee.Unmarshal(r)
+	case enc{ST_VL, 3}: // SigningPubKey
+		t.TxBase.SigningPubKey = new(PublicKey)
+		return true, t.TxBase.SigningPubKey.Unmarshal(r)
+	case enc{ST_VL, 4}: // TxnSignature
+		t.TxBase.TxnSignature = new(VariableLength)
+		return true, t.TxBase.TxnSignature.Unmarshal(r)
+	case enc{ST_ACCOUNT, 1}: // Account
+		return true, t.TxBase.Account.Unmarshal(r)
+	}
+	return false, nil
+}
+
+func (t *CheckCash) encodeFields(fw *fieldWriter, nested bool) {
+	fw.setExtra(t.TxBase.Fields)
+	fw.uint16(enc{ST_UINT16, 2}, uint16(t.TxBase.TransactionType)) // TransactionType
+	if t.TxBase.Flags != nil {
+		fw.uint32(enc{ST_UINT32, 2}, uint32((*t.TxBase.Flags))) // Flags
+	}
+	if t.TxBase.SourceTag != nil {
+		fw.uint32(enc{ST_UINT32, 3}, uint32((*t.TxBase.SourceTag))) // SourceTag
+	}
+	fw.uint32(enc{ST_UINT32, 4}, uint32(t.TxBase.Sequence)) // Sequence
+	if t.TxBase.LastLedgerSequence != nil {
+		fw.uint32(enc{ST_UINT32, 27}, uint32((*t.TxBase.LastLedgerSequence))) // LastLedgerSequence
+	}
+	if t.TxBase.TicketSequence != nil {
+		fw.uint32(enc{ST_UINT32, 41}, uint32((*t.TxBase.TicketSequence))) // TicketSequence
+	}
+	if t.TxBase.PreviousTxnID != nil {
+		fw.wire(enc{ST_HASH256, 5}, t.TxBase.PreviousTxnID) // PreviousTxnID
+	}
+	if t.TxBase.AccountTxnID != nil {
+		fw.wire(enc{ST_HASH256, 9}, t.TxBase.AccountTxnID) // AccountTxnID
+	}
+	fw.wire(enc{ST_HASH256, 24}, &t.CheckID) // CheckID
+	if t.Amount != nil {
+		fw.wire(enc{ST_AMOUNT, 1}, t.Amount) // Amount
+	}
+	fw.wire(enc{ST_AMOUNT, 8}, &t.TxBase.Fee) // Fee
+	if t.DeliverMin != nil {
+		fw.wire(enc{ST_AMOUNT, 10}, t.DeliverMin) // DeliverMin
+	}
+	if t.TxBase.SigningPubKey != nil {
+		fw.wire(enc{ST_VL, 3}, t.TxBase.SigningPubKey) // SigningPubKey
+	}
+	if !fw.ignoreSigningFields && t.TxBase.TxnSignature != nil && len((*t.TxBase.TxnSignature)) > 0 {
+		fw.wire(enc{ST_VL, 4}, t.TxBase.TxnSignature) // TxnSignature
+	}
+	fw.wire(enc{ST_ACCOUNT, 1}, &t.TxBase.Account) // Account
+	if !fw.ignoreSigningFields && len(t.TxBase.Signers) > 0 {
+		fw.header(enc{ST_ARRAY, 3}) // Signers
+		for i := range t.TxBase.Signers {
+			e77 := &t.TxBase.Signers[i]
+			c78 := fw.child()
+			c78.header(enc{ST_OBJECT, 16}) // Signer
+			c79 := c78.child()
+			if e77.Signer.SigningPubKey != nil {
+				c79.wire(enc{ST_VL, 3}, e77.Signer.SigningPubKey) // SigningPubKey
+			}
+			if !fw.ignoreSigningFields && e77.Signer.TxnSignature != nil && len((*e77.Signer.TxnSignature)) > 0 {
+				c79.wire(enc{ST_VL, 4}, e77.Signer.TxnSignature) // TxnSignature
+			}
+			c79.wire(enc{ST_ACCOUNT, 1}, &e77.Signer.Account) // Account
+			c78.close(&c79)
+			c78.end(endOfObject)
+			fw.close(&c78)
+		}
+		fw.end(endOfArray)
+	}
+	if len(t.TxBase.Memos) > 0 {
+		fw.header(enc{ST_ARRAY, 9}) // Memos
+		for i := range t.TxBase.Memos {
+			e80 := &t.TxBase.Memos[i]
+			c81 := fw.child()
+			c81.header(enc{ST_OBJECT, 10}) // Memo
+			c82 := c81.child()
+			if len(e80.Memo.MemoType) > 0 {
+				c82.wire(enc{ST_VL, 12}, &e80.Memo.MemoType) // MemoType
+			}
+			if len(e80.Memo.MemoData) > 0 {
+				c82.wire(enc{ST_VL, 13}, &e80.Memo.MemoData) // MemoData
+			}
+			if len(e80.Memo.MemoFormat) > 0 {
+				c82.wire(enc{ST_VL, 14}, &e80.Memo.MemoFormat) // MemoFormat
+			}
+			c81.close(&c82)
+			c81.end(endOfObject)
+			fw.close(&c81)
+		}
+		fw.end(endOfArray)
+	}
+}
+
+func (t *CheckCash) decodeField(r Reader, e enc) (bool, error) {
+	switch e {
+	case enc{ST_UINT16, 2}: // TransactionType
+		v, err := readUint16(r)
+		t.TxBase.TransactionType = TransactionType(v)
+		return true, err
+	case enc{ST_UINT32, 2}: // Flags
+		t.TxBase.Flags = new(TransactionFlag)
+		v, err := readUint32(r)
+		*t.TxBase.Flags = TransactionFlag(v)
+		return true, err
+	case enc{ST_UINT32, 3}: // SourceTag
+		t.TxBase.SourceTag = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.SourceTag = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 4}: // Sequence
+		v, err := readUint32(r)
+		t.TxBase.Sequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 27}: // LastLedgerSequence
+		t.TxBase.LastLedgerSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.LastLedgerSequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 41}: // TicketSequence
+		t.TxBase.TicketSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.TicketSequence = uint32(v)
+		return true, err
+	case enc{ST_HASH256, 5}: // PreviousTxnID
+		t.TxBase.PreviousTxnID = new(Hash256)
+		return true, t.TxBase.PreviousTxnID.Unmarshal(r)
+	case enc{ST_HASH256, 9}: // AccountTxnID
+		t.TxBase.AccountTxnID = new(Hash256)
+		return true, t.TxBase.AccountTxnID.Unmarshal(r)
+	case enc{ST_HASH256, 24}: // CheckID
+		return true, t.CheckID.Unmarshal(r)
+	case enc{ST_AMOUNT, 1}: // Amount
+		t.Amount = new(Amount)
+		return true, t.Amount.Unmarshal(r)
+	case enc{ST_AMOUNT, 8}: // Fee
+		return true, t.TxBase.Fee.Unmarshal(r)
+	case enc{ST_AMOUNT, 10}: // DeliverMin
+		t.DeliverMin = new(Amount)
+		return true, t.DeliverMin.Unmarshal(r)
+	case enc{ST_VL, 3}: // SigningPubKey
+		t.TxBase.SigningPubKey = new(PublicKey)
+		return true, t.TxBase.SigningPubKey.Unmarshal(r)
+	case enc{ST_VL, 4}: // TxnSignature
+		t.TxBase.TxnSignature = new(VariableLength)
+		return true, t.TxBase.TxnSignature.Unmarshal(r)
+	case enc{ST_ACCOUNT, 1}: // Account
+		return true, t.TxBase.Account.Unmarshal(r)
+	}
+	return false, nil
+}
+
+func (t *CheckCreate) encodeFields(fw *fieldWriter, nested bool) {
+	fw.setExtra(t.TxBase.Fields)
+	fw.uint16(enc{ST_UINT16, 2}, uint16(t.TxBase.TransactionType)) // TransactionType
+	if t.TxBase.Flags != nil {
+		fw.uint32(enc{ST_UINT32, 2}, uint32((*t.TxBase.Flags))) // Flags
+	}
+	if t.TxBase.SourceTag != nil {
+		fw.uint32(enc{ST_UINT32, 3}, uint32((*t.TxBase.SourceTag))) // SourceTag
+	}
+	fw.uint32(enc{ST_UINT32, 4}, uint32(t.TxBase.Sequence)) // Sequence
+	if t.Expiration != nil {
+		fw.uint32(enc{ST_UINT32, 10}, uint32((*t.Expiration))) // Expiration
+	}
+	if t.DestinationTag != nil {
+		fw.uint32(enc{ST_UINT32, 14}, uint32((*t.DestinationTag))) // DestinationTag
+	}
+	if t.TxBase.LastLedgerSequence != nil {
+		fw.uint32(enc{ST_UINT32, 27}, uint32((*t.TxBase.LastLedgerSequence))) // LastLedgerSequence
+	}
+	if t.TxBase.TicketSequence != nil {
+		fw.uint32(enc{ST_UINT32, 41}, uint32((*t.TxBase.TicketSequence))) // TicketSequence
+	}
+	if t.TxBase.PreviousTxnID != nil {
+		fw.wire(enc{ST_HASH256, 5}, t.TxBase.PreviousTxnID) // PreviousTxnID
+	}
+	if t.TxBase.AccountTxnID != nil {
+		fw.wire(enc{ST_HASH256, 9}, t.TxBase.AccountTxnID) // AccountTxnID
+	}
+	if t.InvoiceID != nil {
+		fw.wire(enc{ST_HASH256, 17}, t.InvoiceID) // InvoiceID
+	}
+	fw.wire(enc{ST_AMOUNT, 8}, &t.TxBase.Fee) // Fee
+	fw.wire(enc{ST_AMOUNT, 9}, &t.SendMax)    // SendMax
+	if t.TxBase.SigningPubKey != nil {
+		fw.wire(enc{ST_VL, 3}, t.TxBase.SigningPubKey) // SigningPubKey
+	}
+	if !fw.ignoreSigningFields && t.TxBase.TxnSignature != nil && len((*t.TxBase.TxnSignature)) > 0 {
+		fw.wire(enc{ST_VL, 4}, t.TxBase.TxnSignature) // TxnSignature
+	}
+	fw.wire(enc{ST_ACCOUNT, 1}, &t.TxBase.Account) // Account
+	fw.wire(enc{ST_ACCOUNT, 3}, &t.Destination)    // Destination
+	if !fw.ignoreSigningFields && len(t.TxBase.Signers) > 0 {
+		fw.header(enc{ST_ARRAY, 3}) // Signers
+		for i := range t.TxBase.Signers {
+			e83 := &t.TxBase.Signers[i]
+			c84 := fw.child()
+			c84.header(enc{ST_OBJECT, 16}) // Signer
+			c85 := c84.child()
+			if e83.Signer.SigningPubKey != nil {
+				c85.wire(enc{ST_VL, 3}, e83.Signer.SigningPubKey) // SigningPubKey
+			}
+			if !fw.ignoreSigningFields && e83.Signer.TxnSignature != nil && len((*e83.Signer.TxnSignature)) > 0 {
+				c85.wire(enc{ST_VL, 4}, e83.Signer.TxnSignature) // TxnSignature
+			}
+			c85.wire(enc{ST_ACCOUNT, 1}, &e83.Signer.Account) // Account
+			c84.close(&c85)
+			c84.end(endOfObject)
+			fw.close(&c84)
+		}
+		fw.end(endOfArray)
+	}
+	if len(t.TxBase.Memos) > 0 {
+		fw.header(enc{ST_ARRAY, 9}) // Memos
+		for i := range t.TxBase.Memos {
+			e86 := &t.TxBase.Memos[i]
+			c87 := fw.child()
+			c87.header(enc{ST_OBJECT, 10}) // Memo
+			c88 := c87.child()
+			if len(e86.Memo.MemoType) > 0 {
+				c88.wire(enc{ST_VL, 12}, &e86.Memo.MemoType) // MemoType
+			}
+			if len(e86.Memo.MemoData) > 0 {
+				c88.wire(enc{ST_VL, 13}, &e86.Memo.MemoData) // MemoData
+			}
+			if len(e86.Memo.MemoFormat) > 0 {
+				c88.wire(enc{ST_VL, 14}, &e86.Memo.MemoFormat) // MemoFormat
+			}
+			c87.close(&c88)
+			c87.end(endOfObject)
+			fw.close(&c87)
+		}
+		fw.end(endOfArray)
+	}
+}
+
+func (t *CheckCreate) decodeField(r Reader, e enc) (bool, error) {
+	switch e {
+	case enc{ST_UINT16, 2}: // TransactionType
+		v, err := readUint16(r)
+		t.TxBase.TransactionType = TransactionType(v)
+		return true, err
+	case enc{ST_UINT32, 2}: // Flags
+		t.TxBase.Flags = new(TransactionFlag)
+		v, err := readUint32(r)
+		*t.TxBase.Flags = TransactionFlag(v)
+		return true, err
+	case enc{ST_UINT32, 3}: // SourceTag
+		t.TxBase.SourceTag = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.SourceTag = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 4}: // Sequence
+		v, err := readUint32(r)
+		t.TxBase.Sequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 10}: // Expiration
+		t.Expiration = new(uint32)
+		v, err := readUint32(r)
+		*t.Expiration = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 14}: // DestinationTag
+		t.DestinationTag = new(uint32)
+		v, err := readUint32(r)
+		*t.DestinationTag = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 27}: // LastLedgerSequence
+		t.TxBase.LastLedgerSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.LastLedgerSequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 41}: // TicketSequence
+		t.TxBase.TicketSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.TicketSequence = uint32(v)
+		return true, err
+	case enc{ST_HASH256, 5}: // PreviousTxnID
+		t.TxBase.PreviousTxnID = new(Hash256)
+		return true, t.TxBase.PreviousTxnID.Unmarshal(r)
+	case enc{ST_HASH256, 9}: // AccountTxnID
+		t.TxBase.AccountTxnID = new(Hash256)
+		return true, t.TxBase.AccountTxnID.Unmarshal(r)
+	case enc{ST_HASH256, 17}: // InvoiceID
+		t.InvoiceID = new(Hash256)
+		return true, t.InvoiceID.Unmarshal(r)
+	case enc{ST_AMOUNT, 8}: // Fee
+		return true, t.TxBase.Fee.Unmarshal(r)
+	case enc{ST_AMOUNT, 9}: // SendMax
+		return true, t.SendMax.Unmarshal(r)
+	case enc{ST_VL, 3}: // SigningPubKey
+		t.TxBase.SigningPubKey = new(PublicKey)
+		return true, t.TxBase.SigningPubKey.Unmarshal(r)
+	case enc{ST_VL, 4}: // TxnSignature
+		t.TxBase.TxnSignature = new(VariableLength)
+		return true, t.TxBase.TxnSignature.Unmarshal(r)
+	case enc{ST_ACCOUNT, 1}: // Account
+		return true, t.TxBase.Account.Unmarshal(r)
+	case enc{ST_ACCOUNT, 3}: // Destination
+		return true, t.Destination.Unmarshal(r)
+	}
+	return false, nil
+}
+
+func (t *DepositPreauth) encodeFields(fw *fieldWriter, nested bool) {
+	fw.setExtra(t.TxBase.Fields)
+	fw.uint16(enc{ST_UINT16, 2}, uint16(t.TxBase.TransactionType)) // TransactionType
+	if t.TxBase.Flags != nil {
+		fw.uint32(enc{ST_UINT32, 2}, uint32((*t.TxBase.Flags))) // Flags
+	}
+	if t.TxBase.SourceTag != nil {
+		fw.uint32(enc{ST_UINT32, 3}, uint32((*t.TxBase.SourceTag))) // SourceTag
+	}
+	fw.uint32(enc{ST_UINT32, 4}, uint32(t.TxBase.Sequence)) // Sequence
+	if t.TxBase.LastLedgerSequence != nil {
+		fw.uint32(enc{ST_UINT32, 27}, uint32((*t.TxBase.LastLedgerSequence))) // LastLedgerSequence
+	}
+	if t.TxBase.TicketSequence != nil {
+		fw.uint32(enc{ST_UINT32, 41}, uint32((*t.TxBase.TicketSequence))) // TicketSequence
+	}
+	if t.TxBase.PreviousTxnID != nil {
+		fw.wire(enc{ST_HASH256, 5}, t.TxBase.PreviousTxnID) // PreviousTxnID
+	}
+	if t.TxBase.AccountTxnID != nil {
+		fw.wire(enc{ST_HASH256, 9}, t.TxBase.AccountTxnID) // AccountTxnID
+	}
+	fw.wire(enc{ST_AMOUNT, 8}, &t.TxBase.Fee) // Fee
+	if t.TxBase.SigningPubKey != nil {
+		fw.wire(enc{ST_VL, 3}, t.TxBase.SigningPubKey) // SigningPubKey
+	}
+	if !fw.ignoreSigningFields && t.TxBase.TxnSignature != nil && len((*t.TxBase.TxnSignature)) > 0 {
+		fw.wire(enc{ST_VL, 4}, t.TxBase.TxnSignature) // TxnSignature
+	}
+	fw.wire(enc{ST_ACCOUNT, 1}, &t.TxBase.Account) // Account
+	if t.Authorize != nil {
+		fw.wire(enc{ST_ACCOUNT, 5}, t.Authorize) // Authorize
+	}
+	if t.Unauthorize != nil {
+		fw.wire(enc{ST_ACCOUNT, 6}, t.Unauthorize) // Unauthorize
+	}
+	if !fw.ignoreSigningFields && len(t.TxBase.Signers) > 0 {
+		fw.header(enc{ST_ARRAY, 3}) // Signers
+		for i := range t.TxBase.Signers {
+			e89 := &t.TxBase.Signers[i]
+			c90 := fw.child()
+			c90.header(enc{ST_OBJECT, 16}) // Signer
+			c91 := c90.child()
+			if e89.Signer.SigningPubKey != nil {
+				c91.wire(enc{ST_VL, 3}, e89.Signer.SigningPubKey) // SigningPubKey
+			}
+			if !fw.ignoreSigningFields && e89.Signer.TxnSignature != nil && len((*e89.Signer.TxnSignature)) > 0 {
+				c91.wire(enc{ST_VL, 4}, e89.Signer.TxnSignature) // TxnSignature
+			}
+			c91.wire(enc{ST_ACCOUNT, 1}, &e89.Signer.Account) // Account
+			c90.close(&c91)
+			c90.end(endOfObject)
+			fw.close(&c90)
+		}
+		fw.end(endOfArray)
+	}
+	if len(t.TxBase.Memos) > 0 {
+		fw.header(enc{ST_ARRAY, 9}) // Memos
+		for i := range t.TxBase.Memos {
+			e92 := &t.TxBase.Memos[i]
+			c93 := fw.child()
+			c93.header(enc{ST_OBJECT, 10}) // Memo
+			c94 := c93.child()
+			if len(e92.Memo.MemoType) > 0 {
+				c94.wire(enc{ST_VL, 12}, &e92.Memo.MemoType) // MemoType
+			}
+			if len(e92.Memo.MemoData) > 0 {
+				c94.wire(enc{ST_VL, 13}, &e92.Memo.MemoData) // MemoData
+			}
+			if len(e92.Memo.MemoFormat) > 0 {
+				c94.wire(enc{ST_VL, 14}, &e92.Memo.MemoFormat) // MemoFormat
+			}
+			c93.close(&c94)
+			c93.end(endOfObject)
+			fw.close(&c93)
+		}
+		fw.end(endOfArray)
+	}
+}
+
+func (t *DepositPreauth) decodeField(r Reader, e enc) (bool, error) {
+	switch e {
+	case enc{ST_UINT16, 2}: // TransactionType
+		v, err := readUint16(r)
+		t.TxBase.TransactionType = TransactionType(v)
+		return true, err
+	case enc{ST_UINT32, 2}: // Flags
+		t.TxBase.Flags = new(TransactionFlag)
+		v, err := readUint32(r)
+		*t.TxBase.Flags = TransactionFlag(v)
+		return true, err
+	case enc{ST_UINT32, 3}: // SourceTag
+		t.TxBase.SourceTag = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.SourceTag = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 4}: // Sequence
+		v, err := readUint32(r)
+		t.TxBase.Sequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 27}: // LastLedgerSequence
+		t.TxBase.LastLedgerSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.LastLedgerSequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 41}: // TicketSequence
+		t.TxBase.TicketSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.TicketSequence = uint32(v)
+		return true, err
+	case enc{ST_HASH256, 5}: // PreviousTxnID
+		t.TxBase.PreviousTxnID = new(Hash256)
+		return true, t.TxBase.PreviousTxnID.Unmarshal(r)
+	case enc{ST_HASH256, 9}: // AccountTxnID
+		t.TxBase.AccountTxnID = new(Hash256)
+		return true, t.TxBase.AccountTxnID.Unmarshal(r)
+	case enc{ST_AMOUNT, 8}: // Fee
+		return true, t.TxBase.Fee.Unmarshal(r)
+	case enc{ST_VL, 3}: // SigningPubKey
+		t.TxBase.SigningPubKey = new(PublicKey)
+		return true, t.TxBase.SigningPubKey.Unmarshal(r)
+	case enc{ST_VL, 4}: // TxnSignature
+		t.TxBase.TxnSignature = new(VariableLength)
+		return true, t.TxBase.TxnSignature.Unmarshal(r)
+	case enc{ST_ACCOUNT, 1}: // Account
+		return true, t.TxBase.Account.Unmarshal(r)
+	case enc{ST_ACCOUNT, 5}: // Authorize
+		t.Authorize = new(Account)
+		return true, t.Authorize.Unmarshal(r)
+	case enc{ST_ACCOUNT, 6}: // Unauthorize
+		t.Unauthorize = new(Account)
+		return true, t.Unauthorize.Unmarshal(r)
+	}
+	return false, nil
+}
+
+func (t *DepositPreauthEntry) encodeFields(fw *fieldWriter, nested bool) {
+	fw.setExtra(t.leBase.Fields)
+	if !nested {
+		fw.uint16(enc{ST_UINT16, 1}, uint16(t.leBase.LedgerEntryType)) // LedgerEntryType
+	}
+	if t.Flags != nil {
+		fw.uint32(enc{ST_UINT32, 2}, uint32((*t.Flags))) // Flags
+	}
+	if t.leBase.PreviousTxnLgrSeq != nil {
+		fw.uint32(enc{ST_UINT32, 5}, uint32((*t.leBase.PreviousTxnLgrSeq))) // PreviousTxnLgrSeq
+	}
+	if t.OwnerNode != nil {
+		fw.uint64(enc{ST_UINT64, 4}, uint64((*t.OwnerNode))) // OwnerNode
+	}
+	if t.leBase.PreviousTxnID != nil {
+		fw.wire(enc{ST_HASH256, 5}, t.leBase.PreviousTxnID) // PreviousTxnID
+	}
+	if t.Account != nil {
+		fw.wire(enc{ST_ACCOUNT, 1}, t.Account) // Account
+	}
+	if t.Authorize != nil {
+		fw.wire(enc{ST_ACCOUNT, 5}, t.Authorize) // Authorize
+	}
+}
+
+func (t *DepositPreauthEntry) decodeField(r Reader, e enc) (bool, error) {
+	switch e {
+	case enc{ST_UINT16, 1}: // LedgerEntryType
+		v, err := readUint16(r)
+		t.leBase.LedgerEntryType = LedgerEntryType(v)
+		return true, err
+	case enc{ST_UINT32, 2}: // Flags
+		t.Flags = new(LedgerEntryFlag)
+		v, err := readUint32(r)
+		*t.Flags = LedgerEntryFlag(v)
+		return true, err
+	case enc{ST_UINT32, 5}: // PreviousTxnLgrSeq
+		t.leBase.PreviousTxnLgrSeq = new(uint32)
+		v, err := readUint32(r)
+		*t.leBase.PreviousTxnLgrSeq = uint32(v)
+		return true, err
+	case enc{ST_UINT64, 4}: // OwnerNode
+		t.OwnerNode = new(NodeIndex)
+		v, err := readUint64(r)
+		*t.OwnerNode = NodeIndex(v)
+		return true, err
+	case enc{ST_HASH256, 5}: // PreviousTxnID
+		t.leBase.PreviousTxnID = new(Hash256)
+		return true, t.leBase.PreviousTxnID.Unmarshal(r)
+	case enc{ST_HASH256, 6}: // LedgerIndex
+		t.leBase.LedgerIndex = new(Hash256)
+		return true, t.leBase.LedgerIndex.Unmarshal(r)
+	case enc{ST_ACCOUNT, 1}: // Account
+		t.Account = new(Account)
+		return true, t.Account.Unmarshal(r)
+	case enc{ST_ACCOUNT, 5}: // Authorize
+		t.Authorize = new(Account)
+		return true, t.Authorize.Unmarshal(r)
+	}
+	return false, nil
+}
+
+func (t *Directory) encodeFields(fw *fieldWriter, nested bool) {
+	fw.setExtra(t.leBase.Fields)
+	if !nested {
+		fw.uint16(enc{ST_UINT16, 1}, uint16(t.leBase.LedgerEntryType)) // LedgerEntryType
+	}
+	if t.Flags != nil {
+		fw.uint32(enc{ST_UINT32, 2}, uint32((*t.Flags))) // Flags
+	}
+	if t.leBase.PreviousTxnLgrSeq != nil {
+		fw.uint32(enc{ST_UINT32, 5}, uint32((*t.leBase.PreviousTxnLgrSeq))) // PreviousTxnLgrSeq
+	}
+	if t.IndexNext != nil {
+		fw.uint64(enc{ST_UINT64, 1}, uint64((*t.IndexNext))) // IndexNext
+	}
+	if t.IndexPrevious != nil {
+		fw.uint64(enc{ST_UINT64, 2}, uint64((*t.IndexPrevious))) // IndexPrevious
+	}
+	if t.ExchangeRate != nil {
+		fw.uint64(enc{ST_UINT64, 6}, uint64((*t.ExchangeRate))) // ExchangeRate
+	}
+	if t.leBase.PreviousTxnID != nil {
+		fw.wire(enc{ST_HASH256, 5}, t.leBase.PreviousTxnID) // PreviousTxnID
+	}
+	if t.RootIndex != nil {
+		fw.wire(enc{ST_HASH256, 8}, t.RootIndex) // RootIndex
+	}
+	if t.Owner != nil {
+		fw.wire(enc{ST_ACCOUNT, 2}, t.Owner) // Owner
+	}
+	if t.TakerPaysCurrency != nil {
+		fw.wire(enc{ST_HASH160, 1}, t.TakerPaysCurrency) // TakerPaysCurrency
+	}
+	if t.TakerPaysIssuer != nil {
+		fw.wire(enc{ST_HASH160, 2}, t.TakerPaysIssuer) // TakerPaysIssuer
+	}
+	if t.TakerGetsCurrency != nil {
+		fw.wire(enc{ST_HASH160, 3}, t.TakerGetsCurrency) // TakerGetsCurrency
+	}
+	if t.TakerGetsIssuer != nil {
+		fw.wire(enc{ST_HASH160, 4}, t.TakerGetsIssuer) // TakerGetsIssuer
+	}
+	if t.Indexes != nil && len((*t.Indexes)) > 0 {
+		fw.wire(enc{ST_VECTOR256, 1}, t.Indexes) // Indexes
+	}
+}
+
+func (t *Directory) decodeField(r Reader, e enc) (bool, error) {
+	switch e {
+	case enc{ST_UINT16, 1}: // LedgerEntryType
+		v, err := readUint16(r)
+		t.leBase.LedgerEntryType = LedgerEntryType(v)
+		return true, err
+	case enc{ST_UINT32, 2}: // Flags
+		t.Flags = new(LedgerEntryFlag)
+		v, err := readUint32(r)
+		*t.Flags = LedgerEntryFlag(v)
+		return true, err
+	case enc{ST_UINT32, 5}: // PreviousTxnLgrSeq
+		t.leBase.PreviousTxnLgrSeq = new(uint32)
+		v, err := readUint32(r)
+		*t.leBase.PreviousTxnLgrSeq = uint32(v)
+		return true, err
+	case enc{ST_UINT64, 1}: // IndexNext
+		t.IndexNext = new(NodeIndex)
+		v, err := readUint64(r)
+		*t.IndexNext = NodeIndex(v)
+		return true, err
+	case enc{ST_UINT64, 2}: // IndexPrevious
+		t.IndexPrevious = new(NodeIndex)
+		v, err := readUint64(r)
+		*t.IndexPrevious = NodeIndex(v)
+		return true, err
+	case enc{ST_UINT64, 6}: // ExchangeRate
+		t.ExchangeRate = new(ExchangeRate)
+		v, err := readUint64(r)
+		*t.ExchangeRate = ExchangeRate(v)
+		return true, err
+	case enc{ST_HASH256, 5}: // PreviousTxnID
+		t.leBase.PreviousTxnID = new(Hash256)
+		return true, t.leBase.PreviousTxnID.Unmarshal(r)
+	case enc{ST_HASH256, 6}: // LedgerIndex
+		t.leBase.LedgerIndex = new(Hash256)
+		return true, t.leBase.LedgerIndex.Unmarshal(r)
+	case enc{ST_HASH256, 8}: // RootIndex
+		t.RootIndex = new(Hash256)
+		return true, t.RootIndex.Unmarshal(r)
+	case enc{ST_ACCOUNT, 2}: // Owner
+		t.Owner = new(Account)
+		return true, t.Owner.Unmarshal(r)
+	case enc{ST_HASH160, 1}: // TakerPaysCurrency
+		t.TakerPaysCurrency = new(Hash160)
+		return true, t.TakerPaysCurrency.Unmarshal(r)
+	case enc{ST_HASH160, 2}: // TakerPaysIssuer
+		t.TakerPaysIssuer = new(Hash160)
+		return true, t.TakerPaysIssuer.Unmarshal(r)
+	case enc{ST_HASH160, 3}: // TakerGetsCurrency
+		t.TakerGetsCurrency = new(Hash160)
+		return true, t.TakerGetsCurrency.Unmarshal(r)
+	case enc{ST_HASH160, 4}: // TakerGetsIssuer
+		t.TakerGetsIssuer = new(Hash160)
+		return true, t.TakerGetsIssuer.Unmarshal(r)
+	case enc{ST_VECTOR256, 1}: // Indexes
+		t.Indexes = new(Vector256)
+		return true, t.Indexes.Unmarshal(r)
+	}
+	return false, nil
+}
+
+func (t *Escrow) encodeFields(fw *fieldWriter, nested bool) {
+	fw.setExtra(t.leBase.Fields)
+	if !nested {
+		fw.uint16(enc{ST_UINT16, 1}, uint16(t.leBase.LedgerEntryType)) // LedgerEntryType
+	}
+	if t.Flags != nil {
+		fw.uint32(enc{ST_UINT32, 2}, uint32((*t.Flags))) // Flags
+	}
+	if t.SourceTag != nil {
+		fw.uint32(enc{ST_UINT32, 3}, uint32((*t.SourceTag))) // SourceTag
+	}
+	if t.leBase.PreviousTxnLgrSeq != nil {
+		fw.uint32(enc{ST_UINT32, 5}, uint32((*t.leBase.PreviousTxnLgrSeq))) // PreviousTxnLgrSeq
+	}
+	if t.DestinationTag != nil {
+		fw.uint32(enc{ST_UINT32, 14}, uint32((*t.DestinationTag))) // DestinationTag
+	}
+	if t.CancelAfter != nil {
+		fw.uint32(enc{ST_UINT32, 36}, uint32((*t.CancelAfter))) // CancelAfter
+	}
+	if t.FinishAfter != nil {
+		fw.uint32(enc{ST_UINT32, 37}, uint32((*t.FinishAfter))) // FinishAfter
+	}
+	if t.OwnerNode != nil {
+		fw.uint64(enc{ST_UINT64, 4}, uint64((*t.OwnerNode))) // OwnerNode
+	}
+	if t.leBase.PreviousTxnID != nil {
+		fw.wire(enc{ST_HASH256, 5}, t.leBase.PreviousTxnID) // PreviousTxnID
+	}
+	fw.wire(enc{ST_AMOUNT, 1}, &t.Amount) // Amount
+	if t.Condition != nil && len((*t.Condition)) > 0 {
+		fw.wire(enc{ST_VL, 17}, t.Condition) // Condition
+	}
+	fw.wire(enc{ST_ACCOUNT, 1}, &t.Account)     // Account
+	fw.wire(enc{ST_ACCOUNT, 3}, &t.Destination) // Destination
+}
+
+func (t *Escrow) decodeField(r Reader, e enc) (bool, error) {
+	switch e {
+	case enc{ST_UINT16, 1}: // LedgerEntryType
+		v, err := readUint16(r)
+		t.leBase.LedgerEntryType = LedgerEntryType(v)
+		return true, err
+	case enc{ST_UINT32, 2}: // Flags
+		t.Flags = new(LedgerEntryFlag)
+		v, err := readUint32(r)
+		*t.Flags = LedgerEntryFlag(v)
+		return true, err
+	case enc{ST_UINT32, 3}: // SourceTag
+		t.SourceTag = new(uint32)
+		v, err := readUint32(r)
+		*t.SourceTag = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 5}: // PreviousTxnLgrSeq
+		t.leBase.PreviousTxnLgrSeq = new(uint32)
+		v, err := readUint32(r)
+		*t.leBase.PreviousTxnLgrSeq = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 14}: // DestinationTag
+		t.DestinationTag = new(uint32)
+		v, err := readUint32(r)
+		*t.DestinationTag = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 36}: // CancelAfter
+		t.CancelAfter = new(uint32)
+		v, err := readUint32(r)
+		*t.CancelAfter = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 37}: // FinishAfter
+		t.FinishAfter = new(uint32)
+		v, err := readUint32(r)
+		*t.FinishAfter = uint32(v)
+		return true, err
+	case enc{ST_UINT64, 4}: // OwnerNode
+		t.OwnerNode = new(NodeIndex)
+		v, err := readUint64(r)
+		*t.OwnerNode = NodeIndex(v)
+		return true, err
+	case enc{ST_HASH256, 5}: // PreviousTxnID
+		t.leBase.PreviousTxnID = new(Hash256)
+		return true, t.leBase.PreviousTxnID.Unmarshal(r)
+	case enc{ST_HASH256, 6}: // LedgerIndex
+		t.leBase.LedgerIndex = new(Hash256)
+		return true, t.leBase.LedgerIndex.Unmarshal(r)
+	case enc{ST_AMOUNT, 1}: // Amount
+		return true, t.Amount.Unmarshal(r)
+	case enc{ST_VL, 17}: // Condition
+		t.Condition = new(VariableLength)
+		return true, t.Condition.Unmarshal(r)
+	case enc{ST_ACCOUNT, 1}: // Account
+		return true, t.Account.Unmarshal(r)
+	case enc{ST_ACCOUNT, 3}: // Destination
+		return true, t.Destination.Unmarshal(r)
+	}
+	return false, nil
+}
+
+func (t *EscrowCancel) encodeFields(fw *fieldWriter, nested bool) {
+	fw.setExtra(t.TxBase.Fields)
+	fw.uint16(enc{ST_UINT16, 2}, uint16(t.TxBase.TransactionType)) // TransactionType
+	if t.TxBase.Flags != nil {
+		fw.uint32(enc{ST_UINT32, 2}, uint32((*t.TxBase.Flags))) // Flags
+	}
+	if t.TxBase.SourceTag != nil {
+		fw.uint32(enc{ST_UINT32, 3}, uint32((*t.TxBase.SourceTag))) // SourceTag
+	}
+	fw.uint32(enc{ST_UINT32, 4}, uint32(t.TxBase.Sequence)) // Sequence
+	fw.uint32(enc{ST_UINT32, 25}, uint32(t.OfferSequence))  // OfferSequence
+	if t.TxBase.LastLedgerSequence != nil {
+		fw.uint32(enc{ST_UINT32, 27}, uint32((*t.TxBase.LastLedgerSequence))) // LastLedgerSequence
+	}
+	if t.TxBase.TicketSequence != nil {
+		fw.uint32(enc{ST_UINT32, 41}, uint32((*t.TxBase.TicketSequence))) // TicketSequence
+	}
+	if t.TxBase.PreviousTxnID != nil {
+		fw.wire(enc{ST_HASH256, 5}, t.TxBase.PreviousTxnID) // PreviousTxnID
+	}
+	if t.TxBase.AccountTxnID != nil {
+		fw.wire(enc{ST_HASH256, 9}, t.TxBase.AccountTxnID) // AccountTxnID
+	}
+	fw.wire(enc{ST_AMOUNT, 8}, &t.TxBase.Fee) // Fee
+	if t.TxBase.SigningPubKey != nil {
+		fw.wire(enc{ST_VL, 3}, t.TxBase.SigningPubKey) // SigningPubKey
+	}
+	if !fw.ignoreSigningFields && t.TxBase.TxnSignature != nil && len((*t.TxBase.TxnSignature)) > 0 {
+		fw.wire(enc{ST_VL, 4}, t.TxBase.TxnSignature) // TxnSignature
+	}
+	fw.wire(enc{ST_ACCOUNT, 1}, &t.TxBase.Account) // Account
+	fw.wire(enc{ST_ACCOUNT, 2}, &t.Owner)          // Owner
+	if !fw.ignoreSigningFields && len(t.TxBase.Signers) > 0 {
+		fw.header(enc{ST_ARRAY, 3}) // Signers
+		for i := range t.TxBase.Signers {
+			e95 := &t.TxBase.Signers[i]
+			c96 := fw.child()
+			c96.header(enc{ST_OBJECT, 16}) // Signer
+			c97 := c96.child()
+			if e95.Signer.SigningPubKey != nil {
+				c97.wire(enc{ST_VL, 3}, e95.Signer.SigningPubKey) // SigningPubKey
+			}
+			if !fw.ignoreSigningFields && e95.Signer.TxnSignature != nil && len((*e95.Signer.TxnSignature)) > 0 {
+				c97.wire(enc{ST_VL, 4}, e95.Signer.TxnSignature) // TxnSignature
+			}
+			c97.wire(enc{ST_ACCOUNT, 1}, &e95.Signer.Account) // Account
+			c96.close(&c97)
+			c96.end(endOfObject)
+			fw.close(&c96)
+		}
+		fw.end(endOfArray)
+	}
+	if len(t.TxBase.Memos) > 0 {
+		fw.header(enc{ST_ARRAY, 9}) // Memos
+		for i := range t.TxBase.Memos {
+			e98 := &t.TxBase.Memos[i]
+			c99 := fw.child()
+			c99.header(enc{ST_OBJECT, 10}) // Memo
+			c100 := c99.child()
+			if len(e98.Memo.MemoType) > 0 {
+				c100.wire(enc{ST_VL, 12}, &e98.Memo.MemoType) // MemoType
+			}
+			if len(e98.Memo.MemoData) > 0 {
+				c100.wire(enc{ST_VL, 13}, &e98.Memo.MemoData) // MemoData
+			}
+			if len(e98.Memo.MemoFormat) > 0 {
+				c100.wire(enc{ST_VL, 14}, &e98.Memo.MemoFormat) // MemoFormat
+			}
+			c99.close(&c100)
+			c99.end(endOfObject)
+			fw.close(&c99)
+		}
+		fw.end(endOfArray)
+	}
+}
+
+func (t *EscrowCancel) decodeField(r Reader, e enc) (bool, error) {
+	switch e {
+	case enc{ST_UINT16, 2}: // TransactionType
+		v, err := readUint16(r)
+		t.TxBase.TransactionType = TransactionType(v)
+		return true, err
+	case enc{ST_UINT32, 2}: // Flags
+		t.TxBase.Flags = new(TransactionFlag)
+		v, err := readUint32(r)
+		*t.TxBase.Flags = TransactionFlag(v)
+		return true, err
+	case enc{ST_UINT32, 3}: // SourceTag
+		t.TxBase.SourceTag = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.SourceTag = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 4}: // Sequence
+		v, err := readUint32(r)
+		t.TxBase.Sequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 25}: // OfferSequence
+		v, err := readUint32(r)
+		t.OfferSequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 27}: // LastLedgerSequence
+		t.TxBase.LastLedgerSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.LastLedgerSequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 41}: // TicketSequence
+		t.TxBase.TicketSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.TicketSequence = uint32(v)
+		return true, err
+	case enc{ST_HASH256, 5}: // PreviousTxnID
+		t.TxBase.PreviousTxnID = new(Hash256)
+		return true, t.TxBase.PreviousTxnID.Unmarshal(r)
+	case enc{ST_HASH256, 9}: // AccountTxnID
+		t.TxBase.AccountTxnID = new(Hash256)
+		return true, t.TxBase.AccountTxnID.Unmarshal(r)
+	case enc{ST_AMOUNT, 8}: // Fee
+		return true, t.TxBase.Fee.Unmarshal(r)
+	case enc{ST_VL, 3}: // SigningPubKey
+		t.TxBase.SigningPubKey = new(PublicKey)
+		return true, t.TxBase.SigningPubKey.Unmarshal(r)
+	case enc{ST_VL, 4}: // TxnSignature
+		t.TxBase.TxnSignature = new(VariableLength)
+		return true, t.TxBase.TxnSignature.Unmarshal(r)
+	case enc{ST_ACCOUNT, 1}: // Account
+		return true, t.TxBase.Account.Unmarshal(r)
+	case enc{ST_ACCOUNT, 2}: // Owner
+		return true, t.Owner.Unmarshal(r)
+	}
+	return false, nil
+}
+
+func (t *EscrowCreate) encodeFields(fw *fieldWriter, nested bool) {
+	fw.setExtra(t.TxBase.Fields)
+	fw.uint16(enc{ST_UINT16, 2}, uint16(t.TxBase.TransactionType)) // TransactionType
+	if t.TxBase.Flags != nil {
+		fw.uint32(enc{ST_UINT32, 2}, uint32((*t.TxBase.Flags))) // Flags
+	}
+	if t.TxBase.SourceTag != nil {
+		fw.uint32(enc{ST_UINT32, 3}, uint32((*t.TxBase.SourceTag))) // SourceTag
+	}
+	fw.uint32(enc{ST_UINT32, 4}, uint32(t.TxBase.Sequence)) // Sequence
+	if t.DestinationTag != nil {
+		fw.uint32(enc{ST_UINT32, 14}, uint32((*t.DestinationTag))) // DestinationTag
+	}
+	if t.TxBase.LastLedgerSequence != nil {
+		fw.uint32(enc{ST_UINT32, 27}, uint32((*t.TxBase.LastLedgerSequence))) // LastLedgerSequence
+	}
+	if t.CancelAfter != nil {
+		fw.uint32(enc{ST_UINT32, 36}, uint32((*t.CancelAfter))) // CancelAfter
+	}
+	if t.FinishAfter != nil {
+		fw.uint32(enc{ST_UINT32, 37}, uint32((*t.FinishAfter))) // FinishAfter
+	}
+	if t.TxBase.TicketSequence != nil {
+		fw.uint32(enc{ST_UINT32, 41}, uint32((*t.TxBase.TicketSequence))) // TicketSequence
+	}
+	if t.TxBase.PreviousTxnID != nil {
+		fw.wire(enc{ST_HASH256, 5}, t.TxBase.PreviousTxnID) // PreviousTxnID
+	}
+	if t.TxBase.AccountTxnID != nil {
+		fw.wire(enc{ST_HASH256, 9}, t.TxBase.AccountTxnID) // AccountTxnID
+	}
+	if t.Digest != nil {
+		fw.wire(enc{ST_HASH256, 21}, t.Digest) // Digest
+	}
+	fw.wire(enc{ST_AMOUNT, 1}, &t.Amount)     // Amount
+	fw.wire(enc{ST_AMOUNT, 8}, &t.TxBase.Fee) // Fee
+	if t.TxBase.SigningPubKey != nil {
+		fw.wire(enc{ST_VL, 3}, t.TxBase.SigningPubKey) // SigningPubKey
+	}
+	if !fw.ignoreSigningFields && t.TxBase.TxnSignature != nil && len((*t.TxBase.TxnSignature)) > 0 {
+		fw.wire(enc{ST_VL, 4}, t.TxBase.TxnSignature) // TxnSignature
+	}
+	fw.wire(enc{ST_ACCOUNT, 1}, &t.TxBase.Account) // Account
+	fw.wire(enc{ST_ACCOUNT, 3}, &t.Destination)    // Destination
+	if !fw.ignoreSigningFields && len(t.TxBase.Signers) > 0 {
+		fw.header(enc{ST_ARRAY, 3}) // Signers
+		for i := range t.TxBase.Signers {
+			e101 := &t.TxBase.Signers[i]
+			c102 := fw.child()
+			c102.header(enc{ST_OBJECT, 16}) // Signer
+			c103 := c102.child()
+			if e101.Signer.SigningPubKey != nil {
+				c103.wire(enc{ST_VL, 3}, e101.Signer.SigningPubKey) // SigningPubKey
+			}
+			if !fw.ignoreSigningFields && e101.Signer.TxnSignature != nil && len((*e101.Signer.TxnSignature)) > 0 {
+				c103.wire(enc{ST_VL, 4}, e101.Signer.TxnSignature) // TxnSignature
+			}
+			c103.wire(enc{ST_ACCOUNT, 1}, &e101.Signer.Account) // Account
+			c102.close(&c103)
+			c102.end(endOfObject)
+			fw.close(&c102)
+		}
+		fw.end(endOfArray)
+	}
+	if len(t.TxBase.Memos) > 0 {
+		fw.header(enc{ST_ARRAY, 9}) // Memos
+		for i := range t.TxBase.Memos {
+			e104 := &t.TxBase.Memos[i]
+			c105 := fw.child()
+			c105.header(enc{ST_OBJECT, 10}) // Memo
+			c106 := c105.child()
+			if len(e104.Memo.MemoType) > 0 {
+				c106.wire(enc{ST_VL, 12}, &e104.Memo.MemoType) // MemoType
+			}
+			if len(e104.Memo.MemoData) > 0 {
+				c106.wire(enc{ST_VL, 13}, &e104.Memo.MemoData) // MemoData
+			}
+			if len(e104.Memo.MemoFormat) > 0 {
+				c106.wire(enc{ST_VL, 14}, &e104.Memo.MemoFormat) // MemoFormat
+			}
+			c105.close(&c106)
+			c105.end(endOfObject)
+			fw.close(&c105)
+		}
+		fw.end(endOfArray)
+	}
+}
+
+func (t *EscrowCreate) decodeField(r Reader, e enc) (bool, error) {
+	switch e {
+	case enc{ST_UINT16, 2}: // TransactionType
+		v, err := readUint16(r)
+		t.TxBase.TransactionType = TransactionType(v)
+		return true, err
+	case enc{ST_UINT32, 2}: // Flags
+		t.TxBase.Flags = new(TransactionFlag)
+		v, err := readUint32(r)
+		*t.TxBase.Flags = TransactionFlag(v)
+		return true, err
+	case enc{ST_UINT32, 3}: // SourceTag
+		t.TxBase.SourceTag = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.SourceTag = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 4}: // Sequence
+		v, err := readUint32(r)
+		t.TxBase.Sequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 14}: // DestinationTag
+		t.DestinationTag = new(uint32)
+		v, err := readUint32(r)
+		*t.DestinationTag = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 27}: // LastLedgerSequence
+		t.TxBase.LastLedgerSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.LastLedgerSequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 36}: // CancelAfter
+		t.CancelAfter = new(uint32)
+		v, err := readUint32(r)
+		*t.CancelAfter = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 37}: // FinishAfter
+		t.FinishAfter = new(uint32)
+		v, err := readUint32(r)
+		*t.FinishAfter = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 41}: // TicketSequence
+		t.TxBase.TicketSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.TicketSequence = uint32(v)
+		return true, err
+	case enc{ST_HASH256, 5}: // PreviousTxnID
+		t.TxBase.PreviousTxnID = new(Hash256)
+		return true, t.TxBase.PreviousTxnID.Unmarshal(r)
+	case enc{ST_HASH256, 9}: // AccountTxnID
+		t.TxBase.AccountTxnID = new(Hash256)
+		return true, t.TxBase.AccountTxnID.Unmarshal(r)
+	case enc{ST_HASH256, 21}: // Digest
+		t.Digest = new(Hash256)
+		return true, t.Digest.Unmarshal(r)
+	case enc{ST_AMOUNT, 1}: // Amount
+		return true, t.Amount.Unmarshal(r)
+	case enc{ST_AMOUNT, 8}: // Fee
+		return true, t.TxBase.Fee.Unmarshal(r)
+	case enc{ST_VL, 3}: // SigningPubKey
+		t.TxBase.SigningPubKey = new(PublicKey)
+		return true, t.TxBase.SigningPubKey.Unmarshal(r)
+	case enc{ST_VL, 4}: // TxnSignature
+		t.TxBase.TxnSignature = new(VariableLength)
+		return true, t.TxBase.TxnSignature.Unmarshal(r)
+	case enc{ST_ACCOUNT, 1}: // Account
+		return true, t.TxBase.Account.Unmarshal(r)
+	case enc{ST_ACCOUNT, 3}: // Destination
+		return true, t.Destination.Unmarshal(r)
+	}
+	return false, nil
+}
+
+func (t *EscrowFinish) encodeFields(fw *fieldWriter, nested bool) {
+	fw.setExtra(t.TxBase.Fields)
+	fw.uint16(enc{ST_UINT16, 2}, uint16(t.TxBase.TransactionType)) // TransactionType
+	if t.TxBase.Flags != nil {
+		fw.uint32(enc{ST_UINT32, 2}, uint32((*t.TxBase.Flags))) // Flags
+	}
+	if t.TxBase.SourceTag != nil {
+		fw.uint32(enc{ST_UINT32, 3}, uint32((*t.TxBase.SourceTag))) // SourceTag
+	}
+	fw.uint32(enc{ST_UINT32, 4}, uint32(t.TxBase.Sequence)) // Sequence
+	fw.uint32(enc{ST_UINT32, 25}, uint32(t.OfferSequence))  // OfferSequence
+	if t.TxBase.LastLedgerSequence != nil {
+		fw.uint32(enc{ST_UINT32, 27}, uint32((*t.TxBase.LastLedgerSequence))) // LastLedgerSequence
+	}
+	if t.TxBase.TicketSequence != nil {
+		fw.uint32(enc{ST_UINT32, 41}, uint32((*t.TxBase.TicketSequence))) // TicketSequence
+	}
+	if t.TxBase.PreviousTxnID != nil {
+		fw.wire(enc{ST_HASH256, 5}, t.TxBase.PreviousTxnID) // PreviousTxnID
+	}
+	if t.TxBase.AccountTxnID != nil {
+		fw.wire(enc{ST_HASH256, 9}, t.TxBase.AccountTxnID) // AccountTxnID
+	}
+	if t.Digest != nil {
+		fw.wire(enc{ST_HASH256, 21}, t.Digest) // Digest
+	}
+	fw.wire(enc{ST_AMOUNT, 8}, &t.TxBase.Fee) // Fee
+	if t.TxBase.SigningPubKey != nil {
+		fw.wire(enc{ST_VL, 3}, t.TxBase.SigningPubKey) // SigningPubKey
+	}
+	if !fw.ignoreSigningFields && t.TxBase.TxnSignature != nil && len((*t.TxBase.TxnSignature)) > 0 {
+		fw.wire(enc{ST_VL, 4}, t.TxBase.TxnSignature) // TxnSignature
+	}
+	fw.wire(enc{ST_ACCOUNT, 1}, &t.TxBase.Account) // Account
+	fw.wire(enc{ST_ACCOUNT, 2}, &t.Owner)          // Owner
+	if !fw.ignoreSigningFields && len(t.TxBase.Signers) > 0 {
+		fw.header(enc{ST_ARRAY, 3}) // Signers
+		for i := range t.TxBase.Signers {
+			e107 := &t.TxBase.Signers[i]
+			c108 := fw.child()
+			c108.header(enc{ST_OBJECT, 16}) // Signer
+			c109 := c108.child()
+			if e107.Signer.SigningPubKey != nil {
+				c109.wire(enc{ST_VL, 3}, e107.Signer.SigningPubKey) // SigningPubKey
+			}
+			if !fw.ignoreSigningFields && e107.Signer.TxnSignature != nil && len((*e107.Signer.TxnSignature)) > 0 {
+				c109.wire(enc{ST_VL, 4}, e107.Signer.TxnSignature) // TxnSignature
+			}
+			c109.wire(enc{ST_ACCOUNT, 1}, &e107.Signer.Account) // Account
+			c108.close(&c109)
+			c108.end(endOfObject)
+			fw.close(&c108)
+		}
+		fw.end(endOfArray)
+	}
+	if len(t.TxBase.Memos) > 0 {
+		fw.header(enc{ST_ARRAY, 9}) // Memos
+		for i := range t.TxBase.Memos {
+			e110 := &t.TxBase.Memos[i]
+			c111 := fw.child()
+			c111.header(enc{ST_OBJECT, 10}) // Memo
+			c112 := c111.child()
+			if len(e110.Memo.MemoType) > 0 {
+				c112.wire(enc{ST_VL, 12}, &e110.Memo.MemoType) // MemoType
+			}
+			if len(e110.Memo.MemoData) > 0 {
+				c112.wire(enc{ST_VL, 13}, &e110.Memo.MemoData) // MemoData
+			}
+			if len(e110.Memo.MemoFormat) > 0 {
+				c112.wire(enc{ST_VL, 14}, &e110.Memo.MemoFormat) // MemoFormat
+			}
+			c111.close(&c112)
+			c111.end(endOfObject)
+			fw.close(&c111)
+		}
+		fw.end(endOfArray)
+	}
+	if t.Method != nil {
+		fw.uint8(enc{ST_UINT8, 2}, uint8((*t.Method))) // Method
+	}
+}
+
+func (t *EscrowFinish) decodeField(r Reader, e enc) (bool, error) {
+	switch e {
+	case enc{ST_UINT16, 2}: // TransactionType
+		v, err := readUint16(r)
+		t.TxBase.TransactionType = TransactionType(v)
+		return true, err
+	case enc{ST_UINT32, 2}: // Flags
+		t.TxBase.Flags = new(TransactionFlag)
+		v, err := readUint32(r)
+		*t.TxBase.Flags = TransactionFlag(v)
+		return true, err
+	case enc{ST_UINT32, 3}: // SourceTag
+		t.TxBase.SourceTag = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.SourceTag = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 4}: // Sequence
+		v, err := readUint32(r)
+		t.TxBase.Sequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 25}: // OfferSequence
+		v, err := readUint32(r)
+		t.OfferSequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 27}: // LastLedgerSequence
+		t.TxBase.LastLedgerSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.LastLedgerSequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 41}: // TicketSequence
+		t.TxBase.TicketSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.TicketSequence = uint32(v)
+		return true, err
+	case enc{ST_HASH256, 5}: // PreviousTxnID
+		t.TxBase.PreviousTxnID = new(Hash256)
+		return true, t.TxBase.PreviousTxnID.Unmarshal(r)
+	case enc{ST_HASH256, 9}: // AccountTxnID
+		t.TxBase.AccountTxnID = new(Hash256)
+		return true, t.TxBase.AccountTxnID.Unmarshal(r)
+	case enc{ST_HASH256, 21}: // Digest
+		t.Digest = new(Hash256)
+		return true, t.Digest.Unmarshal(r)
+	case enc{ST_AMOUNT, 8}: // Fee
+		return true, t.TxBase.Fee.Unmarshal(r)
+	case enc{ST_VL, 3}: // SigningPubKey
+		t.TxBase.SigningPubKey = new(PublicKey)
+		return true, t.TxBase.SigningPubKey.Unmarshal(r)
+	case enc{ST_VL, 4}: // TxnSignature
+		t.TxBase.TxnSignature = new(VariableLength)
+		return true, t.TxBase.TxnSignature.Unmarshal(r)
+	case enc{ST_ACCOUNT, 1}: // Account
+		return true, t.TxBase.Account.Unmarshal(r)
+	case enc{ST_ACCOUNT, 2}: // Owner
+		return true, t.Owner.Unmarshal(r)
+	case enc{ST_UINT8, 2}: // Method
+		t.Method = new(uint8)
+		v, err := readUint8(r)
+		*t.Method = uint8(v)
+		return true, err
+	}
+	return false, nil
+}
+
+func (t *FeeSettings) encodeFields(fw *fieldWriter, nested bool) {
+	fw.setExtra(t.leBase.Fields)
+	if !nested {
+		fw.uint16(enc{ST_UINT16, 1}, uint16(t.leBase.LedgerEntryType)) // LedgerEntryType
+	}
+	if t.Flags != nil {
+		fw.uint32(enc{ST_UINT32, 2}, uint32((*t.Flags))) // Flags
+	}
+	if t.leBase.PreviousTxnLgrSeq != nil {
+		fw.uint32(enc{ST_UINT32, 5}, uint32((*t.leBase.PreviousTxnLgrSeq))) // PreviousTxnLgrSeq
+	}
+	if t.ReferenceFeeUnits != nil {
+		fw.uint32(enc{ST_UINT32, 30}, uint32((*t.ReferenceFeeUnits))) // ReferenceFeeUnits
+	}
+	if t.ReserveBase != nil {
+		fw.uint32(enc{ST_UINT32, 31}, uint32((*t.ReserveBase))) // ReserveBase
+	}
+	if t.ReserveIncrement != nil {
+		fw.uint32(enc{ST_UINT32, 32}, uint32((*t.ReserveIncrement))) // ReserveIncrement
+	}
+	if t.BaseFee != nil {
+		fw.uint64(enc{ST_UINT64, 5}, uint64((*t.BaseFee))) // BaseFee
+	}
+	if t.leBase.PreviousTxnID != nil {
+		fw.wire(enc{ST_HASH256, 5}, t.leBase.PreviousTxnID) // PreviousTxnID
+	}
+}
+
+func (t *FeeSettings) decodeField(r Reader, e enc) (bool, error) {
+	switch e {
+	case enc{ST_UINT16, 1}: // LedgerEntryType
+		v, err := readUint16(r)
+		t.leBase.LedgerEntryType = LedgerEntryType(v)
+		return true, err
+	case enc{ST_UINT32, 2}: // Flags
+		t.Flags = new(LedgerEntryFlag)
+		v, err := readUint32(r)
+		*t.Flags = LedgerEntryFlag(v)
+		return true, err
+	case enc{ST_UINT32, 5}: // PreviousTxnLgrSeq
+		t.leBase.PreviousTxnLgrSeq = new(uint32)
+		v, err := readUint32(r)
+		*t.leBase.PreviousTxnLgrSeq = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 30}: // ReferenceFeeUnits
+		t.ReferenceFeeUnits = new(uint32)
+		v, err := readUint32(r)
+		*t.ReferenceFeeUnits = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 31}: // ReserveBase
+		t.ReserveBase = new(uint32)
+		v, err := readUint32(r)
+		*t.ReserveBase = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 32}: // ReserveIncrement
+		t.ReserveIncrement = new(uint32)
+		v, err := readUint32(r)
+		*t.ReserveIncrement = uint32(v)
+		return true, err
+	case enc{ST_UINT64, 5}: // BaseFee
+		t.BaseFee = new(Uint64Hex)
+		v, err := readUint64(r)
+		*t.BaseFee = Uint64Hex(v)
+		return true, err
+	case enc{ST_HASH256, 5}: // PreviousTxnID
+		t.leBase.PreviousTxnID = new(Hash256)
+		return true, t.leBase.PreviousTxnID.Unmarshal(r)
+	case enc{ST_HASH256, 6}: // LedgerIndex
+		t.leBase.LedgerIndex = new(Hash256)
+		return true, t.leBase.LedgerIndex.Unmarshal(r)
+	}
+	return false, nil
+}
+
+func (t *GenericLedgerEntry) encodeFields(fw *fieldWriter, nested bool) {
+	fw.setExtra(t.leBase.Fields)
+	if !nested {
+		fw.uint16(enc{ST_UINT16, 1}, uint16(t.leBase.LedgerEntryType)) // LedgerEntryType
+	}
+	if t.leBase.PreviousTxnLgrSeq != nil {
+		fw.uint32(enc{ST_UINT32, 5}, uint32((*t.leBase.PreviousTxnLgrSeq))) // PreviousTxnLgrSeq
+	}
+	if t.leBase.PreviousTxnID != nil {
+		fw.wire(enc{ST_HASH256, 5}, t.leBase.PreviousTxnID) // PreviousTxnID
+	}
+}
+
+func (t *GenericLedgerEntry) decodeField(r Reader, e enc) (bool, error) {
+	switch e {
+	case enc{ST_UINT16, 1}: // LedgerEntryType
+		v, err := readUint16(r)
+		t.leBase.LedgerEntryType = LedgerEntryType(v)
+		return true, err
+	case enc{ST_UINT32, 5}: // PreviousTxnLgrSeq
+		t.leBase.PreviousTxnLgrSeq = new(uint32)
+		v, err := readUint32(r)
+		*t.leBase.PreviousTxnLgrSeq = uint32(v)
+		return true, err
+	case enc{ST_HASH256, 5}: // PreviousTxnID
+		t.leBase.PreviousTxnID = new(Hash256)
+		return true, t.leBase.PreviousTxnID.Unmarshal(r)
+	case enc{ST_HASH256, 6}: // LedgerIndex
+		t.leBase.LedgerIndex = new(Hash256)
+		return true, t.leBase.LedgerIndex.Unmarshal(r)
+	}
+	return false, nil
+}
+
+func (t *GenericTx) encodeFields(fw *fieldWriter, nested bool) {
+	fw.setExtra(t.TxBase.Fields)
+	fw.uint16(enc{ST_UINT16, 2}, uint16(t.TxBase.TransactionType)) // TransactionType
+	if t.TxBase.Flags != nil {
+		fw.uint32(enc{ST_UINT32, 2}, uint32((*t.TxBase.Flags))) // Flags
+	}
+	if t.TxBase.SourceTag != nil {
+		fw.uint32(enc{ST_UINT32, 3}, uint32((*t.TxBase.SourceTag))) // SourceTag
+	}
+	fw.uint32(enc{ST_UINT32, 4}, uint32(t.TxBase.Sequence)) // Sequence
+	if t.TxBase.LastLedgerSequence != nil {
+		fw.uint32(enc{ST_UINT32, 27}, uint32((*t.TxBase.LastLedgerSequence))) // LastLedgerSequence
+	}
+	if t.TxBase.TicketSequence != nil {
+		fw.uint32(enc{ST_UINT32, 41}, uint32((*t.TxBase.TicketSequence))) // TicketSequence
+	}
+	if t.TxBase.PreviousTxnID != nil {
+		fw.wire(enc{ST_HASH256, 5}, t.TxBase.PreviousTxnID) // PreviousTxnID
+	}
+	if t.TxBase.AccountTxnID != nil {
+		fw.wire(enc{ST_HASH256, 9}, t.TxBase.AccountTxnID) // AccountTxnID
+	}
+	fw.wire(enc{ST_AMOUNT, 8}, &t.TxBase.Fee) // Fee
+	if t.TxBase.SigningPubKey != nil {
+		fw.wire(enc{ST_VL, 3}, t.TxBase.SigningPubKey) // SigningPubKey
+	}
+	if !fw.ignoreSigningFields && t.TxBase.TxnSignature != nil && len((*t.TxBase.TxnSignature)) > 0 {
+		fw.wire(enc{ST_VL, 4}, t.TxBase.TxnSignature) // TxnSignature
+	}
+	fw.wire(enc{ST_ACCOUNT, 1}, &t.TxBase.Account) // Account
+	if !fw.ignoreSigningFields && len(t.TxBase.Signers) > 0 {
+		fw.header(enc{ST_ARRAY, 3}) // Signers
+		for i := range t.TxBase.Signers {
+			e113 := &t.TxBase.Signers[i]
+			c114 := fw.child()
+			c114.header(enc{ST_OBJECT, 16}) // Signer
+			c115 := c114.child()
+			if e113.Signer.SigningPubKey != nil {
+				c115.wire(enc{ST_VL, 3}, e113.Signer.SigningPubKey) // SigningPubKey
+			}
+			if !fw.ignoreSigningFields && e113.Signer.TxnSignature != nil && len((*e113.Signer.TxnSignature)) > 0 {
+				c115.wire(enc{ST_VL, 4}, e113.Signer.TxnSignature) // TxnSignature
+			}
+			c115.wire(enc{ST_ACCOUNT, 1}, &e113.Signer.Account) // Account
+			c114.close(&c115)
+			c114.end(endOfObject)
+			fw.close(&c114)
+		}
+		fw.end(endOfArray)
+	}
+	if len(t.TxBase.Memos) > 0 {
+		fw.header(enc{ST_ARRAY, 9}) // Memos
+		for i := range t.TxBase.Memos {
+			e116 := &t.TxBase.Memos[i]
+			c117 := fw.child()
+			c117.header(enc{ST_OBJECT, 10}) // Memo
+			c118 := c117.child()
+			if len(e116.Memo.MemoType) > 0 {
+				c118.wire(enc{ST_VL, 12}, &e116.Memo.MemoType) // MemoType
+			}
+			if len(e116.Memo.MemoData) > 0 {
+				c118.wire(enc{ST_VL, 13}, &e116.Memo.MemoData) // MemoData
+			}
+			if len(e116.Memo.MemoFormat) > 0 {
+				c118.wire(enc{ST_VL, 14}, &e116.Memo.MemoFormat) // MemoFormat
+			}
+			c117.close(&c118)
+			c117.end(endOfObject)
+			fw.close(&c117)
+		}
+		fw.end(endOfArray)
+	}
+}
+
+func (t *GenericTx) decodeField(r Reader, e enc) (bool, error) {
+	switch e {
+	case enc{ST_UINT16, 2}: // TransactionType
+		v, err := readUint16(r)
+		t.TxBase.TransactionType = TransactionType(v)
+		return true, err
+	case enc{ST_UINT32, 2}: // Flags
+		t.TxBase.Flags = new(TransactionFlag)
+		v, err := readUint32(r)
+		*t.TxBase.Flags = TransactionFlag(v)
+		return true, err
+	case enc{ST_UINT32, 3}: // SourceTag
+		t.TxBase.SourceTag = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.SourceTag = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 4}: // Sequence
+		v, err := readUint32(r)
+		t.TxBase.Sequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 27}: // LastLedgerSequence
+		t.TxBase.LastLedgerSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.LastLedgerSequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 41}: // TicketSequence
+		t.TxBase.TicketSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.TicketSequence = uint32(v)
+		return true, err
+	case enc{ST_HASH256, 5}: // PreviousTxnID
+		t.TxBase.PreviousTxnID = new(Hash256)
+		return true, t.TxBase.PreviousTxnID.Unmarshal(r)
+	case enc{ST_HASH256, 9}: // AccountTxnID
+		t.TxBase.AccountTxnID = new(Hash256)
+		return true, t.TxBase.AccountTxnID.Unmarshal(r)
+	case enc{ST_AMOUNT, 8}: // Fee
+		return true, t.TxBase.Fee.Unmarshal(r)
+	case enc{ST_VL, 3}: // SigningPubKey
+		t.TxBase.SigningPubKey = new(PublicKey)
+		return true, t.TxBase.SigningPubKey.Unmarshal(r)
+	case enc{ST_VL, 4}: // TxnSignature
+		t.TxBase.TxnSignature = new(VariableLength)
+		return true, t.TxBase.TxnSignature.Unmarshal(r)
+	case enc{ST_ACCOUNT, 1}: // Account
+		return true, t.TxBase.Account.Unmarshal(r)
+	}
+	return false, nil
+}
+
+func (t *LedgerHashes) encodeFields(fw *fieldWriter, nested bool) {
+	fw.setExtra(t.leBase.Fields)
+	if !nested {
+		fw.uint16(enc{ST_UINT16, 1}, uint16(t.leBase.LedgerEntryType)) // LedgerEntryType
+	}
+	if t.Flags != nil {
+		fw.uint32(enc{ST_UINT32, 2}, uint32((*t.Flags))) // Flags
+	}
+	if t.leBase.PreviousTxnLgrSeq != nil {
+		fw.uint32(enc{ST_UINT32, 5}, uint32((*t.leBase.PreviousTxnLgrSeq))) // PreviousTxnLgrSeq
+	}
+	if t.FirstLedgerSequence != nil {
+		fw.uint32(enc{ST_UINT32, 26}, uint32((*t.FirstLedgerSequence))) // FirstLedgerSequence
+	}
+	if t.LastLedgerSequence != nil {
+		fw.uint32(enc{ST_UINT32, 27}, uint32((*t.LastLedgerSequence))) // LastLedgerSequence
+	}
+	if t.leBase.PreviousTxnID != nil {
+		fw.wire(enc{ST_HASH256, 5}, t.leBase.PreviousTxnID) // PreviousTxnID
+	}
+	if t.Hashes != nil && len((*t.Hashes)) > 0 {
+		fw.wire(enc{ST_VECTOR256, 2}, t.Hashes) // Hashes
+	}
+}
+
+func (t *LedgerHashes) decodeField(r Reader, e enc) (bool, error) {
+	switch e {
+	case enc{ST_UINT16, 1}: // LedgerEntryType
+		v, err := readUint16(r)
+		t.leBase.LedgerEntryType = LedgerEntryType(v)
+		return true, err
+	case enc{ST_UINT32, 2}: // Flags
+		t.Flags = new(LedgerEntryFlag)
+		v, err := readUint32(r)
+		*t.Flags = LedgerEntryFlag(v)
+		return true, err
+	case enc{ST_UINT32, 5}: // PreviousTxnLgrSeq
+		t.leBase.PreviousTxnLgrSeq = new(uint32)
+		v, err := readUint32(r)
+		*t.leBase.PreviousTxnLgrSeq = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 26}: // FirstLedgerSequence
+		t.FirstLedgerSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.FirstLedgerSequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 27}: // LastLedgerSequence
+		t.LastLedgerSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.LastLedgerSequence = uint32(v)
+		return true, err
+	case enc{ST_HASH256, 5}: // PreviousTxnID
+		t.leBase.PreviousTxnID = new(Hash256)
+		return true, t.leBase.PreviousTxnID.Unmarshal(r)
+	case enc{ST_HASH256, 6}: // LedgerIndex
+		t.leBase.LedgerIndex = new(Hash256)
+		return true, t.leBase.LedgerIndex.Unmarshal(r)
+	case enc{ST_VECTOR256, 2}: // Hashes
+		t.Hashes = new(Vector256)
+		return true, t.Hashes.Unmarshal(r)
+	}
+	return false, nil
+}
+
+func (t *MetaData) encodeFields(fw *fieldWriter, nested bool) {
+	fw.setExtra(t.Fields)
+	fw.uint32(enc{ST_UINT32, 28}, uint32(t.TransactionIndex)) // TransactionIndex
+	if t.DeliveredAmount != nil {
+		fw.wire(enc{ST_AMOUNT, 18}, t.DeliveredAmount) // DeliveredAmount
+	}
+	if len(t.AffectedNodes) > 0 {
+		fw.header(enc{ST_ARRAY, 8}) // AffectedNodes
+		for i := range t.AffectedNodes {
+			e119 := &t.AffectedNodes[i]
+			c120 := fw.child()
+			if e119.CreatedNode != nil {
+				c120.header(enc{ST_OBJECT, 3}) // CreatedNode
+				c122 := c120.child()
+				e119.CreatedNode.encodeFields(&c122, false)
+				c120.close(&c122)
+				c120.end(endOfObject)
+			}
+			if e119.DeletedNode != nil {
+				c120.header(enc{ST_OBJECT, 4}) // DeletedNode
+				c123 := c120.child()
+				e119.DeletedNode.encodeFields(&c123, false)
+				c120.close(&c123)
+				c120.end(endOfObject)
+			}
+			if e119.ModifiedNode != nil {
+				c120.header(enc{ST_OBJECT, 5}) // ModifiedNode
+				c121 := c120.child()
+				e119.ModifiedNode.encodeFields(&c121, false)
+				c120.close(&c121)
+				c120.end(endOfObject)
+			}
+			fw.close(&c120)
+		}
+		fw.end(endOfArray)
+	}
+	fw.wire(enc{ST_UINT8, 3}, &t.TransactionResult) // TransactionResult
+}
+
+func (t *MetaData) decodeField(r Reader, e enc) (bool, error) {
+	switch e {
+	case enc{ST_UINT32, 28}: // TransactionIndex
+		v, err := readUint32(r)
+		t.TransactionIndex = uint32(v)
+		return true, err
+	case enc{ST_AMOUNT, 18}: // DeliveredAmount
+		t.DeliveredAmount = new(Amount)
+		return true, t.DeliveredAmount.Unmarshal(r)
+	case enc{ST_UINT8, 3}: // TransactionResult
+		return true, t.TransactionResult.Unmarshal(r)
+	}
+	return false, nil
+}
+
+func (t *MultiSignPayment) encodeFields(fw *fieldWriter, nested bool) {
+	fw.setExtra(t.MultiSignTxBase.Fields)
+	fw.uint16(enc{ST_UINT16, 2}, uint16(t.MultiSignTxBase.TransactionType)) // TransactionType
+	if t.MultiSignTxBase.Flags != nil {
+		fw.uint32(enc{ST_UINT32, 2}, uint32((*t.MultiSignTxBase.Flags))) // Flags
+	}
+	if t.MultiSignTxBase.SourceTag != nil {
+		fw.uint32(enc{ST_UINT32, 3}, uint32((*t.MultiSignTxBase.SourceTag))) // SourceTag
+	}
+	fw.uint32(enc{ST_UINT32, 4}, uint32(t.MultiSignTxBase.Sequence)) // Sequence
+	if t.DestinationTag != nil {
+		fw.uint32(enc{ST_UINT32, 14}, uint32((*t.DestinationTag))) // DestinationTag
+	}
+	if t.MultiSignTxBase.LastLedgerSequence != nil {
+		fw.uint32(enc{ST_UINT32, 27}, uint32((*t.MultiSignTxBase.LastLedgerSequence))) // LastLedgerSequence
+	}
+	if t.MultiSignTxBase.TicketSequence != nil {
+		fw.uint32(enc{ST_UINT32, 41}, uint32((*t.MultiSignTxBase.TicketSequence))) // TicketSequence
+	}
+	if t.MultiSignTxBase.PreviousTxnID != nil {
+		fw.wire(enc{ST_HASH256, 5}, t.MultiSignTxBase.PreviousTxnID) // PreviousTxnID
+	}
+	if t.MultiSignTxBase.AccountTxnID != nil {
+		fw.wire(enc{ST_HASH256, 9}, t.MultiSignTxBase.AccountTxnID) // AccountTxnID
+	}
+	if t.InvoiceID != nil {
+		fw.wire(enc{ST_HASH256, 17}, t.InvoiceID) // InvoiceID
+	}
+	fw.wire(enc{ST_AMOUNT, 1}, &t.Amount)              // Amount
+	fw.wire(enc{ST_AMOUNT, 8}, &t.MultiSignTxBase.Fee) // Fee
+	if t.SendMax != nil {
+		fw.wire(enc{ST_AMOUNT, 9}, t.SendMax) // SendMax
+	}
+	if t.DeliverMin != nil {
+		fw.wire(enc{ST_AMOUNT, 10}, t.DeliverMin) // DeliverMin
+	}
+	if t.MultiSignTxBase.SigningPubKey != nil {
+		fw.wire(enc{ST_VL, 3}, t.MultiSignTxBase.SigningPubKey) // SigningPubKey
+	}
+	fw.wire(enc{ST_ACCOUNT, 1}, &t.MultiSignTxBase.Account) // Account
+	fw.wire(enc{ST_ACCOUNT, 3}, &t.Destination)             // Destination
+	if !fw.ignoreSigningFields && len(t.MultiSignTxBase.Signers) > 0 {
+		fw.header(enc{ST_ARRAY, 3}) // Signers
+		for i := range t.MultiSignTxBase.Signers {
+			e124 := &t.MultiSignTxBase.Signers[i]
+			c125 := fw.child()
+			c125.header(enc{ST_OBJECT, 16}) // Signer
+			c126 := c125.child()
+			if e124.Signer.SigningPubKey != nil {
+				c126.wire(enc{ST_VL, 3}, e124.Signer.SigningPubKey) // SigningPubKey
+			}
+			if !fw.ignoreSigningFields && e124.Signer.TxnSignature != nil && len((*e124.Signer.TxnSignature)) > 0 {
+				c126.wire(enc{ST_VL, 4}, e124.Signer.TxnSignature) // TxnSignature
+			}
+			c126.wire(enc{ST_ACCOUNT, 1}, &e124.Signer.Account) // Account
+			c125.close(&c126)
+			c125.end(endOfObject)
+			fw.close(&c125)
+		}
+		fw.end(endOfArray)
+	}
+	if len(t.MultiSignTxBase.Memos) > 0 {
+		fw.header(enc{ST_ARRAY, 9}) // Memos
+		for i := range t.MultiSignTxBase.Memos {
+			e127 := &t.MultiSignTxBase.Memos[i]
+			c128 := fw.child()
+			c128.header(enc{ST_OBJECT, 10}) // Memo
+			c129 := c128.child()
+			if len(e127.Memo.MemoType) > 0 {
+				c129.wire(enc{ST_VL, 12}, &e127.Memo.MemoType) // MemoType
+			}
+			if len(e127.Memo.MemoData) > 0 {
+				c129.wire(enc{ST_VL, 13}, &e127.Memo.MemoData) // MemoData
+			}
+			if len(e127.Memo.MemoFormat) > 0 {
+				c129.wire(enc{ST_VL, 14}, &e127.Memo.MemoFormat) // MemoFormat
+			}
+			c128.close(&c129)
+			c128.end(endOfObject)
+			fw.close(&c128)
+		}
+		fw.end(endOfArray)
+	}
+	if t.Paths != nil && len((*t.Paths)) > 0 {
+		fw.wire(enc{ST_PATHSET, 1}, t.Paths) // Paths
+	}
+}
+
+func (t *MultiSignPayment) decodeField(r Reader, e enc) (bool, error) {
+	switch e {
+	case enc{ST_UINT16, 2}: // TransactionType
+		v, err := readUint16(r)
+		t.MultiSignTxBase.TransactionType = TransactionType(v)
+		return true, err
+	case enc{ST_UINT32, 2}: // Flags
+		t.MultiSignTxBase.Flags = new(TransactionFlag)
+		v, err := readUint32(r)
+		*t.MultiSignTxBase.Flags = TransactionFlag(v)
+		return true, err
+	case enc{ST_UINT32, 3}: // SourceTag
+		t.MultiSignTxBase.SourceTag = new(uint32)
+		v, err := readUint32(r)
+		*t.MultiSignTxBase.SourceTag = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 4}: // Sequence
+		v, err := readUint32(r)
+		t.MultiSignTxBase.Sequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 14}: // DestinationTag
+		t.DestinationTag = new(uint32)
+		v, err := readUint32(r)
+		*t.DestinationTag = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 27}: // LastLedgerSequence
+		t.MultiSignTxBase.LastLedgerSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.MultiSignTxBase.LastLedgerSequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 41}: // TicketSequence
+		t.MultiSignTxBase.TicketSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.MultiSignTxBase.TicketSequence = uint32(v)
+		return true, err
+	case enc{ST_HASH256, 5}: // PreviousTxnID
+		t.MultiSignTxBase.PreviousTxnID = new(Hash256)
+		return true, t.MultiSignTxBase.PreviousTxnID.Unmarshal(r)
+	case enc{ST_HASH256, 9}: // AccountTxnID
+		t.MultiSignTxBase.AccountTxnID = new(Hash256)
+		return true, t.MultiSignTxBase.AccountTxnID.Unmarshal(r)
+	case enc{ST_HASH256, 17}: // InvoiceID
+		t.InvoiceID = new(Hash256)
+		return true, t.InvoiceID.Unmarshal(r)
+	case enc{ST_AMOUNT, 1}: // Amount
+		return true, t.Amount.Unmarshal(r)
+	case enc{ST_AMOUNT, 8}: // Fee
+		return true, t.MultiSignTxBase.Fee.Unmarshal(r)
+	case enc{ST_AMOUNT, 9}: // SendMax
+		t.SendMax = new(Amount)
+		return true, t.SendMax.Unmarshal(r)
+	case enc{ST_AMOUNT, 10}: // DeliverMin
+		t.DeliverMin = new(Amount)
+		return true, t.DeliverMin.Unmarshal(r)
+	case enc{ST_VL, 3}: // SigningPubKey
+		t.MultiSignTxBase.SigningPubKey = new(PublicKey)
+		return true, t.MultiSignTxBase.SigningPubKey.Unmarshal(r)
+	case enc{ST_ACCOUNT, 1}: // Account
+		return true, t.MultiSignTxBase.Account.Unmarshal(r)
+	case enc{ST_ACCOUNT, 3}: // Destination
+		return true, t.Destination.Unmarshal(r)
+	case enc{ST_PATHSET, 1}: // Paths
+		t.Paths = new(PathSet)
+		return true, t.Paths.Unmarshal(r)
+	}
+	return false, nil
+}
+
+func (t *MultiSignerListSet) encodeFields(fw *fieldWriter, nested bool) {
+	fw.setExtra(t.MultiSignTxBase.Fields)
+	fw.uint16(enc{ST_UINT16, 2}, uint16(t.MultiSignTxBase.TransactionType)) // TransactionType
+	if t.MultiSignTxBase.Flags != nil {
+		fw.uint32(enc{ST_UINT32, 2}, uint32((*t.MultiSignTxBase.Flags))) // Flags
+	}
+	if t.MultiSignTxBase.SourceTag != nil {
+		fw.uint32(enc{ST_UINT32, 3}, uint32((*t.MultiSignTxBase.SourceTag))) // SourceTag
+	}
+	fw.uint32(enc{ST_UINT32, 4}, uint32(t.MultiSignTxBase.Sequence)) // Sequence
+	if t.MultiSignTxBase.LastLedgerSequence != nil {
+		fw.uint32(enc{ST_UINT32, 27}, uint32((*t.MultiSignTxBase.LastLedgerSequence))) // LastLedgerSequence
+	}
+	fw.uint32(enc{ST_UINT32, 35}, uint32(t.SignerQuorum)) // SignerQuorum
+	if t.MultiSignTxBase.TicketSequence != nil {
+		fw.uint32(enc{ST_UINT32, 41}, uint32((*t.MultiSignTxBase.TicketSequence))) // TicketSequence
+	}
+	if t.MultiSignTxBase.PreviousTxnID != nil {
+		fw.wire(enc{ST_HASH256, 5}, t.MultiSignTxBase.PreviousTxnID) // PreviousTxnID
+	}
+	if t.MultiSignTxBase.AccountTxnID != nil {
+		fw.wire(enc{ST_HASH256, 9}, t.MultiSignTxBase.AccountTxnID) // AccountTxnID
+	}
+	fw.wire(enc{ST_AMOUNT, 8}, &t.MultiSignTxBase.Fee) // Fee
+	if t.MultiSignTxBase.SigningPubKey != nil {
+		fw.wire(enc{ST_VL, 3}, t.MultiSignTxBase.SigningPubKey) // SigningPubKey
+	}
+	fw.wire(enc{ST_ACCOUNT, 1}, &t.MultiSignTxBase.Account) // Account
+	if !fw.ignoreSigningFields && len(t.MultiSignTxBase.Signers) > 0 {
+		fw.header(enc{ST_ARRAY, 3}) // Signers
+		for i := range t.MultiSignTxBase.Signers {
+			e130 := &t.MultiSignTxBase.Signers[i]
+			c131 := fw.child()
+			c131.header(enc{ST_OBJECT, 16}) // Signer
+			c132 := c131.child()
+			if e130.Signer.SigningPubKey != nil {
+				c132.wire(enc{ST_VL, 3}, e130.Signer.SigningPubKey) // SigningPubKey
+			}
+			if !fw.ignoreSigningFields && e130.Signer.TxnSignature != nil && len((*e130.Signer.TxnSignature)) > 0 {
+				c132.wire(enc{ST_VL, 4}, e130.Signer.TxnSignature) // TxnSignature
+			}
+			c132.wire(enc{ST_ACCOUNT, 1}, &e130.Signer.Account) // Account
+			c131.close(&c132)
+			c131.end(endOfObject)
+			fw.close(&c131)
+		}
+		fw.end(endOfArray)
+	}
+	if len(t.SignerEntries) > 0 {
+		fw.header(enc{ST_ARRAY, 4}) // SignerEntries
+		for i := range t.SignerEntries {
+			e136 := &t.SignerEntries[i]
+			c137 := fw.child()
+			c137.header(enc{ST_OBJECT, 11}) // SignerEntry
+			c138 := c137.child()
+			if e136.SignerEntry.SignerWeight != nil {
+				c138.uint16(enc{ST_UINT16, 3}, uint16((*e136.SignerEntry.SignerWeight))) // SignerWeight
+			}
+			if e136.SignerEntry.Account != nil {
+				c138.wire(enc{ST_ACCOUNT, 1}, e136.SignerEntry.Account) // Account
+			}
+			c137.close(&c138)
+			c137.end(endOfObject)
+			fw.close(&c137)
+		}
+		fw.end(endOfArray)
+	}
+	if len(t.MultiSignTxBase.Memos) > 0 {
+		fw.header(enc{ST_ARRAY, 9}) // Memos
+		for i := range t.MultiSignTxBase.Memos {
+			e133 := &t.MultiSignTxBase.Memos[i]
+			c134 := fw.child()
+			c134.header(enc{ST_OBJECT, 10}) // Memo
+			c135 := c134.child()
+			if len(e133.Memo.MemoType) > 0 {
+				c135.wire(enc{ST_VL, 12}, &e133.Memo.MemoType) // MemoType
+			}
+			if len(e133.Memo.MemoData) > 0 {
+				c135.wire(enc{ST_VL, 13}, &e133.Memo.MemoData) // MemoData
+			}
+			if len(e133.Memo.MemoFormat) > 0 {
+				c135.wire(enc{ST_VL, 14}, &e133.Memo.MemoFormat) // MemoFormat
+			}
+			c134.close(&c135)
+			c134.end(endOfObject)
+			fw.close(&c134)
+		}
+		fw.end(endOfArray)
+	}
+}
+
+func (t *MultiSignerListSet) decodeField(r Reader, e enc) (bool, error) {
+	switch e {
+	case enc{ST_UINT16, 2}: // TransactionType
+		v, err := readUint16(r)
+		t.MultiSignTxBase.TransactionType = TransactionType(v)
+		return true, err
+	case enc{ST_UINT32, 2}: // Flags
+		t.MultiSignTxBase.Flags = new(TransactionFlag)
+		v, err := readUint32(r)
+		*t.MultiSignTxBase.Flags = TransactionFlag(v)
+		return true, err
+	case enc{ST_UINT32, 3}: // SourceTag
+		t.MultiSignTxBase.SourceTag = new(uint32)
+		v, err := readUint32(r)
+		*t.MultiSignTxBase.SourceTag = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 4}: // Sequence
+		v, err := readUint32(r)
+		t.MultiSignTxBase.Sequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 27}: // LastLedgerSequence
+		t.MultiSignTxBase.LastLedgerSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.MultiSignTxBase.LastLedgerSequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 35}: // SignerQuorum
+		v, err := readUint32(r)
+		t.SignerQuorum = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 41}: // TicketSequence
+		t.MultiSignTxBase.TicketSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.MultiSignTxBase.TicketSequence = uint32(v)
+		return true, err
+	case enc{ST_HASH256, 5}: // PreviousTxnID
+		t.MultiSignTxBase.PreviousTxnID = new(Hash256)
+		return true, t.MultiSignTxBase.PreviousTxnID.Unmarshal(r)
+	case enc{ST_HASH256, 9}: // AccountTxnID
+		t.MultiSignTxBase.AccountTxnID = new(Hash256)
+		return true, t.MultiSignTxBase.AccountTxnID.Unmarshal(r)
+	case enc{ST_AMOUNT, 8}: // Fee
+		return true, t.MultiSignTxBase.Fee.Unmarshal(r)
+	case enc{ST_VL, 3}: // SigningPubKey
+		t.MultiSignTxBase.SigningPubKey = new(PublicKey)
+		return true, t.MultiSignTxBase.SigningPubKey.Unmarshal(r)
+	case enc{ST_ACCOUNT, 1}: // Account
+		return true, t.MultiSignTxBase.Account.Unmarshal(r)
+	}
+	return false, nil
+}
+
+func (t *NFTokenAcceptOffer) encodeFields(fw *fieldWriter, nested bool) {
+	fw.setExtra(t.TxBase.Fields)
+	fw.uint16(enc{ST_UINT16, 2}, uint16(t.TxBase.TransactionType)) // TransactionType
+	if t.TxBase.Flags != nil {
+		fw.uint32(enc{ST_UINT32, 2}, uint32((*t.TxBase.Flags))) // Flags
+	}
+	if t.TxBase.SourceTag != nil {
+		fw.uint32(enc{ST_UINT32, 3}, uint32((*t.TxBase.SourceTag))) // SourceTag
+	}
+	fw.uint32(enc{ST_UINT32, 4}, uint32(t.TxBase.Sequence)) // Sequence
+	if t.TxBase.LastLedgerSequence != nil {
+		fw.uint32(enc{ST_UINT32, 27}, uint32((*t.TxBase.LastLedgerSequence))) // LastLedgerSequence
+	}
+	if t.TxBase.TicketSequence != nil {
+		fw.uint32(enc{ST_UINT32, 41}, uint32((*t.TxBase.TicketSequence))) // TicketSequence
+	}
+	if t.TxBase.PreviousTxnID != nil {
+		fw.wire(enc{ST_HASH256, 5}, t.TxBase.PreviousTxnID) // PreviousTxnID
+	}
+	if t.TxBase.AccountTxnID != nil {
+		fw.wire(enc{ST_HASH256, 9}, t.TxBase.AccountTxnID) // AccountTxnID
+	}
+	if t.NFTokenBuyOffer != nil {
+		fw.wire(enc{ST_HASH256, 28}, t.NFTokenBuyOffer) // NFTokenBuyOffer
+	}
+	if t.NFTokenSellOffer != nil {
+		fw.wire(enc{ST_HASH256, 29}, t.NFTokenSellOffer) // NFTokenSellOffer
+	}
+	fw.wire(enc{ST_AMOUNT, 8}, &t.TxBase.Fee) // Fee
+	if t.NFTokenBrokerFee != nil {
+		fw.wire(enc{ST_AMOUNT, 19}, t.NFTokenBrokerFee) // NFTokenBrokerFee
+	}
+	if t.TxBase.SigningPubKey != nil {
+		fw.wire(enc{ST_VL, 3}, t.TxBase.SigningPubKey) // SigningPubKey
+	}
+	if !fw.ignoreSigningFields && t.TxBase.TxnSignature != nil && len((*t.TxBase.TxnSignature)) > 0 {
+		fw.wire(enc{ST_VL, 4}, t.TxBase.TxnSignature) // TxnSignature
+	}
+	fw.wire(enc{ST_ACCOUNT, 1}, &t.TxBase.Account) // Account
+	if !fw.ignoreSigningFields && len(t.TxBase.Signers) > 0 {
+		fw.header(enc{ST_ARRAY, 3}) // Signers
+		for i := range t.TxBase.Signers {
+			e139 := &t.TxBase.Signers[i]
+			c140 := fw.child()
+			c140.header(enc{ST_OBJECT, 16}) // Signer
+			c141 := c140.child()
+			if e139.Signer.SigningPubKey != nil {
+				c141.wire(enc{ST_VL, 3}, e139.Signer.SigningPubKey) // SigningPubKey
+			}
+			if !fw.ignoreSigningFields && e139.Signer.TxnSignature != nil && len((*e139.Signer.TxnSignature)) > 0 {
+				c141.wire(enc{ST_VL, 4}, e139.Signer.TxnSignature) // TxnSignature
+			}
+			c141.wire(enc{ST_ACCOUNT, 1}, &e139.Signer.Account) // Account
+			c140.close(&c141)
+			c140.end(endOfObject)
+			fw.close(&c140)
+		}
+		fw.end(endOfArray)
+	}
+	if len(t.TxBase.Memos) > 0 {
+		fw.header(enc{ST_ARRAY, 9}) // Memos
+		for i := range t.TxBase.Memos {
+			e142 := &t.TxBase.Memos[i]
+			c143 := fw.child()
+			c143.header(enc{ST_OBJECT, 10}) // Memo
+			c144 := c143.child()
+			if len(e142.Memo.MemoType) > 0 {
+				c144.wire(enc{ST_VL, 12}, &e142.Memo.MemoType) // MemoType
+			}
+			if len(e142.Memo.MemoData) > 0 {
+				c144.wire(enc{ST_VL, 13}, &e142.Memo.MemoData) // MemoData
+			}
+			if len(e142.Memo.MemoFormat) > 0 {
+				c144.wire(enc{ST_VL, 14}, &e142.Memo.MemoFormat) // MemoFormat
+			}
+			c143.close(&c144)
+			c143.end(endOfObject)
+			fw.close(&c143)
+		}
+		fw.end(endOfArray)
+	}
+}
+
+func (t *NFTokenAcceptOffer) decodeField(r Reader, e enc) (bool, error) {
+	switch e {
+	case enc{ST_UINT16, 2}: // TransactionType
+		v, err := readUint16(r)
+		t.TxBase.TransactionType = TransactionType(v)
+		return true, err
+	case enc{ST_UINT32, 2}: // Flags
+		t.TxBase.Flags = new(TransactionFlag)
+		v, err := readUint32(r)
+		*t.TxBase.Flags = TransactionFlag(v)
+		return true, err
+	case enc{ST_UINT32, 3}: // SourceTag
+		t.TxBase.SourceTag = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.SourceTag = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 4}: // Sequence
+		v, err := readUint32(r)
+		t.TxBase.Sequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 27}: // LastLedgerSequence
+		t.TxBase.LastLedgerSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.LastLedgerSequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 41}: // TicketSequence
+		t.TxBase.TicketSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.TicketSequence = uint32(v)
+		return true, err
+	case enc{ST_HASH256, 5}: // PreviousTxnID
+		t.TxBase.PreviousTxnID = new(Hash256)
+		return true, t.TxBase.PreviousTxnID.Unmarshal(r)
+	case enc{ST_HASH256, 9}: // AccountTxnID
+		t.TxBase.AccountTxnID = new(Hash256)
+		return true, t.TxBase.AccountTxnID.Unmarshal(r)
+	case enc{ST_HASH256, 28}: // NFTokenBuyOffer
+		t.NFTokenBuyOffer = new(Hash256)
+		return true, t.NFTokenBuyOffer.Unmarshal(r)
+	case enc{ST_HASH256, 29}: // NFTokenSellOffer
+		t.NFTokenSellOffer = new(Hash256)
+		return true, t.NFTokenSellOffer.Unmarshal(r)
+	case enc{ST_AMOUNT, 8}: // Fee
+		return true, t.TxBase.Fee.Unmarshal(r)
+	case enc{ST_AMOUNT, 19}: // NFTokenBrokerFee
+		t.NFTokenBrokerFee = new(Amount)
+		return true, t.NFTokenBrokerFee.Unmarshal(r)
+	case enc{ST_VL, 3}: // SigningPubKey
+		t.TxBase.SigningPubKey = new(PublicKey)
+		return true, t.TxBase.SigningPubKey.Unmarshal(r)
+	case enc{ST_VL, 4}: // TxnSignature
+		t.TxBase.TxnSignature = new(VariableLength)
+		return true, t.TxBase.TxnSignature.Unmarshal(r)
+	case enc{ST_ACCOUNT, 1}: // Account
+		return true, t.TxBase.Account.Unmarshal(r)
+	}
+	return false, nil
+}
+
+func (t *NFTokenBurn) encodeFields(fw *fieldWriter, nested bool) {
+	fw.setExtra(t.TxBase.Fields)
+	fw.uint16(enc{ST_UINT16, 2}, uint16(t.TxBase.TransactionType)) // TransactionType
+	if t.TxBase.Flags != nil {
+		fw.uint32(enc{ST_UINT32, 2}, uint32((*t.TxBase.Flags))) // Flags
+	}
+	if t.TxBase.SourceTag != nil {
+		fw.uint32(enc{ST_UINT32, 3}, uint32((*t.TxBase.SourceTag))) // SourceTag
+	}
+	fw.uint32(enc{ST_UINT32, 4}, uint32(t.TxBase.Sequence)) // Sequence
+	if t.TxBase.LastLedgerSequence != nil {
+		fw.uint32(enc{ST_UINT32, 27}, uint32((*t.TxBase.LastLedgerSequence))) // LastLedgerSequence
+	}
+	if t.TxBase.TicketSequence != nil {
+		fw.uint32(enc{ST_UINT32, 41}, uint32((*t.TxBase.TicketSequence))) // TicketSequence
+	}
+	if t.TxBase.PreviousTxnID != nil {
+		fw.wire(enc{ST_HASH256, 5}, t.TxBase.PreviousTxnID) // PreviousTxnID
+	}
+	if t.TxBase.AccountTxnID != nil {
+		fw.wire(enc{ST_HASH256, 9}, t.TxBase.AccountTxnID) // AccountTxnID
+	}
+	fw.wire(enc{ST_HASH256, 10}, &t.NFTokenID) // NFTokenID
+	fw.wire(enc{ST_AMOUNT, 8}, &t.TxBase.Fee)  // Fee
+	if t.TxBase.SigningPubKey != nil {
+		fw.wire(enc{ST_VL, 3}, t.TxBase.SigningPubKey) // SigningPubKey
+	}
+	if !fw.ignoreSigningFields && t.TxBase.TxnSignature != nil && len((*t.TxBase.TxnSignature)) > 0 {
+		fw.wire(enc{ST_VL, 4}, t.TxBase.TxnSignature) // TxnSignature
+	}
+	fw.wire(enc{ST_ACCOUNT, 1}, &t.TxBase.Account) // Account
+	if t.Owner != nil {
+		fw.wire(enc{ST_ACCOUNT, 2}, t.Owner) // Owner
+	}
+	if !fw.ignoreSigningFields && len(t.TxBase.Signers) > 0 {
+		fw.header(enc{ST_ARRAY, 3}) // Signers
+		for i := range t.TxBase.Signers {
+			e145 := &t.TxBase.Signers[i]
+			c146 := fw.child()
+			c146.header(enc{ST_OBJECT, 16}) // Signer
+			c147 := c146.child()
+			if e145.Signer.SigningPubKey != nil {
+				c147.wire(enc{ST_VL, 3}, e145.Signer.SigningPubKey) // SigningPubKey
+			}
+			if !fw.ignoreSigningFields && e145.Signer.TxnSignature != nil && len((*e145.Signer.TxnSignature)) > 0 {
+				c147.wire(enc{ST_VL, 4}, e145.Signer.TxnSignature) // TxnSignature
+			}
+			c147.wire(enc{ST_ACCOUNT, 1}, &e145.Signer.Account) // Account
+			c146.close(&c147)
+			c146.end(endOfObject)
+			fw.close(&c146)
+		}
+		fw.end(endOfArray)
+	}
+	if len(t.TxBase.Memos) > 0 {
+		fw.header(enc{ST_ARRAY, 9}) // Memos
+		for i := range t.TxBase.Memos {
+			e148 := &t.TxBase.Memos[i]
+			c149 := fw.child()
+			c149.header(enc{ST_OBJECT, 10}) // Memo
+			c150 := c149.child()
+			if len(e148.Memo.MemoType) > 0 {
+				c150.wire(enc{ST_VL, 12}, &e148.Memo.MemoType) // MemoType
+			}
+			if len(e148.Memo.MemoData) > 0 {
+				c150.wire(enc{ST_VL, 13}, &e148.Memo.MemoData) // MemoData
+			}
+			if len(e148.Memo.MemoFormat) > 0 {
+				c150.wire(enc{ST_VL, 14}, &e148.Memo.MemoFormat) // MemoFormat
+			}
+			c149.close(&c150)
+			c149.end(endOfObject)
+			fw.close(&c149)
+		}
+		fw.end(endOfArray)
+	}
+}
+
+func (t *NFTokenBurn) decodeField(r Reader, e enc) (bool, error) {
+	switch e {
+	case enc{ST_UINT16, 2}: // TransactionType
+		v, err := readUint16(r)
+		t.TxBase.TransactionType = TransactionType(v)
+		return true, err
+	case enc{ST_UINT32, 2}: // Flags
+		t.TxBase.Flags = new(TransactionFlag)
+		v, err := readUint32(r)
+		*t.TxBase.Flags = TransactionFlag(v)
+		return true, err
+	case enc{ST_UINT32, 3}: // SourceTag
+		t.TxBase.SourceTag = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.SourceTag = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 4}: // Sequence
+		v, err := readUint32(r)
+		t.TxBase.Sequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 27}: // LastLedgerSequence
+		t.TxBase.LastLedgerSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.LastLedgerSequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 41}: // TicketSequence
+		t.TxBase.TicketSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.TicketSequence = uint32(v)
+		return true, err
+	case enc{ST_HASH256, 5}: // PreviousTxnID
+		t.TxBase.PreviousTxnID = new(Hash256)
+		return true, t.TxBase.PreviousTxnID.Unmarshal(r)
+	case enc{ST_HASH256, 9}: // AccountTxnID
+		t.TxBase.AccountTxnID = new(Hash256)
+		return true, t.TxBase.AccountTxnID.Unmarshal(r)
+	case enc{ST_HASH256, 10}: // NFTokenID
+		return true, t.NFTokenID.Unmarshal(r)
+	case enc{ST_AMOUNT, 8}: // Fee
+		return true, t.TxBase.Fee.Unmarshal(r)
+	case enc{ST_VL, 3}: // SigningPubKey
+		t.TxBase.SigningPubKey = new(PublicKey)
+		return true, t.TxBase.SigningPubKey.Unmarshal(r)
+	case enc{ST_VL, 4}: // TxnSignature
+		t.TxBase.TxnSignature = new(VariableLength)
+		return true, t.TxBase.TxnSignature.Unmarshal(r)
+	case enc{ST_ACCOUNT, 1}: // Account
+		return true, t.TxBase.Account.Unmarshal(r)
+	case enc{ST_ACCOUNT, 2}: // Owner
+		t.Owner = new(Account)
+		return true, t.Owner.Unmarshal(r)
+	}
+	return false, nil
+}
+
+func (t *NFTokenCancelOffer) encodeFields(fw *fieldWriter, nested bool) {
+	fw.setExtra(t.TxBase.Fields)
+	fw.uint16(enc{ST_UINT16, 2}, uint16(t.TxBase.TransactionType)) // TransactionType
+	if t.TxBase.Flags != nil {
+		fw.uint32(enc{ST_UINT32, 2}, uint32((*t.TxBase.Flags))) // Flags
+	}
+	if t.TxBase.SourceTag != nil {
+		fw.uint32(enc{ST_UINT32, 3}, uint32((*t.TxBase.SourceTag))) // SourceTag
+	}
+	fw.uint32(enc{ST_UINT32, 4}, uint32(t.TxBase.Sequence)) // Sequence
+	if t.TxBase.LastLedgerSequence != nil {
+		fw.uint32(enc{ST_UINT32, 27}, uint32((*t.TxBase.LastLedgerSequence))) // LastLedgerSequence
+	}
+	if t.TxBase.TicketSequence != nil {
+		fw.uint32(enc{ST_UINT32, 41}, uint32((*t.TxBase.TicketSequence))) // TicketSequence
+	}
+	if t.TxBase.PreviousTxnID != nil {
+		fw.wire(enc{ST_HASH256, 5}, t.TxBase.PreviousTxnID) // PreviousTxnID
+	}
+	if t.TxBase.AccountTxnID != nil {
+		fw.wire(enc{ST_HASH256, 9}, t.TxBase.AccountTxnID) // AccountTxnID
+	}
+	fw.wire(enc{ST_AMOUNT, 8}, &t.TxBase.Fee) // Fee
+	if t.TxBase.SigningPubKey != nil {
+		fw.wire(enc{ST_VL, 3}, t.TxBase.SigningPubKey) // SigningPubKey
+	}
+	if !fw.ignoreSigningFields && t.TxBase.TxnSignature != nil && len((*t.TxBase.TxnSignature)) > 0 {
+		fw.wire(enc{ST_VL, 4}, t.TxBase.TxnSignature) // TxnSignature
+	}
+	fw.wire(enc{ST_ACCOUNT, 1}, &t.TxBase.Account) // Account
+	if !fw.ignoreSigningFields && len(t.TxBase.Signers) > 0 {
+		fw.header(enc{ST_ARRAY, 3}) // Signers
+		for i := range t.TxBase.Signers {
+			e151 := &t.TxBase.Signers[i]
+			c152 := fw.child()
+			c152.header(enc{ST_OBJECT, 16}) // Signer
+			c153 := c152.child()
+			if e151.Signer.SigningPubKey != nil {
+				c153.wire(enc{ST_VL, 3}, e151.Signer.SigningPubKey) // SigningPubKey
+			}
+			if !fw.ignoreSigningFields && e151.Signer.TxnSignature != nil && len((*e151.Signer.TxnSignature)) > 0 {
+				c153.wire(enc{ST_VL, 4}, e151.Signer.TxnSignature) // TxnSignature
+			}
+			c153.wire(enc{ST_ACCOUNT, 1}, &e151.Signer.Account) // Account
+			c152.close(&c153)
+			c152.end(endOfObject)
+			fw.close(&c152)
+		}
+		fw.end(endOfArray)
+	}
+	if len(t.TxBase.Memos) > 0 {
+		fw.header(enc{ST_ARRAY, 9}) // Memos
+		for i := range t.TxBase.Memos {
+			e154 := &t.TxBase.Memos[i]
+			c155 := fw.child()
+			c155.header(enc{ST_OBJECT, 10}) // Memo
+			c156 := c155.child()
+			if len(e154.Memo.MemoType) > 0 {
+				c156.wire(enc{ST_VL, 12}, &e154.Memo.MemoType) // MemoType
+			}
+			if len(e154.Memo.MemoData) > 0 {
+				c156.wire(enc{ST_VL, 13}, &e154.Memo.MemoData) // MemoData
+			}
+			if len(e154.Memo.MemoFormat) > 0 {
+				c156.wire(enc{ST_VL, 14}, &e154.Memo.MemoFormat) // MemoFormat
+			}
+			c155.close(&c156)
+			c155.end(endOfObject)
+			fw.close(&c155)
+		}
+		fw.end(endOfArray)
+	}
+	if len(t.NFTokenOffers) > 0 {
+		fw.wire(enc{ST_VECTOR256, 4}, &t.NFTokenOffers) // NFTokenOffers
+	}
+}
+
+func (t *NFTokenCancelOffer) decodeField(r Reader, e enc) (bool, error) {
+	switch e {
+	case enc{ST_UINT16, 2}: // TransactionType
+		v, err := readUint16(r)
+		t.TxBase.TransactionType = TransactionType(v)
+		return true, err
+	case enc{ST_UINT32, 2}: // Flags
+		t.TxBase.Flags = new(TransactionFlag)
+		v, err := readUint32(r)
+		*t.TxBase.Flags = TransactionFlag(v)
+		return true, err
+	case enc{ST_UINT32, 3}: // SourceTag
+		t.TxBase.SourceTag = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.SourceTag = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 4}: // Sequence
+		v, err := readUint32(r)
+		t.TxBase.Sequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 27}: // LastLedgerSequence
+		t.TxBase.LastLedgerSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.LastLedgerSequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 41}: // TicketSequence
+		t.TxBase.TicketSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.TicketSequence = uint32(v)
+		return true, err
+	case enc{ST_HASH256, 5}: // PreviousTxnID
+		t.TxBase.PreviousTxnID = new(Hash256)
+		return true, t.TxBase.PreviousTxnID.Unmarshal(r)
+	case enc{ST_HASH256, 9}: // AccountTxnID
+		t.TxBase.AccountTxnID = new(Hash256)
+		return true, t.TxBase.AccountTxnID.Unmarshal(r)
+	case enc{ST_AMOUNT, 8}: // Fee
+		return true, t.TxBase.Fee.Unmarshal(r)
+	case enc{ST_VL, 3}: // SigningPubKey
+		t.TxBase.SigningPubKey = new(PublicKey)
+		return true, t.TxBase.SigningPubKey.Unmarshal(r)
+	case enc{ST_VL, 4}: // TxnSignature
+		t.TxBase.TxnSignature = new(VariableLength)
+		return true, t.TxBase.TxnSignature.Unmarshal(r)
+	case enc{ST_ACCOUNT, 1}: // Account
+		return true, t.TxBase.Account.Unmarshal(r)
+	case enc{ST_VECTOR256, 4}: // NFTokenOffers
+		return true, t.NFTokenOffers.Unmarshal(r)
+	}
+	return false, nil
+}
+
+func (t *NFTokenCreateOffer) encodeFields(fw *fieldWriter, nested bool) {
+	fw.setExtra(t.TxBase.Fields)
+	fw.uint16(enc{ST_UINT16, 2}, uint16(t.TxBase.TransactionType)) // TransactionType
+	if t.TxBase.Flags != nil {
+		fw.uint32(enc{ST_UINT32, 2}, uint32((*t.TxBase.Flags))) // Flags
+	}
+	if t.TxBase.SourceTag != nil {
+		fw.uint32(enc{ST_UINT32, 3}, uint32((*t.TxBase.SourceTag))) // SourceTag
+	}
+	fw.uint32(enc{ST_UINT32, 4}, uint32(t.TxBase.Sequence)) // Sequence
+	if t.Expiration != nil {
+		fw.uint32(enc{ST_UINT32, 10}, uint32((*t.Expiration))) // Expiration
+	}
+	if t.TxBase.LastLedgerSequence != nil {
+		fw.uint32(enc{ST_UINT32, 27}, uint32((*t.TxBase.LastLedgerSequence))) // LastLedgerSequence
+	}
+	if t.TxBase.TicketSequence != nil {
+		fw.uint32(enc{ST_UINT32, 41}, uint32((*t.TxBase.TicketSequence))) // TicketSequence
+	}
+	if t.TxBase.PreviousTxnID != nil {
+		fw.wire(enc{ST_HASH256, 5}, t.TxBase.PreviousTxnID) // PreviousTxnID
+	}
+	if t.TxBase.AccountTxnID != nil {
+		fw.wire(enc{ST_HASH256, 9}, t.TxBase.AccountTxnID) // AccountTxnID
+	}
+	fw.wire(enc{ST_HASH256, 10}, &t.NFTokenID) // NFTokenID
+	fw.wire(enc{ST_AMOUNT, 1}, &t.Amount)      // Amount
+	fw.wire(enc{ST_AMOUNT, 8}, &t.TxBase.Fee)  // Fee
+	if t.TxBase.SigningPubKey != nil {
+		fw.wire(enc{ST_VL, 3}, t.TxBase.SigningPubKey) // SigningPubKey
+	}
+	if !fw.ignoreSigningFields && t.TxBase.TxnSignature != nil && len((*t.TxBase.TxnSignature)) > 0 {
+		fw.wire(enc{ST_VL, 4}, t.TxBase.TxnSignature) // TxnSignature
+	}
+	fw.wire(enc{ST_ACCOUNT, 1}, &t.TxBase.Account) // Account
+	if t.Owner != nil {
+		fw.wire(enc{ST_ACCOUNT, 2}, t.Owner) // Owner
+	}
+	if t.Destination != nil {
+		fw.wire(enc{ST_ACCOUNT, 3}, t.Destination) // Destination
+	}
+	if !fw.ignoreSigningFields && len(t.TxBase.Signers) > 0 {
+		fw.header(enc{ST_ARRAY, 3}) // Signers
+		for i := range t.TxBase.Signers {
+			e157 := &t.TxBase.Signers[i]
+			c158 := fw.child()
+			c158.header(enc{ST_OBJECT, 16}) // Signer
+			c159 := c158.child()
+			if e157.Signer.SigningPubKey != nil {
+				c159.wire(enc{ST_VL, 3}, e157.Signer.SigningPubKey) // SigningPubKey
+			}
+			if !fw.ignoreSigningFields && e157.Signer.TxnSignature != nil && len((*e157.Signer.TxnSignature)) > 0 {
+				c159.wire(enc{ST_VL, 4}, e157.Signer.TxnSignature) // TxnSignature
+			}
+			c159.wire(enc{ST_ACCOUNT, 1}, &e157.Signer.Account) // Account
+			c158.close(&c159)
+			c158.end(endOfObject)
+			fw.close(&c158)
+		}
+		fw.end(endOfArray)
+	}
+	if len(t.TxBase.Memos) > 0 {
+		fw.header(enc{ST_ARRAY, 9}) // Memos
+		for i := range t.TxBase.Memos {
+			e160 := &t.TxBase.Memos[i]
+			c161 := fw.child()
+			c161.header(enc{ST_OBJECT, 10}) // Memo
+			c162 := c161.child()
+			if len(e160.Memo.MemoType) > 0 {
+				c162.wire(enc{ST_VL, 12}, &e160.Memo.MemoType) // MemoType
+			}
+			if len(e160.Memo.MemoData) > 0 {
+				c162.wire(enc{ST_VL, 13}, &e160.Memo.MemoData) // MemoData
+			}
+			if len(e160.Memo.MemoFormat) > 0 {
+				c162.wire(enc{ST_VL, 14}, &e160.Memo.MemoFormat) // MemoFormat
+			}
+			c161.close(&c162)
+			c161.end(endOfObject)
+			fw.close(&c161)
+		}
+		fw.end(endOfArray)
+	}
+}
+
+func (t *NFTokenCreateOffer) decodeField(r Reader, e enc) (bool, error) {
+	switch e {
+	case enc{ST_UINT16, 2}: // TransactionType
+		v, err := readUint16(r)
+		t.TxBase.TransactionType = TransactionType(v)
+		return true, err
+	case enc{ST_UINT32, 2}: // Flags
+		t.TxBase.Flags = new(TransactionFlag)
+		v, err := readUint32(r)
+		*t.TxBase.Flags = TransactionFlag(v)
+		return true, err
+	case enc{ST_UINT32, 3}: // SourceTag
+		t.TxBase.SourceTag = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.SourceTag = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 4}: // Sequence
+		v, err := readUint32(r)
+		t.TxBase.Sequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 10}: // Expiration
+		t.Expiration = new(uint32)
+		v, err := readUint32(r)
+		*t.Expiration = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 27}: // LastLedgerSequence
+		t.TxBase.LastLedgerSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.LastLedgerSequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 41}: // TicketSequence
+		t.TxBase.TicketSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.TicketSequence = uint32(v)
+		return true, err
+	case enc{ST_HASH256, 5}: // PreviousTxnID
+		t.TxBase.PreviousTxnID = new(Hash256)
+		return true, t.TxBase.PreviousTxnID.Unmarshal(r)
+	case enc{ST_HASH256, 9}: // AccountTxnID
+		t.TxBase.AccountTxnID = new(Hash256)
+		return true, t.TxBase.AccountTxnID.Unmarshal(r)
+	case enc{ST_HASH256, 10}: // NFTokenID
+		return true, t.NFTokenID.Unmarshal(r)
+	case enc{ST_AMOUNT, 1}: // Amount
+		return true, t.Amount.Unmarshal(r)
+	case enc{ST_AMOUNT, 8}: // Fee
+		return true, t.TxBase.Fee.Unmarshal(r)
+	case enc{ST_VL, 3}: // SigningPubKey
+		t.TxBase.SigningPubKey = new(PublicKey)
+		return true, t.TxBase.SigningPubKey.Unmarshal(r)
+	case enc{ST_VL, 4}: // TxnSignature
+		t.TxBase.TxnSignature = new(VariableLength)
+		return true, t.TxBase.TxnSignature.Unmarshal(r)
+	case enc{ST_ACCOUNT, 1}: // Account
+		return true, t.TxBase.Account.Unmarshal(r)
+	case enc{ST_ACCOUNT, 2}: // Owner
+		t.Owner = new(Account)
+		return true, t.Owner.Unmarshal(r)
+	case enc{ST_ACCOUNT, 3}: // Destination
+		t.Destination = new(Account)
+		return true, t.Destination.Unmarshal(r)
+	}
+	return false, nil
+}
+
+func (t *NFTokenMint) encodeFields(fw *fieldWriter, nested bool) {
+	fw.setExtra(t.TxBase.Fields)
+	fw.uint16(enc{ST_UINT16, 2}, uint16(t.TxBase.TransactionType)) // TransactionType
+	if t.TransferFee != nil {
+		fw.uint16(enc{ST_UINT16, 4}, uint16((*t.TransferFee))) // TransferFee
+	}
+	if t.TxBase.Flags != nil {
+		fw.uint32(enc{ST_UINT32, 2}, uint32((*t.TxBase.Flags))) // Flags
+	}
+	if t.TxBase.SourceTag != nil {
+		fw.uint32(enc{ST_UINT32, 3}, uint32((*t.TxBase.SourceTag))) // SourceTag
+	}
+	fw.uint32(enc{ST_UINT32, 4}, uint32(t.TxBase.Sequence)) // Sequence
+	if t.Expiration != nil {
+		fw.uint32(enc{ST_UINT32, 10}, uint32((*t.Expiration))) // Expiration
+	}
+	if t.TxBase.LastLedgerSequence != nil {
+		fw.uint32(enc{ST_UINT32, 27}, uint32((*t.TxBase.LastLedgerSequence))) // LastLedgerSequence
+	}
+	if t.TxBase.TicketSequence != nil {
+		fw.uint32(enc{ST_UINT32, 41}, uint32((*t.TxBase.TicketSequence))) // TicketSequence
+	}
+	fw.uint32(enc{ST_UINT32, 42}, uint32(t.NFTokenTaxon)) // NFTokenTaxon
+	if t.TxBase.PreviousTxnID != nil {
+		fw.wire(enc{ST_HASH256, 5}, t.TxBase.PreviousTxnID) // PreviousTxnID
+	}
+	if t.TxBase.AccountTxnID != nil {
+		fw.wire(enc{ST_HASH256, 9}, t.TxBase.AccountTxnID) // AccountTxnID
+	}
+	if t.Amount != nil {
+		fw.wire(enc{ST_AMOUNT, 1}, t.Amount) // Amount
+	}
+	fw.wire(enc{ST_AMOUNT, 8}, &t.TxBase.Fee) // Fee
+	if t.TxBase.SigningPubKey != nil {
+		fw.wire(enc{ST_VL, 3}, t.TxBase.SigningPubKey) // SigningPubKey
+	}
+	if !fw.ignoreSigningFields && t.TxBase.TxnSignature != nil && len((*t.TxBase.TxnSignature)) > 0 {
+		fw.wire(enc{ST_VL, 4}, t.TxBase.TxnSignature) // TxnSignature
+	}
+	if t.URI != nil && len((*t.URI)) > 0 {
+		fw.wire(enc{ST_VL, 5}, t.URI) // URI
+	}
+	fw.wire(enc{ST_ACCOUNT, 1}, &t.TxBase.Account) // Account
+	if t.Destination != nil {
+		fw.wire(enc{ST_ACCOUNT, 3}, t.Destination) // Destination
+	}
+	if t.Issuer != nil {
+		fw.wire(enc{ST_ACCOUNT, 4}, t.Issuer) // Issuer
+	}
+	if !fw.ignoreSigningFields && len(t.TxBase.Signers) > 0 {
+		fw.header(enc{ST_ARRAY, 3}) // Signers
+		for i := range t.TxBase.Signers {
+			e163 := &t.TxBase.Signers[i]
+			c164 := fw.child()
+			c164.header(enc{ST_OBJECT, 16}) // Signer
+			c165 := c164.child()
+			if e163.Signer.SigningPubKey != nil {
+				c165.wire(enc{ST_VL, 3}, e163.Signer.SigningPubKey) // SigningPubKey
+			}
+			if !fw.ignoreSigningFields && e163.Signer.TxnSignature != nil && len((*e163.Signer.TxnSignature)) > 0 {
+				c165.wire(enc{ST_VL, 4}, e163.Signer.TxnSignature) // TxnSignature
+			}
+			c165.wire(enc{ST_ACCOUNT, 1}, &e163.Signer.Account) // Account
+			c164.close(&c165)
+			c164.end(endOfObject)
+			fw.close(&c164)
+		}
+		fw.end(endOfArray)
+	}
+	if len(t.TxBase.Memos) > 0 {
+		fw.header(enc{ST_ARRAY, 9}) // Memos
+		for i := range t.TxBase.Memos {
+			e166 := &t.TxBase.Memos[i]
+			c167 := fw.child()
+			c167.header(enc{ST_OBJECT, 10}) // Memo
+			c168 := c167.child()
+			if len(e166.Memo.MemoType) > 0 {
+				c168.wire(enc{ST_VL, 12}, &e166.Memo.MemoType) // MemoType
+			}
+			if len(e166.Memo.MemoData) > 0 {
+				c168.wire(enc{ST_VL, 13}, &e166.Memo.MemoData) // MemoData
+			}
+			if len(e166.Memo.MemoFormat) > 0 {
+				c168.wire(enc{ST_VL, 14}, &e166.Memo.MemoFormat) // MemoFormat
+			}
+			c167.close(&c168)
+			c167.end(endOfObject)
+			fw.close(&c167)
+		}
+		fw.end(endOfArray)
+	}
+}
+
+func (t *NFTokenMint) decodeField(r Reader, e enc) (bool, error) {
+	switch e {
+	case enc{ST_UINT16, 2}: // TransactionType
+		v, err := readUint16(r)
+		t.TxBase.TransactionType = TransactionType(v)
+		return true, err
+	case enc{ST_UINT16, 4}: // TransferFee
+		t.TransferFee = new(uint16)
+		v, err := readUint16(r)
+		*t.TransferFee = uint16(v)
+		return true, err
+	case enc{ST_UINT32, 2}: // Flags
+		t.TxBase.Flags = new(TransactionFlag)
+		v, err := readUint32(r)
+		*t.TxBase.Flags = TransactionFlag(v)
+		return true, err
+	case enc{ST_UINT32, 3}: // SourceTag
+		t.TxBase.SourceTag = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.SourceTag = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 4}: // Sequence
+		v, err := readUint32(r)
+		t.TxBase.Sequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 10}: // Expiration
+		t.Expiration = new(uint32)
+		v, err := readUint32(r)
+		*t.Expiration = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 27}: // LastLedgerSequence
+		t.TxBase.LastLedgerSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.LastLedgerSequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 41}: // TicketSequence
+		t.TxBase.TicketSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.TicketSequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 42}: // NFTokenTaxon
+		v, err := readUint32(r)
+		t.NFTokenTaxon = uint32(v)
+		return true, err
+	case enc{ST_HASH256, 5}: // PreviousTxnID
+		t.TxBase.PreviousTxnID = new(Hash256)
+		return true, t.TxBase.PreviousTxnID.Unmarshal(r)
+	case enc{ST_HASH256, 9}: // AccountTxnID
+		t.TxBase.AccountTxnID = new(Hash256)
+		return true, t.TxBase.AccountTxnID.Unmarshal(r)
+	case enc{ST_AMOUNT, 1}: // Amount
+		t.Amount = new(Amount)
+		return true, t.Amount.Unmarshal(r)
+	case enc{ST_AMOUNT, 8}: // Fee
+		return true, t.TxBase.Fee.Unmarshal(r)
+	case enc{ST_VL, 3}: // SigningPubKey
+		t.TxBase.SigningPubKey = new(PublicKey)
+		return true, t.TxBase.SigningPubKey.Unmarshal(r)
+	case enc{ST_VL, 4}: // TxnSignature
+		t.TxBase.TxnSignature = new(VariableLength)
+		return true, t.TxBase.TxnSignature.Unmarshal(r)
+	case enc{ST_VL, 5}: // URI
+		t.URI = new(VariableLength)
+		return true, t.URI.Unmarshal(r)
+	case enc{ST_ACCOUNT, 1}: // Account
+		return true, t.TxBase.Account.Unmarshal(r)
+	case enc{ST_ACCOUNT, 3}: // Destination
+		t.Destination = new(Account)
+		return true, t.Destination.Unmarshal(r)
+	case enc{ST_ACCOUNT, 4}: // Issuer
+		t.Issuer = new(Account)
+		return true, t.Issuer.Unmarshal(r)
+	}
+	return false, nil
+}
+
+func (t *NFTokenOffer) encodeFields(fw *fieldWriter, nested bool) {
+	fw.setExtra(t.leBase.Fields)
+	if !nested {
+		fw.uint16(enc{ST_UINT16, 1}, uint16(t.leBase.LedgerEntryType)) // LedgerEntryType
+	}
+	if t.Flags != nil {
+		fw.uint32(enc{ST_UINT32, 2}, uint32((*t.Flags))) // Flags
+	}
+	if t.leBase.PreviousTxnLgrSeq != nil {
+		fw.uint32(enc{ST_UINT32, 5}, uint32((*t.leBase.PreviousTxnLgrSeq))) // PreviousTxnLgrSeq
+	}
+	if t.Expiration != nil {
+		fw.uint32(enc{ST_UINT32, 10}, uint32((*t.Expiration))) // Expiration
+	}
+	if t.OwnerNode != nil {
+		fw.uint64(enc{ST_UINT64, 4}, uint64((*t.OwnerNode))) // OwnerNode
+	}
+	if t.NFTokenOfferNode != nil {
+		fw.uint64(enc{ST_UINT64, 12}, uint64((*t.NFTokenOfferNode))) // NFTokenOfferNode
+	}
+	if t.leBase.PreviousTxnID != nil {
+		fw.wire(enc{ST_HASH256, 5}, t.leBase.PreviousTxnID) // PreviousTxnID
+	}
+	if t.NFTokenID != nil {
+		fw.wire(enc{ST_HASH256, 10}, t.NFTokenID) // NFTokenID
+	}
+	if t.Amount != nil {
+		fw.wire(enc{ST_AMOUNT, 1}, t.Amount) // Amount
+	}
+	if t.Owner != nil {
+		fw.wire(enc{ST_ACCOUNT, 2}, t.Owner) // Owner
+	}
+	if t.Destination != nil {
+		fw.wire(enc{ST_ACCOUNT, 3}, t.Destination) // Destination
+	}
+}
+
+func (t *NFTokenOffer) decodeField(r Reader, e enc) (bool, error) {
+	switch e {
+	case enc{ST_UINT16, 1}: // LedgerEntryType
+		v, err := readUint16(r)
+		t.leBase.LedgerEntryType = LedgerEntryType(v)
+		return true, err
+	case enc{ST_UINT32, 2}: // Flags
+		t.Flags = new(LedgerEntryFlag)
+		v, err := readUint32(r)
+		*t.Flags = LedgerEntryFlag(v)
+		return true, err
+	case enc{ST_UINT32, 5}: // PreviousTxnLgrSeq
+		t.leBase.PreviousTxnLgrSeq = new(uint32)
+		v, err := readUint32(r)
+		*t.leBase.PreviousTxnLgrSeq = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 10}: // Expiration
+		t.Expiration = new(uint32)
+		v, err := readUint32(r)
+		*t.Expiration = uint32(v)
+		return true, err
+	case enc{ST_UINT64, 4}: // OwnerNode
+		t.OwnerNode = new(NodeIndex)
+		v, err := readUint64(r)
+		*t.OwnerNode = NodeIndex(v)
+		return true, err
+	case enc{ST_UINT64, 12}: // NFTokenOfferNode
+		t.NFTokenOfferNode = new(NodeIndex)
+		v, err := readUint64(r)
+		*t.NFTokenOfferNode = NodeIndex(v)
+		return true, err
+	case enc{ST_HASH256, 5}: // PreviousTxnID
+		t.leBase.PreviousTxnID = new(Hash256)
+		return true, t.leBase.PreviousTxnID.Unmarshal(r)
+	case enc{ST_HASH256, 6}: // LedgerIndex
+		t.leBase.LedgerIndex = new(Hash256)
+		return true, t.leBase.LedgerIndex.Unmarshal(r)
+	case enc{ST_HASH256, 10}: // NFTokenID
+		t.NFTokenID = new(Hash256)
+		return true, t.NFTokenID.Unmarshal(r)
+	case enc{ST_AMOUNT, 1}: // Amount
+		t.Amount = new(Amount)
+		return true, t.Amount.Unmarshal(r)
+	case enc{ST_ACCOUNT, 2}: // Owner
+		t.Owner = new(Account)
+		return true, t.Owner.Unmarshal(r)
+	case enc{ST_ACCOUNT, 3}: // Destination
+		t.Destination = new(Account)
+		return true, t.Destination.Unmarshal(r)
+	}
+	return false, nil
+}
+
+func (t *NFTokenPage) encodeFields(fw *fieldWriter, nested bool) {
+	fw.setExtra(t.leBase.Fields)
+	if !nested {
+		fw.uint16(enc{ST_UINT16, 1}, uint16(t.leBase.LedgerEntryType)) // LedgerEntryType
+	}
+	if t.Flags != nil {
+		fw.uint32(enc{ST_UINT32, 2}, uint32((*t.Flags))) // Flags
+	}
+	if t.leBase.PreviousTxnLgrSeq != nil {
+		fw.uint32(enc{ST_UINT32, 5}, uint32((*t.leBase.PreviousTxnLgrSeq))) // PreviousTxnLgrSeq
+	}
+	if t.leBase.PreviousTxnID != nil {
+		fw.wire(enc{ST_HASH256, 5}, t.leBase.PreviousTxnID) // PreviousTxnID
+	}
+	if t.PreviousPageMin != nil {
+		fw.wire(enc{ST_HASH256, 26}, t.PreviousPageMin) // PreviousPageMin
+	}
+	if t.NextPageMin != nil {
+		fw.wire(enc{ST_HASH256, 27}, t.NextPageMin) // NextPageMin
+	}
+	if len(t.NFTokens) > 0 {
+		fw.header(enc{ST_ARRAY, 10}) // NFTokens
+		for i := range t.NFTokens {
+			e169 := &t.NFTokens[i]
+			c170 := fw.child()
+			c170.header(enc{ST_OBJECT, 12}) // NFToken
+			c171 := c170.child()
+			c171.wire(enc{ST_HASH256, 10}, &e169.NFToken.NFTokenID) // NFTokenID
+			if e169.NFToken.URI != nil && len((*e169.NFToken.URI)) > 0 {
+				c171.wire(enc{ST_VL, 5}, e169.NFToken.URI) // URI
+			}
+			c170.close(&c171)
+			c170.end(endOfObject)
+			fw.close(&c170)
+		}
+		fw.end(endOfArray)
+	}
+}
+
+func (t *NFTokenPage) decodeField(r Reader, e enc) (bool, error) {
+	switch e {
+	case enc{ST_UINT16, 1}: // LedgerEntryType
+		v, err := readUint16(r)
+		t.leBase.LedgerEntryType = LedgerEntryType(v)
+		return true, err
+	case enc{ST_UINT32, 2}: // Flags
+		t.Flags = new(LedgerEntryFlag)
+		v, err := readUint32(r)
+		*t.Flags = LedgerEntryFlag(v)
+		return true, err
+	case enc{ST_UINT32, 5}: // PreviousTxnLgrSeq
+		t.leBase.PreviousTxnLgrSeq = new(uint32)
+		v, err := readUint32(r)
+		*t.leBase.PreviousTxnLgrSeq = uint32(v)
+		return true, err
+	case enc{ST_HASH256, 5}: // PreviousTxnID
+		t.leBase.PreviousTxnID = new(Hash256)
+		return true, t.leBase.PreviousTxnID.Unmarshal(r)
+	case enc{ST_HASH256, 6}: // LedgerIndex
+		t.leBase.LedgerIndex = new(Hash256)
+		return true, t.leBase.LedgerIndex.Unmarshal(r)
+	case enc{ST_HASH256, 26}: // PreviousPageMin
+		t.PreviousPageMin = new(Hash256)
+		return true, t.PreviousPageMin.Unmarshal(r)
+	case enc{ST_HASH256, 27}: // NextPageMin
+		t.NextPageMin = new(Hash256)
+		return true, t.NextPageMin.Unmarshal(r)
+	}
+	return false, nil
+}
+
+func (t *Offer) encodeFields(fw *fieldWriter, nested bool) {
+	fw.setExtra(t.leBase.Fields)
+	if !nested {
+		fw.uint16(enc{ST_UINT16, 1}, uint16(t.leBase.LedgerEntryType)) // LedgerEntryType
+	}
+	if t.Flags != nil {
+		fw.uint32(enc{ST_UINT32, 2}, uint32((*t.Flags))) // Flags
+	}
+	if t.Sequence != nil {
+		fw.uint32(enc{ST_UINT32, 4}, uint32((*t.Sequence))) // Sequence
+	}
+	if t.leBase.PreviousTxnLgrSeq != nil {
+		fw.uint32(enc{ST_UINT32, 5}, uint32((*t.leBase.PreviousTxnLgrSeq))) // PreviousTxnLgrSeq
+	}
+	if t.Expiration != nil {
+		fw.uint32(enc{ST_UINT32, 10}, uint32((*t.Expiration))) // Expiration
+	}
+	if t.BookNode != nil {
+		fw.uint64(enc{ST_UINT64, 3}, uint64((*t.BookNode))) // BookNode
+	}
+	if t.OwnerNode != nil {
+		fw.uint64(enc{ST_UINT64, 4}, uint64((*t.OwnerNode))) // OwnerNode
+	}
+	if t.leBase.PreviousTxnID != nil {
+		fw.wire(enc{ST_HASH256, 5}, t.leBase.PreviousTxnID) // PreviousTxnID
+	}
+	if t.BookDirectory != nil {
+		fw.wire(enc{ST_HASH256, 16}, t.BookDirectory) // BookDirectory
+	}
+	if t.TakerPays != nil {
+		fw.wire(enc{ST_AMOUNT, 4}, t.TakerPays) // TakerPays
+	}
+	if t.TakerGets != nil {
+		fw.wire(enc{ST_AMOUNT, 5}, t.TakerGets) // TakerGets
+	}
+	if t.Account != nil {
+		fw.wire(enc{ST_ACCOUNT, 1}, t.Account) // Account
+	}
+}
+
+func (t *Offer) decodeField(r Reader, e enc) (bool, error) {
+	switch e {
+	case enc{ST_UINT16, 1}: // LedgerEntryType
+		v, err := readUint16(r)
+		t.leBase.LedgerEntryType = LedgerEntryType(v)
+		return true, err
+	case enc{ST_UINT32, 2}: // Flags
+		t.Flags = new(LedgerEntryFlag)
+		v, err := readUint32(r)
+		*t.Flags = LedgerEntryFlag(v)
+		return true, err
+	case enc{ST_UINT32, 4}: // Sequence
+		t.Sequence = new(uint32)
+		v, err := readUint32(r)
+		*t.Sequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 5}: // PreviousTxnLgrSeq
+		t.leBase.PreviousTxnLgrSeq = new(uint32)
+		v, err := readUint32(r)
+		*t.leBase.PreviousTxnLgrSeq = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 10}: // Expiration
+		t.Expiration = new(uint32)
+		v, err := readUint32(r)
+		*t.Expiration = uint32(v)
+		return true, err
+	case enc{ST_UINT64, 3}: // BookNode
+		t.BookNode = new(NodeIndex)
+		v, err := readUint64(r)
+		*t.BookNode = NodeIndex(v)
+		return true, err
+	case enc{ST_UINT64, 4}: // OwnerNode
+		t.OwnerNode = new(NodeIndex)
+		v, err := readUint64(r)
+		*t.OwnerNode = NodeIndex(v)
+		return true, err
+	case enc{ST_HASH256, 5}: // PreviousTxnID
+		t.leBase.PreviousTxnID = new(Hash256)
+		return true, t.leBase.PreviousTxnID.Unmarshal(r)
+	case enc{ST_HASH256, 6}: // LedgerIndex
+		t.leBase.LedgerIndex = new(Hash256)
+		return true, t.leBase.LedgerIndex.Unmarshal(r)
+	case enc{ST_HASH256, 16}: // BookDirectory
+		t.BookDirectory = new(Hash256)
+		return true, t.BookDirectory.Unmarshal(r)
+	case enc{ST_AMOUNT, 4}: // TakerPays
+		t.TakerPays = new(Amount)
+		return true, t.TakerPays.Unmarshal(r)
+	case enc{ST_AMOUNT, 5}: // TakerGets
+		t.TakerGets = new(Amount)
+		return true, t.TakerGets.Unmarshal(r)
+	case enc{ST_ACCOUNT, 1}: // Account
+		t.Account = new(Account)
+		return true, t.Account.Unmarshal(r)
+	}
+	return false, nil
+}
+
+func (t *OfferCancel) encodeFields(fw *fieldWriter, nested bool) {
+	fw.setExtra(t.TxBase.Fields)
+	fw.uint16(enc{ST_UINT16, 2}, uint16(t.TxBase.TransactionType)) // TransactionType
+	if t.TxBase.Flags != nil {
+		fw.uint32(enc{ST_UINT32, 2}, uint32((*t.TxBase.Flags))) // Flags
+	}
+	if t.TxBase.SourceTag != nil {
+		fw.uint32(enc{ST_UINT32, 3}, uint32((*t.TxBase.SourceTag))) // SourceTag
+	}
+	fw.uint32(enc{ST_UINT32, 4}, uint32(t.TxBase.Sequence)) // Sequence
+	fw.uint32(enc{ST_UINT32, 25}, uint32(t.OfferSequence))  // OfferSequence
+	if t.TxBase.LastLedgerSequence != nil {
+		fw.uint32(enc{ST_UINT32, 27}, uint32((*t.TxBase.LastLedgerSequence))) // LastLedgerSequence
+	}
+	if t.TxBase.TicketSequence != nil {
+		fw.uint32(enc{ST_UINT32, 41}, uint32((*t.TxBase.TicketSequence))) // TicketSequence
+	}
+	if t.TxBase.PreviousTxnID != nil {
+		fw.wire(enc{ST_HASH256, 5}, t.TxBase.PreviousTxnID) // PreviousTxnID
+	}
+	if t.TxBase.AccountTxnID != nil {
+		fw.wire(enc{ST_HASH256, 9}, t.TxBase.AccountTxnID) // AccountTxnID
+	}
+	fw.wire(enc{ST_AMOUNT, 8}, &t.TxBase.Fee) // Fee
+	if t.TxBase.SigningPubKey != nil {
+		fw.wire(enc{ST_VL, 3}, t.TxBase.SigningPubKey) // SigningPubKey
+	}
+	if !fw.ignoreSigningFields && t.TxBase.TxnSignature != nil && len((*t.TxBase.TxnSignature)) > 0 {
+		fw.wire(enc{ST_VL, 4}, t.TxBase.TxnSignature) // TxnSignature
+	}
+	fw.wire(enc{ST_ACCOUNT, 1}, &t.TxBase.Account) // Account
+	if !fw.ignoreSigningFields && len(t.TxBase.Signers) > 0 {
+		fw.header(enc{ST_ARRAY, 3}) // Signers
+		for i := range t.TxBase.Signers {
+			e172 := &t.TxBase.Signers[i]
+			c173 := fw.child()
+			c173.header(enc{ST_OBJECT, 16}) // Signer
+			c174 := c173.child()
+			if e172.Signer.SigningPubKey != nil {
+				c174.wire(enc{ST_VL, 3}, e172.Signer.SigningPubKey) // SigningPubKey
+			}
+			if !fw.ignoreSigningFields && e172.Signer.TxnSignature != nil && len((*e172.Signer.TxnSignature)) > 0 {
+				c174.wire(enc{ST_VL, 4}, e172.Signer.TxnSignature) // TxnSignature
+			}
+			c174.wire(enc{ST_ACCOUNT, 1}, &e172.Signer.Account) // Account
+			c173.close(&c174)
+			c173.end(endOfObject)
+			fw.close(&c173)
+		}
+		fw.end(endOfArray)
+	}
+	if len(t.TxBase.Memos) > 0 {
+		fw.header(enc{ST_ARRAY, 9}) // Memos
+		for i := range t.TxBase.Memos {
+			e175 := &t.TxBase.Memos[i]
+			c176 := fw.child()
+			c176.header(enc{ST_OBJECT, 10}) // Memo
+			c177 := c176.child()
+			if len(e175.Memo.MemoType) > 0 {
+				c177.wire(enc{ST_VL, 12}, &e175.Memo.MemoType) // MemoType
+			}
+			if len(e175.Memo.MemoData) > 0 {
+				c177.wire(enc{ST_VL, 13}, &e175.Memo.MemoData) // MemoData
+			}
+			if len(e175.Memo.MemoFormat) > 0 {
+				c177.wire(enc{ST_VL, 14}, &e175.Memo.MemoFormat) // MemoFormat
+			}
+			c176.close(&c177)
+			c176.end(endOfObject)
+			fw.close(&c176)
+		}
+		fw.end(endOfArray)
+	}
+}
+
+func (t *OfferCancel) decodeField(r Reader, e enc) (bool, error) {
+	switch e {
+	case enc{ST_UINT16, 2}: // TransactionType
+		v, err := readUint16(r)
+		t.TxBase.TransactionType = TransactionType(v)
+		return true, err
+	case enc{ST_UINT32, 2}: // Flags
+		t.TxBase.Flags = new(TransactionFlag)
+		v, err := readUint32(r)
+		*t.TxBase.Flags = TransactionFlag(v)
+		return true, err
+	case enc{ST_UINT32, 3}: // SourceTag
+		t.TxBase.SourceTag = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.SourceTag = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 4}: // Sequence
+		v, err := readUint32(r)
+		t.TxBase.Sequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 25}: // OfferSequence
+		v, err := readUint32(r)
+		t.OfferSequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 27}: // LastLedgerSequence
+		t.TxBase.LastLedgerSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.LastLedgerSequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 41}: // TicketSequence
+		t.TxBase.TicketSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.TicketSequence = uint32(v)
+		return true, err
+	case enc{ST_HASH256, 5}: // PreviousTxnID
+		t.TxBase.PreviousTxnID = new(Hash256)
+		return true, t.TxBase.PreviousTxnID.Unmarshal(r)
+	case enc{ST_HASH256, 9}: // AccountTxnID
+		t.TxBase.AccountTxnID = new(Hash256)
+		return true, t.TxBase.AccountTxnID.Unmarshal(r)
+	case enc{ST_AMOUNT, 8}: // Fee
+		return true, t.TxBase.Fee.Unmarshal(r)
+	case enc{ST_VL, 3}: // SigningPubKey
+		t.TxBase.SigningPubKey = new(PublicKey)
+		return true, t.TxBase.SigningPubKey.Unmarshal(r)
+	case enc{ST_VL, 4}: // TxnSignature
+		t.TxBase.TxnSignature = new(VariableLength)
+		return true, t.TxBase.TxnSignature.Unmarshal(r)
+	case enc{ST_ACCOUNT, 1}: // Account
+		return true, t.TxBase.Account.Unmarshal(r)
+	}
+	return false, nil
+}
+
+func (t *OfferCreate) encodeFields(fw *fieldWriter, nested bool) {
+	fw.setExtra(t.TxBase.Fields)
+	fw.uint16(enc{ST_UINT16, 2}, uint16(t.TxBase.TransactionType)) // TransactionType
+	if t.TxBase.Flags != nil {
+		fw.uint32(enc{ST_UINT32, 2}, uint32((*t.TxBase.Flags))) // Flags
+	}
+	if t.TxBase.SourceTag != nil {
+		fw.uint32(enc{ST_UINT32, 3}, uint32((*t.TxBase.SourceTag))) // SourceTag
+	}
+	fw.uint32(enc{ST_UINT32, 4}, uint32(t.TxBase.Sequence)) // Sequence
+	if t.Expiration != nil {
+		fw.uint32(enc{ST_UINT32, 10}, uint32((*t.Expiration))) // Expiration
+	}
+	if t.OfferSequence != nil {
+		fw.uint32(enc{ST_UINT32, 25}, uint32((*t.OfferSequence))) // OfferSequence
+	}
+	if t.TxBase.LastLedgerSequence != nil {
+		fw.uint32(enc{ST_UINT32, 27}, uint32((*t.TxBase.LastLedgerSequence))) // LastLedgerSequence
+	}
+	if t.TxBase.TicketSequence != nil {
+		fw.uint32(enc{ST_UINT32, 41}, uint32((*t.TxBase.TicketSequence))) // TicketSequence
+	}
+	if t.TxBase.PreviousTxnID != nil {
+		fw.wire(enc{ST_HASH256, 5}, t.TxBase.PreviousTxnID) // PreviousTxnID
+	}
+	if t.TxBase.AccountTxnID != nil {
+		fw.wire(enc{ST_HASH256, 9}, t.TxBase.AccountTxnID) // AccountTxnID
+	}
+	fw.wire(enc{ST_AMOUNT, 4}, &t.TakerPays)  // TakerPays
+	fw.wire(enc{ST_AMOUNT, 5}, &t.TakerGets)  // TakerGets
+	fw.wire(enc{ST_AMOUNT, 8}, &t.TxBase.Fee) // Fee
+	if t.TxBase.SigningPubKey != nil {
+		fw.wire(enc{ST_VL, 3}, t.TxBase.SigningPubKey) // SigningPubKey
+	}
+	if !fw.ignoreSigningFields && t.TxBase.TxnSignature != nil && len((*t.TxBase.TxnSignature)) > 0 {
+		fw.wire(enc{ST_VL, 4}, t.TxBase.TxnSignature) // TxnSignature
+	}
+	fw.wire(enc{ST_ACCOUNT, 1}, &t.TxBase.Account) // Account
+	if !fw.ignoreSigningFields && len(t.TxBase.Signers) > 0 {
+		fw.header(enc{ST_ARRAY, 3}) // Signers
+		for i := range t.TxBase.Signers {
+			e178 := &t.TxBase.Signers[i]
+			c179 := fw.child()
+			c179.header(enc{ST_OBJECT, 16}) // Signer
+			c180 := c179.child()
+			if e178.Signer.SigningPubKey != nil {
+				c180.wire(enc{ST_VL, 3}, e178.Signer.SigningPubKey) // SigningPubKey
+			}
+			if !fw.ignoreSigningFields && e178.Signer.TxnSignature != nil && len((*e178.Signer.TxnSignature)) > 0 {
+				c180.wire(enc{ST_VL, 4}, e178.Signer.TxnSignature) // TxnSignature
+			}
+			c180.wire(enc{ST_ACCOUNT, 1}, &e178.Signer.Account) // Account
+			c179.close(&c180)
+			c179.end(endOfObject)
+			fw.close(&c179)
+		}
+		fw.end(endOfArray)
+	}
+	if len(t.TxBase.Memos) > 0 {
+		fw.header(enc{ST_ARRAY, 9}) // Memos
+		for i := range t.TxBase.Memos {
+			e181 := &t.TxBase.Memos[i]
+			c182 := fw.child()
+			c182.header(enc{ST_OBJECT, 10}) // Memo
+			c183 := c182.child()
+			if len(e181.Memo.MemoType) > 0 {
+				c183.wire(enc{ST_VL, 12}, &e181.Memo.MemoType) // MemoType
+			}
+			if len(e181.Memo.MemoData) > 0 {
+				c183.wire(enc{ST_VL, 13}, &e181.Memo.MemoData) // MemoData
+			}
+			if len(e181.Memo.MemoFormat) > 0 {
+				c183.wire(enc{ST_VL, 14}, &e181.Memo.MemoFormat) // MemoFormat
+			}
+			c182.close(&c183)
+			c182.end(endOfObject)
+			fw.close(&c182)
+		}
+		fw.end(endOfArray)
+	}
+}
+
+func (t *OfferCreate) decodeField(r Reader, e enc) (bool, error) {
+	switch e {
+	case enc{ST_UINT16, 2}: // TransactionType
+		v, err := readUint16(r)
+		t.TxBase.TransactionType = TransactionType(v)
+		return true, err
+	case enc{ST_UINT32, 2}: // Flags
+		t.TxBase.Flags = new(TransactionFlag)
+		v, err := readUint32(r)
+		*t.TxBase.Flags = TransactionFlag(v)
+		return true, err
+	case enc{ST_UINT32, 3}: // SourceTag
+		t.TxBase.SourceTag = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.SourceTag = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 4}: // Sequence
+		v, err := readUint32(r)
+		t.TxBase.Sequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 10}: // Expiration
+		t.Expiration = new(uint32)
+		v, err := readUint32(r)
+		*t.Expiration = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 25}: // OfferSequence
+		t.OfferSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.OfferSequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 27}: // LastLedgerSequence
+		t.TxBase.LastLedgerSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.LastLedgerSequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 41}: // TicketSequence
+		t.TxBase.TicketSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.TicketSequence = uint32(v)
+		return true, err
+	case enc{ST_HASH256, 5}: // PreviousTxnID
+		t.TxBase.PreviousTxnID = new(Hash256)
+		return true, t.TxBase.PreviousTxnID.Unmarshal(r)
+	case enc{ST_HASH256, 9}: // AccountTxnID
+		t.TxBase.AccountTxnID = new(Hash256)
+		return true, t.TxBase.AccountTxnID.Unmarshal(r)
+	case enc{ST_AMOUNT, 4}: // TakerPays
+		return true, t.TakerPays.Unmarshal(r)
+	case enc{ST_AMOUNT, 5}: // TakerGets
+		return true, t.TakerGets.Unmarshal(r)
+	case enc{ST_AMOUNT, 8}: // Fee
+		return true, t.TxBase.Fee.Unmarshal(r)
+	case enc{ST_VL, 3}: // SigningPubKey
+		t.TxBase.SigningPubKey = new(PublicKey)
+		return true, t.TxBase.SigningPubKey.Unmarshal(r)
+	case enc{ST_VL, 4}: // TxnSignature
+		t.TxBase.TxnSignature = new(VariableLength)
+		return true, t.TxBase.TxnSignature.Unmarshal(r)
+	case enc{ST_ACCOUNT, 1}: // Account
+		return true, t.TxBase.Account.Unmarshal(r)
+	}
+	return false, nil
+}
+
+func (t *PayChannel) encodeFields(fw *fieldWriter, nested bool) {
+	fw.setExtra(t.leBase.Fields)
+	if !nested {
+		fw.uint16(enc{ST_UINT16, 1}, uint16(t.leBase.LedgerEntryType)) // LedgerEntryType
+	}
+	if t.Flags != nil {
+		fw.uint32(enc{ST_UINT32, 2}, uint32((*t.Flags))) // Flags
+	}
+	if t.SourceTag != nil {
+		fw.uint32(enc{ST_UINT32, 3}, uint32((*t.SourceTag))) // SourceTag
+	}
+	if t.leBase.PreviousTxnLgrSeq != nil {
+		fw.uint32(enc{ST_UINT32, 5}, uint32((*t.leBase.PreviousTxnLgrSeq))) // PreviousTxnLgrSeq
+	}
+	if t.Expiration != nil {
+		fw.uint32(enc{ST_UINT32, 10}, uint32((*t.Expiration))) // Expiration
+	}
+	if t.DestinationTag != nil {
+		fw.uint32(enc{ST_UINT32, 14}, uint32((*t.DestinationTag))) // DestinationTag
+	}
+	if t.CancelAfter != nil {
+		fw.uint32(enc{ST_UINT32, 36}, uint32((*t.CancelAfter))) // CancelAfter
+	}
+	if t.SettleDelay != nil {
+		fw.uint32(enc{ST_UINT32, 39}, uint32((*t.SettleDelay))) // SettleDelay
+	}
+	if t.OwnerNode != nil {
+		fw.uint64(enc{ST_UINT64, 4}, uint64((*t.OwnerNode))) // OwnerNode
+	}
+	if t.leBase.PreviousTxnID != nil {
+		fw.wire(enc{ST_HASH256, 5}, t.leBase.PreviousTxnID) // PreviousTxnID
+	}
+	if t.Amount != nil {
+		fw.wire(enc{ST_AMOUNT, 1}, t.Amount) // Amount
+	}
+	if t.Balance != nil {
+		fw.wire(enc{ST_AMOUNT, 2}, t.Balance) // Balance
+	}
+	if t.PublicKey != nil {
+		fw.wire(enc{ST_VL, 1}, t.PublicKey) // PublicKey
+	}
+	if t.Account != nil {
+		fw.wire(enc{ST_ACCOUNT, 1}, t.Account) // Account
+	}
+	if t.Destination != nil {
+		fw.wire(enc{ST_ACCOUNT, 3}, t.Destination) // Destination
+	}
+}
+
+func (t *PayChannel) decodeField(r Reader, e enc) (bool, error) {
+	switch e {
+	case enc{ST_UINT16, 1}: // LedgerEntryType
+		v, err := readUint16(r)
+		t.leBase.LedgerEntryType = LedgerEntryType(v)
+		return true, err
+	case enc{ST_UINT32, 2}: // Flags
+		t.Flags = new(LedgerEntryFlag)
+		v, err := readUint32(r)
+		*t.Flags = LedgerEntryFlag(v)
+		return true, err
+	case enc{ST_UINT32, 3}: // SourceTag
+		t.SourceTag = new(uint32)
+		v, err := readUint32(r)
+		*t.SourceTag = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 5}: // PreviousTxnLgrSeq
+		t.leBase.PreviousTxnLgrSeq = new(uint32)
+		v, err := readUint32(r)
+		*t.leBase.PreviousTxnLgrSeq = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 10}: // Expiration
+		t.Expiration = new(uint32)
+		v, err := readUint32(r)
+		*t.Expiration = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 14}: // DestinationTag
+		t.DestinationTag = new(uint32)
+		v, err := readUint32(r)
+		*t.DestinationTag = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 36}: // CancelAfter
+		t.CancelAfter = new(uint32)
+		v, err := readUint32(r)
+		*t.CancelAfter = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 39}: // SettleDelay
+		t.SettleDelay = new(uint32)
+		v, err := readUint32(r)
+		*t.SettleDelay = uint32(v)
+		return true, err
+	case enc{ST_UINT64, 4}: // OwnerNode
+		t.OwnerNode = new(NodeIndex)
+		v, err := readUint64(r)
+		*t.OwnerNode = NodeIndex(v)
+		return true, err
+	case enc{ST_HASH256, 5}: // PreviousTxnID
+		t.leBase.PreviousTxnID = new(Hash256)
+		return true, t.leBase.PreviousTxnID.Unmarshal(r)
+	case enc{ST_HASH256, 6}: // LedgerIndex
+		t.leBase.LedgerIndex = new(Hash256)
+		return true, t.leBase.LedgerIndex.Unmarshal(r)
+	case enc{ST_AMOUNT, 1}: // Amount
+		t.Amount = new(Amount)
+		return true, t.Amount.Unmarshal(r)
+	case enc{ST_AMOUNT, 2}: // Balance
+		t.Balance = new(Amount)
+		return true, t.Balance.Unmarshal(r)
+	case enc{ST_VL, 1}: // PublicKey
+		t.PublicKey = new(PublicKey)
+		return true, t.PublicKey.Unmarshal(r)
+	case enc{ST_ACCOUNT, 1}: // Account
+		t.Account = new(Account)
+		return true, t.Account.Unmarshal(r)
+	case enc{ST_ACCOUNT, 3}: // Destination
+		t.Destination = new(Account)
+		return true, t.Destination.Unmarshal(r)
+	}
+	return false, nil
+}
+
+func (t *Payment) encodeFields(fw *fieldWriter, nested bool) {
+	fw.setExtra(t.TxBase.Fields)
+	fw.uint16(enc{ST_UINT16, 2}, uint16(t.TxBase.TransactionType)) // TransactionType
+	if t.TxBase.Flags != nil {
+		fw.uint32(enc{ST_UINT32, 2}, uint32((*t.TxBase.Flags))) // Flags
+	}
+	if t.TxBase.SourceTag != nil {
+		fw.uint32(enc{ST_UINT32, 3}, uint32((*t.TxBase.SourceTag))) // SourceTag
+	}
+	fw.uint32(enc{ST_UINT32, 4}, uint32(t.TxBase.Sequence)) // Sequence
+	if t.DestinationTag != nil {
+		fw.uint32(enc{ST_UINT32, 14}, uint32((*t.DestinationTag))) // DestinationTag
+	}
+	if t.TxBase.LastLedgerSequence != nil {
+		fw.uint32(enc{ST_UINT32, 27}, uint32((*t.TxBase.LastLedgerSequence))) // LastLedgerSequence
+	}
+	if t.TxBase.TicketSequence != nil {
+		fw.uint32(enc{ST_UINT32, 41}, uint32((*t.TxBase.TicketSequence))) // TicketSequence
+	}
+	if t.TxBase.PreviousTxnID != nil {
+		fw.wire(enc{ST_HASH256, 5}, t.TxBase.PreviousTxnID) // PreviousTxnID
+	}
+	if t.TxBase.AccountTxnID != nil {
+		fw.wire(enc{ST_HASH256, 9}, t.TxBase.AccountTxnID) // AccountTxnID
+	}
+	if t.InvoiceID != nil {
+		fw.wire(enc{ST_HASH256, 17}, t.InvoiceID) // InvoiceID
+	}
+	fw.wire(enc{ST_AMOUNT, 1}, &t.Amount)     // Amount
+	fw.wire(enc{ST_AMOUNT, 8}, &t.TxBase.Fee) // Fee
+	if t.SendMax != nil {
+		fw.wire(enc{ST_AMOUNT, 9}, t.SendMax) // SendMax
+	}
+	if t.DeliverMin != nil {
+		fw.wire(enc{ST_AMOUNT, 10}, t.DeliverMin) // DeliverMin
+	}
+	if t.TxBase.SigningPubKey != nil {
+		fw.wire(enc{ST_VL, 3}, t.TxBase.SigningPubKey) // SigningPubKey
+	}
+	if !fw.ignoreSigningFields && t.TxBase.TxnSignature != nil && len((*t.TxBase.TxnSignature)) > 0 {
+		fw.wire(enc{ST_VL, 4}, t.TxBase.TxnSignature) // TxnSignature
+	}
+	fw.wire(enc{ST_ACCOUNT, 1}, &t.TxBase.Account) // Account
+	fw.wire(enc{ST_ACCOUNT, 3}, &t.Destination)    // Destination
+	if !fw.ignoreSigningFields && len(t.TxBase.Signers) > 0 {
+		fw.header(enc{ST_ARRAY, 3}) // Signers
+		for i := range t.TxBase.Signers {
+			e184 := &t.TxBase.Signers[i]
+			c185 := fw.child()
+			c185.header(enc{ST_OBJECT, 16}) // Signer
+			c186 := c185.child()
+			if e184.Signer.SigningPubKey != nil {
+				c186.wire(enc{ST_VL, 3}, e184.Signer.SigningPubKey) // SigningPubKey
+			}
+			if !fw.ignoreSigningFields && e184.Signer.TxnSignature != nil && len((*e184.Signer.TxnSignature)) > 0 {
+				c186.wire(enc{ST_VL, 4}, e184.Signer.TxnSignature) // TxnSignature
+			}
+			c186.wire(enc{ST_ACCOUNT, 1}, &e184.Signer.Account) // Account
+			c185.close(&c186)
+			c185.end(endOfObject)
+			fw.close(&c185)
+		}
+		fw.end(endOfArray)
+	}
+	if len(t.TxBase.Memos) > 0 {
+		fw.header(enc{ST_ARRAY, 9}) // Memos
+		for i := range t.TxBase.Memos {
+			e187 := &t.TxBase.Memos[i]
+			c188 := fw.child()
+			c188.header(enc{ST_OBJECT, 10}) // Memo
+			c189 := c188.child()
+			if len(e187.Memo.MemoType) > 0 {
+				c189.wire(enc{ST_VL, 12}, &e187.Memo.MemoType) // MemoType
+			}
+			if len(e187.Memo.MemoData) > 0 {
+				c189.wire(enc{ST_VL, 13}, &e187.Memo.MemoData) // MemoData
+			}
+			if len(e187.Memo.MemoFormat) > 0 {
+				c189.wire(enc{ST_VL, 14}, &e187.Memo.MemoFormat) // MemoFormat
+			}
+			c188.close(&c189)
+			c188.end(endOfObject)
+			fw.close(&c188)
+		}
+		fw.end(endOfArray)
+	}
+	if t.Paths != nil && len((*t.Paths)) > 0 {
+		fw.wire(enc{ST_PATHSET, 1}, t.Paths) // Paths
+	}
+}
+
+func (t *Payment) decodeField(r Reader, e enc) (bool, error) {
+	switch e {
+	case enc{ST_UINT16, 2}: // TransactionType
+		v, err := readUint16(r)
+		t.TxBase.TransactionType = TransactionType(v)
+		return true, err
+	case enc{ST_UINT32, 2}: // Flags
+		t.TxBase.Flags = new(TransactionFlag)
+		v, err := readUint32(r)
+		*t.TxBase.Flags = TransactionFlag(v)
+		return true, err
+	case enc{ST_UINT32, 3}: // SourceTag
+		t.TxBase.SourceTag = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.SourceTag = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 4}: // Sequence
+		v, err := readUint32(r)
+		t.TxBase.Sequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 14}: // DestinationTag
+		t.DestinationTag = new(uint32)
+		v, err := readUint32(r)
+		*t.DestinationTag = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 27}: // LastLedgerSequence
+		t.TxBase.LastLedgerSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.LastLedgerSequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 41}: // TicketSequence
+		t.TxBase.TicketSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.TicketSequence = uint32(v)
+		return true, err
+	case enc{ST_HASH256, 5}: // PreviousTxnID
+		t.TxBase.PreviousTxnID = new(Hash256)
+		return true, t.TxBase.PreviousTxnID.Unmarshal(r)
+	case enc{ST_HASH256, 9}: // AccountTxnID
+		t.TxBase.AccountTxnID = new(Hash256)
+		return true, t.TxBase.AccountTxnID.Unmarshal(r)
+	case enc{ST_HASH256, 17}: // InvoiceID
+		t.InvoiceID = new(Hash256)
+		return true, t.InvoiceID.Unmarshal(r)
+	case enc{ST_AMOUNT, 1}: // Amount
+		return true, t.Amount.Unmarshal(r)
+	case enc{ST_AMOUNT, 8}: // Fee
+		return true, t.TxBase.Fee.Unmarshal(r)
+	case enc{ST_AMOUNT, 9}: // SendMax
+		t.SendMax = new(Amount)
+		return true, t.SendMax.Unmarshal(r)
+	case enc{ST_AMOUNT, 10}: // DeliverMin
+		t.DeliverMin = new(Amount)
+		return true, t.DeliverMin.Unmarshal(r)
+	case enc{ST_VL, 3}: // SigningPubKey
+		t.TxBase.SigningPubKey = new(PublicKey)
+		return true, t.TxBase.SigningPubKey.Unmarshal(r)
+	case enc{ST_VL, 4}: // TxnSignature
+		t.TxBase.TxnSignature = new(VariableLength)
+		return true, t.TxBase.TxnSignature.Unmarshal(r)
+	case enc{ST_ACCOUNT, 1}: // Account
+		return true, t.TxBase.Account.Unmarshal(r)
+	case enc{ST_ACCOUNT, 3}: // Destination
+		return true, t.Destination.Unmarshal(r)
+	case enc{ST_PATHSET, 1}: // Paths
+		t.Paths = new(PathSet)
+		return true, t.Paths.Unmarshal(r)
+	}
+	return false, nil
+}
+
+func (t *PaymentChannelClaim) encodeFields(fw *fieldWriter, nested bool) {
+	fw.setExtra(t.TxBase.Fields)
+	fw.uint16(enc{ST_UINT16, 2}, uint16(t.TxBase.TransactionType)) // TransactionType
+	if t.TxBase.Flags != nil {
+		fw.uint32(enc{ST_UINT32, 2}, uint32((*t.TxBase.Flags))) // Flags
+	}
+	if t.TxBase.SourceTag != nil {
+		fw.uint32(enc{ST_UINT32, 3}, uint32((*t.TxBase.SourceTag))) // SourceTag
+	}
+	fw.uint32(enc{ST_UINT32, 4}, uint32(t.TxBase.Sequence)) // Sequence
+	if t.TxBase.LastLedgerSequence != nil {
+		fw.uint32(enc{ST_UINT32, 27}, uint32((*t.TxBase.LastLedgerSequence))) // LastLedgerSequence
+	}
+	if t.TxBase.TicketSequence != nil {
+		fw.uint32(enc{ST_UINT32, 41}, uint32((*t.TxBase.TicketSequence))) // TicketSequence
+	}
+	if t.TxBase.PreviousTxnID != nil {
+		fw.wire(enc{ST_HASH256, 5}, t.TxBase.PreviousTxnID) // PreviousTxnID
+	}
+	if t.TxBase.AccountTxnID != nil {
+		fw.wire(enc{ST_HASH256, 9}, t.TxBase.AccountTxnID) // AccountTxnID
+	}
+	fw.wire(enc{ST_HASH256, 22}, &t.Channel) // Channel
+	if t.Amount != nil {
+		fw.wire(enc{ST_AMOUNT, 1}, t.Amount) // Amount
+	}
+	if t.Balance != nil {
+		fw.wire(enc{ST_AMOUNT, 2}, t.Balance) // Balance
+	}
+	fw.wire(enc{ST_AMOUNT, 8}, &t.TxBase.Fee) // Fee
+	if t.PublicKey != nil {
+		fw.wire(enc{ST_VL, 1}, t.PublicKey) // PublicKey
+	}
+	if t.TxBase.SigningPubKey != nil {
+		fw.wire(enc{ST_VL, 3}, t.TxBase.SigningPubKey) // SigningPubKey
+	}
+	if !fw.ignoreSigningFields && t.TxBase.TxnSignature != nil && len((*t.TxBase.TxnSignature)) > 0 {
+		fw.wire(enc{ST_VL, 4}, t.TxBase.TxnSignature) // TxnSignature
+	}
+	if !fw.ignoreSigningFields && t.Signature != nil && len((*t.Signature)) > 0 {
+		fw.wire(enc{ST_VL, 6}, t.Signature) // Signature
+	}
+	fw.wire(enc{ST_ACCOUNT, 1}, &t.TxBase.Account) // Account
+	if !fw.ignoreSigningFields && len(t.TxBase.Signers) > 0 {
+		fw.header(enc{ST_ARRAY, 3}) // Signers
+		for i := range t.TxBase.Signers {
+			e190 := &t.TxBase.Signers[i]
+			c191 := fw.child()
+			c191.header(enc{ST_OBJECT, 16}) // Signer
+			c192 := c191.child()
+			if e190.Signer.SigningPubKey != nil {
+				c192.wire(enc{ST_VL, 3}, e190.Signer.SigningPubKey) // SigningPubKey
+			}
+			if !fw.ignoreSigningFields && e190.Signer.TxnSignature != nil && len((*e190.Signer.TxnSignature)) > 0 {
+				c192.wire(enc{ST_VL, 4}, e190.Signer.TxnSignature) // TxnSignature
+			}
+			c192.wire(enc{ST_ACCOUNT, 1}, &e190.Signer.Account) // Account
+			c191.close(&c192)
+			c191.end(endOfObject)
+			fw.close(&c191)
+		}
+		fw.end(endOfArray)
+	}
+	if len(t.TxBase.Memos) > 0 {
+		fw.header(enc{ST_ARRAY, 9}) // Memos
+		for i := range t.TxBase.Memos {
+			e193 := &t.TxBase.Memos[i]
+			c194 := fw.child()
+			c194.header(enc{ST_OBJECT, 10}) // Memo
+			c195 := c194.child()
+			if len(e193.Memo.MemoType) > 0 {
+				c195.wire(enc{ST_VL, 12}, &e193.Memo.MemoType) // MemoType
+			}
+			if len(e193.Memo.MemoData) > 0 {
+				c195.wire(enc{ST_VL, 13}, &e193.Memo.MemoData) // MemoData
+			}
+			if len(e193.Memo.MemoFormat) > 0 {
+				c195.wire(enc{ST_VL, 14}, &e193.Memo.MemoFormat) // MemoFormat
+			}
+			c194.close(&c195)
+			c194.end(endOfObject)
+			fw.close(&c194)
+		}
+		fw.end(endOfArray)
+	}
+}
+
+func (t *PaymentChannelClaim) decodeField(r Reader, e enc) (bool, error) {
+	switch e {
+	case enc{ST_UINT16, 2}: // TransactionType
+		v, err := readUint16(r)
+		t.TxBase.TransactionType = TransactionType(v)
+		return true, err
+	case enc{ST_UINT32, 2}: // Flags
+		t.TxBase.Flags = new(TransactionFlag)
+		v, err := readUint32(r)
+		*t.TxBase.Flags = TransactionFlag(v)
+		return true, err
+	case enc{ST_UINT32, 3}: // SourceTag
+		t.TxBase.SourceTag = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.SourceTag = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 4}: // Sequence
+		v, err := readUint32(r)
+		t.TxBase.Sequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 27}: // LastLedgerSequence
+		t.TxBase.LastLedgerSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.LastLedgerSequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 41}: // TicketSequence
+		t.TxBase.TicketSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.TicketSequence = uint32(v)
+		return true, err
+	case enc{ST_HASH256, 5}: // PreviousTxnID
+		t.TxBase.PreviousTxnID = new(Hash256)
+		return true, t.TxBase.PreviousTxnID.Unmarshal(r)
+	case enc{ST_HASH256, 9}: // AccountTxnID
+		t.TxBase.AccountTxnID = new(Hash256)
+		return true, t.TxBase.AccountTxnID.Unmarshal(r)
+	case enc{ST_HASH256, 22}: // Channel
+		return true, t.Channel.Unmarshal(r)
+	case enc{ST_AMOUNT, 1}: // Amount
+		t.Amount = new(Amount)
+		return true, t.Amount.Unmarshal(r)
+	case enc{ST_AMOUNT, 2}: // Balance
+		t.Balance = new(Amount)
+		return true, t.Balance.Unmarshal(r)
+	case enc{ST_AMOUNT, 8}: // Fee
+		return true, t.TxBase.Fee.Unmarshal(r)
+	case enc{ST_VL, 1}: // PublicKey
+		t.PublicKey = new(PublicKey)
+		return true, t.PublicKey.Unmarshal(r)
+	case enc{ST_VL, 3}: // SigningPubKey
+		t.TxBase.SigningPubKey = new(PublicKey)
+		return true, t.TxBase.SigningPubKey.Unmarshal(r)
+	case enc{ST_VL, 4}: // TxnSignature
+		t.TxBase.TxnSignature = new(VariableLength)
+		return true, t.TxBase.TxnSignature.Unmarshal(r)
+	case enc{ST_VL, 6}: // Signature
+		t.Signature = new(VariableLength)
+		return true, t.Signature.Unmarshal(r)
+	case enc{ST_ACCOUNT, 1}: // Account
+		return true, t.TxBase.Account.Unmarshal(r)
+	}
+	return false, nil
+}
+
+func (t *PaymentChannelCreate) encodeFields(fw *fieldWriter, nested bool) {
+	fw.setExtra(t.TxBase.Fields)
+	fw.uint16(enc{ST_UINT16, 2}, uint16(t.TxBase.TransactionType)) // TransactionType
+	if t.TxBase.Flags != nil {
+		fw.uint32(enc{ST_UINT32, 2}, uint32((*t.TxBase.Flags))) // Flags
+	}
+	if t.TxBase.SourceTag != nil {
+		fw.uint32(enc{ST_UINT32, 3}, uint32((*t.TxBase.SourceTag))) // SourceTag
+	}
+	if t.SourceTag != nil {
+		fw.uint32(enc{ST_UINT32, 3}, uint32((*t.SourceTag))) // SourceTag
+	}
+	fw.uint32(enc{ST_UINT32, 4}, uint32(t.TxBase.Sequence)) // Sequence
+	if t.DestinationTag != nil {
+		fw.uint32(enc{ST_UINT32, 14}, uint32((*t.DestinationTag))) // DestinationTag
+	}
+	if t.TxBase.LastLedgerSequence != nil {
+		fw.uint32(enc{ST_UINT32, 27}, uint32((*t.TxBase.LastLedgerSequence))) // LastLedgerSequence
+	}
+	if t.CancelAfter != nil {
+		fw.uint32(enc{ST_UINT32, 36}, uint32((*t.CancelAfter))) // CancelAfter
+	}
+	fw.uint32(enc{ST_UINT32, 39}, uint32(t.SettleDelay)) // SettleDelay
+	if t.TxBase.TicketSequence != nil {
+		fw.uint32(enc{ST_UINT32, 41}, uint32((*t.TxBase.TicketSequence))) // TicketSequence
+	}
+	if t.TxBase.PreviousTxnID != nil {
+		fw.wire(enc{ST_HASH256, 5}, t.TxBase.PreviousTxnID) // PreviousTxnID
+	}
+	if t.TxBase.AccountTxnID != nil {
+		fw.wire(enc{ST_HASH256, 9}, t.TxBase.AccountTxnID) // AccountTxnID
+	}
+	fw.wire(enc{ST_AMOUNT, 1}, &t.Amount)     // Amount
+	fw.wire(enc{ST_AMOUNT, 8}, &t.TxBase.Fee) // Fee
+	fw.wire(enc{ST_VL, 1}, &t.PublicKey)      // PublicKey
+	if t.TxBase.SigningPubKey != nil {
+		fw.wire(enc{ST_VL, 3}, t.TxBase.SigningPubKey) // SigningPubKey
+	}
+	if !fw.ignoreSigningFields && t.TxBase.TxnSignature != nil && len((*t.TxBase.TxnSignature)) > 0 {
+		fw.wire(enc{ST_VL, 4}, t.TxBase.TxnSignature) // TxnSignature
+	}
+	fw.wire(enc{ST_ACCOUNT, 1}, &t.TxBase.Account) // Account
+	fw.wire(enc{ST_ACCOUNT, 3}, &t.Destination)    // Destination
+	if !fw.ignoreSigningFields && len(t.TxBase.Signers) > 0 {
+		fw.header(enc{ST_ARRAY, 3}) // Signers
+		for i := range t.TxBase.Signers {
+			e196 := &t.TxBase.Signers[i]
+			c197 := fw.child()
+			c197.header(enc{ST_OBJECT, 16}) // Signer
+			c198 := c197.child()
+			if e196.Signer.SigningPubKey != nil {
+				c198.wire(enc{ST_VL, 3}, e196.Signer.SigningPubKey) // SigningPubKey
+			}
+			if !fw.ignoreSigningFields && e196.Signer.TxnSignature != nil && len((*e196.Signer.TxnSignature)) > 0 {
+				c198.wire(enc{ST_VL, 4}, e196.Signer.TxnSignature) // TxnSignature
+			}
+			c198.wire(enc{ST_ACCOUNT, 1}, &e196.Signer.Account) // Account
+			c197.close(&c198)
+			c197.end(endOfObject)
+			fw.close(&c197)
+		}
+		fw.end(endOfArray)
+	}
+	if len(t.TxBase.Memos) > 0 {
+		fw.header(enc{ST_ARRAY, 9}) // Memos
+		for i := range t.TxBase.Memos {
+			e199 := &t.TxBase.Memos[i]
+			c200 := fw.child()
+			c200.header(enc{ST_OBJECT, 10}) // Memo
+			c201 := c200.child()
+			if len(e199.Memo.MemoType) > 0 {
+				c201.wire(enc{ST_VL, 12}, &e199.Memo.MemoType) // MemoType
+			}
+			if len(e199.Memo.MemoData) > 0 {
+				c201.wire(enc{ST_VL, 13}, &e199.Memo.MemoData) // MemoData
+			}
+			if len(e199.Memo.MemoFormat) > 0 {
+				c201.wire(enc{ST_VL, 14}, &e199.Memo.MemoFormat) // MemoFormat
+			}
+			c200.close(&c201)
+			c200.end(endOfObject)
+			fw.close(&c200)
+		}
+		fw.end(endOfArray)
+	}
+}
+
+func (t *PaymentChannelCreate) decodeField(r Reader, e enc) (bool, error) {
+	switch e {
+	case enc{ST_UINT16, 2}: // TransactionType
+		v, err := readUint16(r)
+		t.TxBase.TransactionType = TransactionType(v)
+		return true, err
+	case enc{ST_UINT32, 2}: // Flags
+		t.TxBase.Flags = new(TransactionFlag)
+		v, err := readUint32(r)
+		*t.TxBase.Flags = TransactionFlag(v)
+		return true, err
+	case enc{ST_UINT32, 3}: // SourceTag
+		t.SourceTag = new(uint32)
+		v, err := readUint32(r)
+		*t.SourceTag = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 4}: // Sequence
+		v, err := readUint32(r)
+		t.TxBase.Sequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 14}: // DestinationTag
+		t.DestinationTag = new(uint32)
+		v, err := readUint32(r)
+		*t.DestinationTag = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 27}: // LastLedgerSequence
+		t.TxBase.LastLedgerSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.LastLedgerSequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 36}: // CancelAfter
+		t.CancelAfter = new(uint32)
+		v, err := readUint32(r)
+		*t.CancelAfter = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 39}: // SettleDelay
+		v, err := readUint32(r)
+		t.SettleDelay = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 41}: // TicketSequence
+		t.TxBase.TicketSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.TicketSequence = uint32(v)
+		return true, err
+	case enc{ST_HASH256, 5}: // PreviousTxnID
+		t.TxBase.PreviousTxnID = new(Hash256)
+		return true, t.TxBase.PreviousTxnID.Unmarshal(r)
+	case enc{ST_HASH256, 9}: // AccountTxnID
+		t.TxBase.AccountTxnID = new(Hash256)
+		return true, t.TxBase.AccountTxnID.Unmarshal(r)
+	case enc{ST_AMOUNT, 1}: // Amount
+		return true, t.Amount.Unmarshal(r)
+	case enc{ST_AMOUNT, 8}: // Fee
+		return true, t.TxBase.Fee.Unmarshal(r)
+	case enc{ST_VL, 1}: // PublicKey
+		return true, t.PublicKey.Unmarshal(r)
+	case enc{ST_VL, 3}: // SigningPubKey
+		t.TxBase.SigningPubKey = new(PublicKey)
+		return true, t.TxBase.SigningPubKey.Unmarshal(r)
+	case enc{ST_VL, 4}: // TxnSignature
+		t.TxBase.TxnSignature = new(VariableLength)
+		return true, t.TxBase.TxnSignature.Unmarshal(r)
+	case enc{ST_ACCOUNT, 1}: // Account
+		return true, t.TxBase.Account.Unmarshal(r)
+	case enc{ST_ACCOUNT, 3}: // Destination
+		return true, t.Destination.Unmarshal(r)
+	}
+	return false, nil
+}
+
+func (t *PaymentChannelFund) encodeFields(fw *fieldWriter, nested bool) {
+	fw.setExtra(t.TxBase.Fields)
+	fw.uint16(enc{ST_UINT16, 2}, uint16(t.TxBase.TransactionType)) // TransactionType
+	if t.TxBase.Flags != nil {
+		fw.uint32(enc{ST_UINT32, 2}, uint32((*t.TxBase.Flags))) // Flags
+	}
+	if t.TxBase.SourceTag != nil {
+		fw.uint32(enc{ST_UINT32, 3}, uint32((*t.TxBase.SourceTag))) // SourceTag
+	}
+	fw.uint32(enc{ST_UINT32, 4}, uint32(t.TxBase.Sequence)) // Sequence
+	if t.Expiration != nil {
+		fw.uint32(enc{ST_UINT32, 10}, uint32((*t.Expiration))) // Expiration
+	}
+	if t.TxBase.LastLedgerSequence != nil {
+		fw.uint32(enc{ST_UINT32, 27}, uint32((*t.TxBase.LastLedgerSequence))) // LastLedgerSequence
+	}
+	if t.TxBase.TicketSequence != nil {
+		fw.uint32(enc{ST_UINT32, 41}, uint32((*t.TxBase.TicketSequence))) // TicketSequence
+	}
+	if t.TxBase.PreviousTxnID != nil {
+		fw.wire(enc{ST_HASH256, 5}, t.TxBase.PreviousTxnID) // PreviousTxnID
+	}
+	if t.TxBase.AccountTxnID != nil {
+		fw.wire(enc{ST_HASH256, 9}, t.TxBase.AccountTxnID) // AccountTxnID
+	}
+	fw.wire(enc{ST_HASH256, 22}, &t.Channel)  // Channel
+	fw.wire(enc{ST_AMOUNT, 1}, &t.Amount)     // Amount
+	fw.wire(enc{ST_AMOUNT, 8}, &t.TxBase.Fee) // Fee
+	if t.TxBase.SigningPubKey != nil {
+		fw.wire(enc{ST_VL, 3}, t.TxBase.SigningPubKey) // SigningPubKey
+	}
+	if !fw.ignoreSigningFields && t.TxBase.TxnSignature != nil && len((*t.TxBase.TxnSignature)) > 0 {
+		fw.wire(enc{ST_VL, 4}, t.TxBase.TxnSignature) // TxnSignature
+	}
+	fw.wire(enc{ST_ACCOUNT, 1}, &t.TxBase.Account) // Account
+	if !fw.ignoreSigningFields && len(t.TxBase.Signers) > 0 {
+		fw.header(enc{ST_ARRAY, 3}) // Signers
+		for i := range t.TxBase.Signers {
+			e202 := &t.TxBase.Signers[i]
+			c203 := fw.child()
+			c203.header(enc{ST_OBJECT, 16}) // Signer
+			c204 := c203.child()
+			if e202.Signer.SigningPubKey != nil {
+				c204.wire(enc{ST_VL, 3}, e202.Signer.SigningPubKey) // SigningPubKey
+			}
+			if !fw.ignoreSigningFields && e202.Signer.TxnSignature != nil && len((*e202.Signer.TxnSignature)) > 0 {
+				c204.wire(enc{ST_VL, 4}, e202.Signer.TxnSignature) // TxnSignature
+			}
+			c204.wire(enc{ST_ACCOUNT, 1}, &e202.Signer.Account) // Account
+			c203.close(&c204)
+			c203.end(endOfObject)
+			fw.close(&c203)
+		}
+		fw.end(endOfArray)
+	}
+	if len(t.TxBase.Memos) > 0 {
+		fw.header(enc{ST_ARRAY, 9}) // Memos
+		for i := range t.TxBase.Memos {
+			e205 := &t.TxBase.Memos[i]
+			c206 := fw.child()
+			c206.header(enc{ST_OBJECT, 10}) // Memo
+			c207 := c206.child()
+			if len(e205.Memo.MemoType) > 0 {
+				c207.wire(enc{ST_VL, 12}, &e205.Memo.MemoType) // MemoType
+			}
+			if len(e205.Memo.MemoData) > 0 {
+				c207.wire(enc{ST_VL, 13}, &e205.Memo.MemoData) // MemoData
+			}
+			if len(e205.Memo.MemoFormat) > 0 {
+				c207.wire(enc{ST_VL, 14}, &e205.Memo.MemoFormat) // MemoFormat
+			}
+			c206.close(&c207)
+			c206.end(endOfObject)
+			fw.close(&c206)
+		}
+		fw.end(endOfArray)
+	}
+}
+
+func (t *PaymentChannelFund) decodeField(r Reader, e enc) (bool, error) {
+	switch e {
+	case enc{ST_UINT16, 2}: // TransactionType
+		v, err := readUint16(r)
+		t.TxBase.TransactionType = TransactionType(v)
+		return true, err
+	case enc{ST_UINT32, 2}: // Flags
+		t.TxBase.Flags = new(TransactionFlag)
+		v, err := readUint32(r)
+		*t.TxBase.Flags = TransactionFlag(v)
+		return true, err
+	case enc{ST_UINT32, 3}: // SourceTag
+		t.TxBase.SourceTag = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.SourceTag = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 4}: // Sequence
+		v, err := readUint32(r)
+		t.TxBase.Sequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 10}: // Expiration
+		t.Expiration = new(uint32)
+		v, err := readUint32(r)
+		*t.Expiration = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 27}: // LastLedgerSequence
+		t.TxBase.LastLedgerSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.LastLedgerSequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 41}: // TicketSequence
+		t.TxBase.TicketSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.TicketSequence = uint32(v)
+		return true, err
+	case enc{ST_HASH256, 5}: // PreviousTxnID
+		t.TxBase.PreviousTxnID = new(Hash256)
+		return true, t.TxBase.PreviousTxnID.Unmarshal(r)
+	case enc{ST_HASH256, 9}: // AccountTxnID
+		t.TxBase.AccountTxnID = new(Hash256)
+		return true, t.TxBase.AccountTxnID.Unmarshal(r)
+	case enc{ST_HASH256, 22}: // Channel
+		return true, t.Channel.Unmarshal(r)
+	case enc{ST_AMOUNT, 1}: // Amount
+		return true, t.Amount.Unmarshal(r)
+	case enc{ST_AMOUNT, 8}: // Fee
+		return true, t.TxBase.Fee.Unmarshal(r)
+	case enc{ST_VL, 3}: // SigningPubKey
+		t.TxBase.SigningPubKey = new(PublicKey)
+		return true, t.TxBase.SigningPubKey.Unmarshal(r)
+	case enc{ST_VL, 4}: // TxnSignature
+		t.TxBase.TxnSignature = new(VariableLength)
+		return true, t.TxBase.TxnSignature.Unmarshal(r)
+	case enc{ST_ACCOUNT, 1}: // Account
+		return true, t.TxBase.Account.Unmarshal(r)
+	}
+	return false, nil
+}
+
+func (t *RippleState) encodeFields(fw *fieldWriter, nested bool) {
+	fw.setExtra(t.leBase.Fields)
+	if !nested {
+		fw.uint16(enc{ST_UINT16, 1}, uint16(t.leBase.LedgerEntryType)) // LedgerEntryType
+	}
+	if t.Flags != nil {
+		fw.uint32(enc{ST_UINT32, 2}, uint32((*t.Flags))) // Flags
+	}
+	if t.leBase.PreviousTxnLgrSeq != nil {
+		fw.uint32(enc{ST_UINT32, 5}, uint32((*t.leBase.PreviousTxnLgrSeq))) // PreviousTxnLgrSeq
+	}
+	if t.HighQualityIn != nil {
+		fw.uint32(enc{ST_UINT32, 16}, uint32((*t.HighQualityIn))) // HighQualityIn
+	}
+	if t.HighQualityOut != nil {
+		fw.uint32(enc{ST_UINT32, 17}, uint32((*t.HighQualityOut))) // HighQualityOut
+	}
+	if t.LowQualityIn != nil {
+		fw.uint32(enc{ST_UINT32, 18}, uint32((*t.LowQualityIn))) // LowQualityIn
+	}
+	if t.LowQualityOut != nil {
+		fw.uint32(enc{ST_UINT32, 19}, uint32((*t.LowQualityOut))) // LowQualityOut
+	}
+	if t.LowNode != nil {
+		fw.uint64(enc{ST_UINT64, 7}, uint64((*t.LowNode))) // LowNode
+	}
+	if t.HighNode != nil {
+		fw.uint64(enc{ST_UINT64, 8}, uint64((*t.HighNode))) // HighNode
+	}
+	if t.leBase.PreviousTxnID != nil {
+		fw.wire(enc{ST_HASH256, 5}, t.leBase.PreviousTxnID) // PreviousTxnID
+	}
+	if t.Balance != nil {
+		fw.wire(enc{ST_AMOUNT, 2}, t.Balance) // Balance
+	}
+	if t.LowLimit != nil {
+		fw.wire(enc{ST_AMOUNT, 6}, t.LowLimit) // LowLimit
+	}
+	if t.HighLimit != nil {
+		fw.wire(enc{ST_AMOUNT, 7}, t.HighLimit) // HighLimit
+	}
+}
+
+func (t *RippleState) decodeField(r Reader, e enc) (bool, error) {
+	switch e {
+	case enc{ST_UINT16, 1}: // LedgerEntryType
+		v, err := readUint16(r)
+		t.leBase.LedgerEntryType = LedgerEntryType(v)
+		return true, err
+	case enc{ST_UINT32, 2}: // Flags
+		t.Flags = new(LedgerEntryFlag)
+		v, err := readUint32(r)
+		*t.Flags = LedgerEntryFlag(v)
+		return true, err
+	case enc{ST_UINT32, 5}: // PreviousTxnLgrSeq
+		t.leBase.PreviousTxnLgrSeq = new(uint32)
+		v, err := readUint32(r)
+		*t.leBase.PreviousTxnLgrSeq = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 16}: // HighQualityIn
+		t.HighQualityIn = new(uint32)
+		v, err := readUint32(r)
+		*t.HighQualityIn = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 17}: // HighQualityOut
+		t.HighQualityOut = new(uint32)
+		v, err := readUint32(r)
+		*t.HighQualityOut = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 18}: // LowQualityIn
+		t.LowQualityIn = new(uint32)
+		v, err := readUint32(r)
+		*t.LowQualityIn = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 19}: // LowQualityOut
+		t.LowQualityOut = new(uint32)
+		v, err := readUint32(r)
+		*t.LowQualityOut = uint32(v)
+		return true, err
+	case enc{ST_UINT64, 7}: // LowNode
+		t.LowNode = new(NodeIndex)
+		v, err := readUint64(r)
+		*t.LowNode = NodeIndex(v)
+		return true, err
+	case enc{ST_UINT64, 8}: // HighNode
+		t.HighNode = new(NodeIndex)
+		v, err := readUint64(r)
+		*t.HighNode = NodeIndex(v)
+		return true, err
+	case enc{ST_HASH256, 5}: // PreviousTxnID
+		t.leBase.PreviousTxnID = new(Hash256)
+		return true, t.leBase.PreviousTxnID.Unmarshal(r)
+	case enc{ST_HASH256, 6}: // LedgerIndex
+		t.leBase.LedgerIndex = new(Hash256)
+		return true, t.leBase.LedgerIndex.Unmarshal(r)
+	case enc{ST_AMOUNT, 2}: // Balance
+		t.Balance = new(Amount)
+		return true, t.Balance.Unmarshal(r)
+	case enc{ST_AMOUNT, 6}: // LowLimit
+		t.LowLimit = new(Amount)
+		return true, t.LowLimit.Unmarshal(r)
+	case enc{ST_AMOUNT, 7}: // HighLimit
+		t.HighLimit = new(Amount)
+		return true, t.HighLimit.Unmarshal(r)
+	}
+	return false, nil
+}
+
+func (t *SetFee) encodeFields(fw *fieldWriter, nested bool) {
+	fw.setExtra(t.TxBase.Fields)
+	fw.uint16(enc{ST_UINT16, 2}, uint16(t.TxBase.TransactionType)) // TransactionType
+	if t.TxBase.Flags != nil {
+		fw.uint32(enc{ST_UINT32, 2}, uint32((*t.TxBase.Flags))) // Flags
+	}
+	if t.TxBase.SourceTag != nil {
+		fw.uint32(enc{ST_UINT32, 3}, uint32((*t.TxBase.SourceTag))) // SourceTag
+	}
+	fw.uint32(enc{ST_UINT32, 4}, uint32(t.TxBase.Sequence)) // Sequence
+	if t.TxBase.LastLedgerSequence != nil {
+		fw.uint32(enc{ST_UINT32, 27}, uint32((*t.TxBase.LastLedgerSequence))) // LastLedgerSequence
+	}
+	fw.uint32(enc{ST_UINT32, 30}, uint32(t.ReferenceFeeUnits)) // ReferenceFeeUnits
+	fw.uint32(enc{ST_UINT32, 31}, uint32(t.ReserveBase))       // ReserveBase
+	fw.uint32(enc{ST_UINT32, 32}, uint32(t.ReserveIncrement))  // ReserveIncrement
+	if t.TxBase.TicketSequence != nil {
+		fw.uint32(enc{ST_UINT32, 41}, uint32((*t.TxBase.TicketSequence))) // TicketSequence
+	}
+	fw.uint64(enc{ST_UINT64, 5}, uint64(t.BaseFee)) // BaseFee
+	if t.TxBase.PreviousTxnID != nil {
+		fw.wire(enc{ST_HASH256, 5}, t.TxBase.PreviousTxnID) // PreviousTxnID
+	}
+	if t.TxBase.AccountTxnID != nil {
+		fw.wire(enc{ST_HASH256, 9}, t.TxBase.AccountTxnID) // AccountTxnID
+	}
+	fw.wire(enc{ST_AMOUNT, 8}, &t.TxBase.Fee) // Fee
+	if t.TxBase.SigningPubKey != nil {
+		fw.wire(enc{ST_VL, 3}, t.TxBase.SigningPubKey) // SigningPubKey
+	}
+	if !fw.ignoreSigningFields && t.TxBase.TxnSignature != nil && len((*t.TxBase.TxnSignature)) > 0 {
+		fw.wire(enc{ST_VL, 4}, t.TxBase.TxnSignature) // TxnSignature
+	}
+	fw.wire(enc{ST_ACCOUNT, 1}, &t.TxBase.Account) // Account
+	if !fw.ignoreSigningFields && len(t.TxBase.Signers) > 0 {
+		fw.header(enc{ST_ARRAY, 3}) // Signers
+		for i := range t.TxBase.Signers {
+			e208 := &t.TxBase.Signers[i]
+			c209 := fw.child()
+			c209.header(enc{ST_OBJECT, 16}) // Signer
+			c210 := c209.child()
+			if e208.Signer.SigningPubKey != nil {
+				c210.wire(enc{ST_VL, 3}, e208.Signer.SigningPubKey) // SigningPubKey
+			}
+			if !fw.ignoreSigningFields && e208.Signer.TxnSignature != nil && len((*e208.Signer.TxnSignature)) > 0 {
+				c210.wire(enc{ST_VL, 4}, e208.Signer.TxnSignature) // TxnSignature
+			}
+			c210.wire(enc{ST_ACCOUNT, 1}, &e208.Signer.Account) // Account
+			c209.close(&c210)
+			c209.end(endOfObject)
+			fw.close(&c209)
+		}
+		fw.end(endOfArray)
+	}
+	if len(t.TxBase.Memos) > 0 {
+		fw.header(enc{ST_ARRAY, 9}) // Memos
+		for i := range t.TxBase.Memos {
+			e211 := &t.TxBase.Memos[i]
+			c212 := fw.child()
+			c212.header(enc{ST_OBJECT, 10}) // Memo
+			c213 := c212.child()
+			if len(e211.Memo.MemoType) > 0 {
+				c213.wire(enc{ST_VL, 12}, &e211.Memo.MemoType) // MemoType
+			}
+			if len(e211.Memo.MemoData) > 0 {
+				c213.wire(enc{ST_VL, 13}, &e211.Memo.MemoData) // MemoData
+			}
+			if len(e211.Memo.MemoFormat) > 0 {
+				c213.wire(enc{ST_VL, 14}, &e211.Memo.MemoFormat) // MemoFormat
+			}
+			c212.close(&c213)
+			c212.end(endOfObject)
+			fw.close(&c212)
+		}
+		fw.end(endOfArray)
+	}
+}
+
+func (t *SetFee) decodeField(r Reader, e enc) (bool, error) {
+	switch e {
+	case enc{ST_UINT16, 2}: // TransactionType
+		v, err := readUint16(r)
+		t.TxBase.TransactionType = TransactionType(v)
+		return true, err
+	case enc{ST_UINT32, 2}: // Flags
+		t.TxBase.Flags = new(TransactionFlag)
+		v, err := readUint32(r)
+		*t.TxBase.Flags = TransactionFlag(v)
+		return true, err
+	case enc{ST_UINT32, 3}: // SourceTag
+		t.TxBase.SourceTag = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.SourceTag = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 4}: // Sequence
+		v, err := readUint32(r)
+		t.TxBase.Sequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 27}: // LastLedgerSequence
+		t.TxBase.LastLedgerSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.LastLedgerSequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 30}: // ReferenceFeeUnits
+		v, err := readUint32(r)
+		t.ReferenceFeeUnits = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 31}: // ReserveBase
+		v, err := readUint32(r)
+		t.ReserveBase = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 32}: // ReserveIncrement
+		v, err := readUint32(r)
+		t.ReserveIncrement = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 41}: // TicketSequence
+		t.TxBase.TicketSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.TicketSequence = uint32(v)
+		return true, err
+	case enc{ST_UINT64, 5}: // BaseFee
+		v, err := readUint64(r)
+		t.BaseFee = Uint64Hex(v)
+		return true, err
+	case enc{ST_HASH256, 5}: // PreviousTxnID
+		t.TxBase.PreviousTxnID = new(Hash256)
+		return true, t.TxBase.PreviousTxnID.Unmarshal(r)
+	case enc{ST_HASH256, 9}: // AccountTxnID
+		t.TxBase.AccountTxnID = new(Hash256)
+		return true, t.TxBase.AccountTxnID.Unmarshal(r)
+	case enc{ST_AMOUNT, 8}: // Fee
+		return true, t.TxBase.Fee.Unmarshal(r)
+	case enc{ST_VL, 3}: // SigningPubKey
+		t.TxBase.SigningPubKey = new(PublicKey)
+		return true, t.TxBase.SigningPubKey.Unmarshal(r)
+	case enc{ST_VL, 4}: // TxnSignature
+		t.TxBase.TxnSignature = new(VariableLength)
+		return true, t.TxBase.TxnSignature.Unmarshal(r)
+	case enc{ST_ACCOUNT, 1}: // Account
+		return true, t.TxBase.Account.Unmarshal(r)
+	}
+	return false, nil
+}
+
+func (t *SetRegularKey) encodeFields(fw *fieldWriter, nested bool) {
+	fw.setExtra(t.TxBase.Fields)
+	fw.uint16(enc{ST_UINT16, 2}, uint16(t.TxBase.TransactionType)) // TransactionType
+	if t.TxBase.Flags != nil {
+		fw.uint32(enc{ST_UINT32, 2}, uint32((*t.TxBase.Flags))) // Flags
+	}
+	if t.TxBase.SourceTag != nil {
+		fw.uint32(enc{ST_UINT32, 3}, uint32((*t.TxBase.SourceTag))) // SourceTag
+	}
+	fw.uint32(enc{ST_UINT32, 4}, uint32(t.TxBase.Sequence)) // Sequence
+	if t.TxBase.LastLedgerSequence != nil {
+		fw.uint32(enc{ST_UINT32, 27}, uint32((*t.TxBase.LastLedgerSequence))) // LastLedgerSequence
+	}
+	if t.TxBase.TicketSequence != nil {
+		fw.uint32(enc{ST_UINT32, 41}, uint32((*t.TxBase.TicketSequence))) // TicketSequence
+	}
+	if t.TxBase.PreviousTxnID != nil {
+		fw.wire(enc{ST_HASH256, 5}, t.TxBase.PreviousTxnID) // PreviousTxnID
+	}
+	if t.TxBase.AccountTxnID != nil {
+		fw.wire(enc{ST_HASH256, 9}, t.TxBase.AccountTxnID) // AccountTxnID
+	}
+	fw.wire(enc{ST_AMOUNT, 8}, &t.TxBase.Fee) // Fee
+	if t.TxBase.SigningPubKey != nil {
+		fw.wire(enc{ST_VL, 3}, t.TxBase.SigningPubKey) // SigningPubKey
+	}
+	if !fw.ignoreSigningFields && t.TxBase.TxnSignature != nil && len((*t.TxBase.TxnSignature)) > 0 {
+		fw.wire(enc{ST_VL, 4}, t.TxBase.TxnSignature) // TxnSignature
+	}
+	fw.wire(enc{ST_ACCOUNT, 1}, &t.TxBase.Account) // Account
+	if t.RegularKey != nil {
+		fw.wire(enc{ST_ACCOUNT, 8}, t.RegularKey) // RegularKey
+	}
+	if !fw.ignoreSigningFields && len(t.TxBase.Signers) > 0 {
+		fw.header(enc{ST_ARRAY, 3}) // Signers
+		for i := range t.TxBase.Signers {
+			e214 := &t.TxBase.Signers[i]
+			c215 := fw.child()
+			c215.header(enc{ST_OBJECT, 16}) // Signer
+			c216 := c215.child()
+			if e214.Signer.SigningPubKey != nil {
+				c216.wire(enc{ST_VL, 3}, e214.Signer.SigningPubKey) // SigningPubKey
+			}
+			if !fw.ignoreSigningFields && e214.Signer.TxnSignature != nil && len((*e214.Signer.TxnSignature)) > 0 {
+				c216.wire(enc{ST_VL, 4}, e214.Signer.TxnSignature) // TxnSignature
+			}
+			c216.wire(enc{ST_ACCOUNT, 1}, &e214.Signer.Account) // Account
+			c215.close(&c216)
+			c215.end(endOfObject)
+			fw.close(&c215)
+		}
+		fw.end(endOfArray)
+	}
+	if len(t.TxBase.Memos) > 0 {
+		fw.header(enc{ST_ARRAY, 9}) // Memos
+		for i := range t.TxBase.Memos {
+			e217 := &t.TxBase.Memos[i]
+			c218 := fw.child()
+			c218.header(enc{ST_OBJECT, 10}) // Memo
+			c219 := c218.child()
+			if len(e217.Memo.MemoType) > 0 {
+				c219.wire(enc{ST_VL, 12}, &e217.Memo.MemoType) // MemoType
+			}
+			if len(e217.Memo.MemoData) > 0 {
+				c219.wire(enc{ST_VL, 13}, &e217.Memo.MemoData) // MemoData
+			}
+			if len(e217.Memo.MemoFormat) > 0 {
+				c219.wire(enc{ST_VL, 14}, &e217.Memo.MemoFormat) // MemoFormat
+			}
+			c218.close(&c219)
+			c218.end(endOfObject)
+			fw.close(&c218)
+		}
+		fw.end(endOfArray)
+	}
+}
+
+func (t *SetRegularKey) decodeField(r Reader, e enc) (bool, error) {
+	switch e {
+	case enc{ST_UINT16, 2}: // TransactionType
+		v, err := readUint16(r)
+		t.TxBase.TransactionType = TransactionType(v)
+		return true, err
+	case enc{ST_UINT32, 2}: // Flags
+		t.TxBase.Flags = new(TransactionFlag)
+		v, err := readUint32(r)
+		*t.TxBase.Flags = TransactionFlag(v)
+		return true, err
+	case enc{ST_UINT32, 3}: // SourceTag
+		t.TxBase.SourceTag = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.SourceTag = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 4}: // Sequence
+		v, err := readUint32(r)
+		t.TxBase.Sequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 27}: // LastLedgerSequence
+		t.TxBase.LastLedgerSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.LastLedgerSequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 41}: // TicketSequence
+		t.TxBase.TicketSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.TicketSequence = uint32(v)
+		return true, err
+	case enc{ST_HASH256, 5}: // PreviousTxnID
+		t.TxBase.PreviousTxnID = new(Hash256)
+		return true, t.TxBase.PreviousTxnID.Unmarshal(r)
+	case enc{ST_HASH256, 9}: // AccountTxnID
+		t.TxBase.AccountTxnID = new(Hash256)
+		return true, t.TxBase.AccountTxnID.Unmarshal(r)
+	case enc{ST_AMOUNT, 8}: // Fee
+		return true, t.TxBase.Fee.Unmarshal(r)
+	case enc{ST_VL, 3}: // SigningPubKey
+		t.TxBase.SigningPubKey = new(PublicKey)
+		return true, t.TxBase.SigningPubKey.Unmarshal(r)
+	case enc{ST_VL, 4}: // TxnSignature
+		t.TxBase.TxnSignature = new(VariableLength)
+		return true, t.TxBase.TxnSignature.Unmarshal(r)
+	case enc{ST_ACCOUNT, 1}: // Account
+		return true, t.TxBase.Account.Unmarshal(r)
+	case enc{ST_ACCOUNT, 8}: // RegularKey
+		t.RegularKey = new(RegularKey)
+		return true, t.RegularKey.Unmarshal(r)
+	}
+	return false, nil
+}
+
+func (t *SignerList) encodeFields(fw *fieldWriter, nested bool) {
+	fw.setExtra(t.leBase.Fields)
+	if !nested {
+		fw.uint16(enc{ST_UINT16, 1}, uint16(t.leBase.LedgerEntryType)) // LedgerEntryType
+	}
+	if t.Flags != nil {
+		fw.uint32(enc{ST_UINT32, 2}, uint32((*t.Flags))) // Flags
+	}
+	if t.leBase.PreviousTxnLgrSeq != nil {
+		fw.uint32(enc{ST_UINT32, 5}, uint32((*t.leBase.PreviousTxnLgrSeq))) // PreviousTxnLgrSeq
+	}
+	if t.SignerQuorum != nil {
+		fw.uint32(enc{ST_UINT32, 35}, uint32((*t.SignerQuorum))) // SignerQuorum
+	}
+	if t.SignerListID != nil {
+		fw.uint32(enc{ST_UINT32, 38}, uint32((*t.SignerListID))) // SignerListID
+	}
+	if t.OwnerNode != nil {
+		fw.uint64(enc{ST_UINT64, 4}, uint64((*t.OwnerNode))) // OwnerNode
+	}
+	if t.leBase.PreviousTxnID != nil {
+		fw.wire(enc{ST_HASH256, 5}, t.leBase.PreviousTxnID) // PreviousTxnID
+	}
+	if len(t.SignerEntries) > 0 {
+		fw.header(enc{ST_ARRAY, 4}) // SignerEntries
+		for i := range t.SignerEntries {
+			e220 := &t.SignerEntries[i]
+			c221 := fw.child()
+			c221.header(enc{ST_OBJECT, 11}) // SignerEntry
+			c222 := c221.child()
+			if e220.SignerEntry.SignerWeight != nil {
+				c222.uint16(enc{ST_UINT16, 3}, uint16((*e220.SignerEntry.SignerWeight))) // SignerWeight
+			}
+			if e220.SignerEntry.Account != nil {
+				c222.wire(enc{ST_ACCOUNT, 1}, e220.SignerEntry.Account) // Account
+			}
+			c221.close(&c222)
+			c221.end(endOfObject)
+			fw.close(&c221)
+		}
+		fw.end(endOfArray)
+	}
+}
+
+func (t *SignerList) decodeField(r Reader, e enc) (bool, error) {
+	switch e {
+	case enc{ST_UINT16, 1}: // LedgerEntryType
+		v, err := readUint16(r)
+		t.leBase.LedgerEntryType = LedgerEntryType(v)
+		return true, err
+	case enc{ST_UINT32, 2}: // Flags
+		t.Flags = new(LedgerEntryFlag)
+		v, err := readUint32(r)
+		*t.Flags = LedgerEntryFlag(v)
+		return true, err
+	case enc{ST_UINT32, 5}: // PreviousTxnLgrSeq
+		t.leBase.PreviousTxnLgrSeq = new(uint32)
+		v, err := readUint32(r)
+		*t.leBase.PreviousTxnLgrSeq = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 35}: // SignerQuorum
+		t.SignerQuorum = new(uint32)
+		v, err := readUint32(r)
+		*t.SignerQuorum = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 38}: // SignerListID
+		t.SignerListID = new(uint32)
+		v, err := readUint32(r)
+		*t.SignerListID = uint32(v)
+		return true, err
+	case enc{ST_UINT64, 4}: // OwnerNode
+		t.OwnerNode = new(NodeIndex)
+		v, err := readUint64(r)
+		*t.OwnerNode = NodeIndex(v)
+		return true, err
+	case enc{ST_HASH256, 5}: // PreviousTxnID
+		t.leBase.PreviousTxnID = new(Hash256)
+		return true, t.leBase.PreviousTxnID.Unmarshal(r)
+	case enc{ST_HASH256, 6}: // LedgerIndex
+		t.leBase.LedgerIndex = new(Hash256)
+		return true, t.leBase.LedgerIndex.Unmarshal(r)
+	}
+	return false, nil
+}
+
+func (t *SignerListSet) encodeFields(fw *fieldWriter, nested bool) {
+	fw.setExtra(t.TxBase.Fields)
+	fw.uint16(enc{ST_UINT16, 2}, uint16(t.TxBase.TransactionType)) // TransactionType
+	if t.TxBase.Flags != nil {
+		fw.uint32(enc{ST_UINT32, 2}, uint32((*t.TxBase.Flags))) // Flags
+	}
+	if t.TxBase.SourceTag != nil {
+		fw.uint32(enc{ST_UINT32, 3}, uint32((*t.TxBase.SourceTag))) // SourceTag
+	}
+	fw.uint32(enc{ST_UINT32, 4}, uint32(t.TxBase.Sequence)) // Sequence
+	if t.TxBase.LastLedgerSequence != nil {
+		fw.uint32(enc{ST_UINT32, 27}, uint32((*t.TxBase.LastLedgerSequence))) // LastLedgerSequence
+	}
+	fw.uint32(enc{ST_UINT32, 35}, uint32(t.SignerQuorum)) // SignerQuorum
+	if t.TxBase.TicketSequence != nil {
+		fw.uint32(enc{ST_UINT32, 41}, uint32((*t.TxBase.TicketSequence))) // TicketSequence
+	}
+	if t.TxBase.PreviousTxnID != nil {
+		fw.wire(enc{ST_HASH256, 5}, t.TxBase.PreviousTxnID) // PreviousTxnID
+	}
+	if t.TxBase.AccountTxnID != nil {
+		fw.wire(enc{ST_HASH256, 9}, t.TxBase.AccountTxnID) // AccountTxnID
+	}
+	fw.wire(enc{ST_AMOUNT, 8}, &t.TxBase.Fee) // Fee
+	if t.TxBase.SigningPubKey != nil {
+		fw.wire(enc{ST_VL, 3}, t.TxBase.SigningPubKey) // SigningPubKey
+	}
+	if !fw.ignoreSigningFields && t.TxBase.TxnSignature != nil && len((*t.TxBase.TxnSignature)) > 0 {
+		fw.wire(enc{ST_VL, 4}, t.TxBase.TxnSignature) // TxnSignature
+	}
+	fw.wire(enc{ST_ACCOUNT, 1}, &t.TxBase.Account) // Account
+	if !fw.ignoreSigningFields && len(t.TxBase.Signers) > 0 {
+		fw.header(enc{ST_ARRAY, 3}) // Signers
+		for i := range t.TxBase.Signers {
+			e223 := &t.TxBase.Signers[i]
+			c224 := fw.child()
+			c224.header(enc{ST_OBJECT, 16}) // Signer
+			c225 := c224.child()
+			if e223.Signer.SigningPubKey != nil {
+				c225.wire(enc{ST_VL, 3}, e223.Signer.SigningPubKey) // SigningPubKey
+			}
+			if !fw.ignoreSigningFields && e223.Signer.TxnSignature != nil && len((*e223.Signer.TxnSignature)) > 0 {
+				c225.wire(enc{ST_VL, 4}, e223.Signer.TxnSignature) // TxnSignature
+			}
+			c225.wire(enc{ST_ACCOUNT, 1}, &e223.Signer.Account) // Account
+			c224.close(&c225)
+			c224.end(endOfObject)
+			fw.close(&c224)
+		}
+		fw.end(endOfArray)
+	}
+	if len(t.SignerEntries) > 0 {
+		fw.header(enc{ST_ARRAY, 4}) // SignerEntries
+		for i := range t.SignerEntries {
+			e229 := &t.SignerEntries[i]
+			c230 := fw.child()
+			c230.header(enc{ST_OBJECT, 11}) // SignerEntry
+			c231 := c230.child()
+			if e229.SignerEntry.SignerWeight != nil {
+				c231.uint16(enc{ST_UINT16, 3}, uint16((*e229.SignerEntry.SignerWeight))) // SignerWeight
+			}
+			if e229.SignerEntry.Account != nil {
+				c231.wire(enc{ST_ACCOUNT, 1}, e229.SignerEntry.Account) // Account
+			}
+			c230.close(&c231)
+			c230.end(endOfObject)
+			fw.close(&c230)
+		}
+		fw.end(endOfArray)
+	}
+	if len(t.TxBase.Memos) > 0 {
+		fw.header(enc{ST_ARRAY, 9}) // Memos
+		for i := range t.TxBase.Memos {
+			e226 := &t.TxBase.Memos[i]
+			c227 := fw.child()
+			c227.header(enc{ST_OBJECT, 10}) // Memo
+			c228 := c227.child()
+			if len(e226.Memo.MemoType) > 0 {
+				c228.wire(enc{ST_VL, 12}, &e226.Memo.MemoType) // MemoType
+			}
+			if len(e226.Memo.MemoData) > 0 {
+				c228.wire(enc{ST_VL, 13}, &e226.Memo.MemoData) // MemoData
+			}
+			if len(e226.Memo.MemoFormat) > 0 {
+				c228.wire(enc{ST_VL, 14}, &e226.Memo.MemoFormat) // MemoFormat
+			}
+			c227.close(&c228)
+			c227.end(endOfObject)
+			fw.close(&c227)
+		}
+		fw.end(endOfArray)
+	}
+}
+
+func (t *SignerListSet) decodeField(r Reader, e enc) (bool, error) {
+	switch e {
+	case enc{ST_UINT16, 2}: // TransactionType
+		v, err := readUint16(r)
+		t.TxBase.TransactionType = TransactionType(v)
+		return true, err
+	case enc{ST_UINT32, 2}: // Flags
+		t.TxBase.Flags = new(TransactionFlag)
+		v, err := readUint32(r)
+		*t.TxBase.Flags = TransactionFlag(v)
+		return true, err
+	case enc{ST_UINT32, 3}: // SourceTag
+		t.TxBase.SourceTag = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.SourceTag = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 4}: // Sequence
+		v, err := readUint32(r)
+		t.TxBase.Sequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 27}: // LastLedgerSequence
+		t.TxBase.LastLedgerSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.LastLedgerSequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 35}: // SignerQuorum
+		v, err := readUint32(r)
+		t.SignerQuorum = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 41}: // TicketSequence
+		t.TxBase.TicketSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.TicketSequence = uint32(v)
+		return true, err
+	case enc{ST_HASH256, 5}: // PreviousTxnID
+		t.TxBase.PreviousTxnID = new(Hash256)
+		return true, t.TxBase.PreviousTxnID.Unmarshal(r)
+	case enc{ST_HASH256, 9}: // AccountTxnID
+		t.TxBase.AccountTxnID = new(Hash256)
+		return true, t.TxBase.AccountTxnID.Unmarshal(r)
+	case enc{ST_AMOUNT, 8}: // Fee
+		return true, t.TxBase.Fee.Unmarshal(r)
+	case enc{ST_VL, 3}: // SigningPubKey
+		t.TxBase.SigningPubKey = new(PublicKey)
+		return true, t.TxBase.SigningPubKey.Unmarshal(r)
+	case enc{ST_VL, 4}: // TxnSignature
+		t.TxBase.TxnSignature = new(VariableLength)
+		return true, t.TxBase.TxnSignature.Unmarshal(r)
+	case enc{ST_ACCOUNT, 1}: // Account
+		return true, t.TxBase.Account.Unmarshal(r)
+	}
+	return false, nil
+}
+
+func (t *Ticket) encodeFields(fw *fieldWriter, nested bool) {
+	fw.setExtra(t.leBase.Fields)
+	if !nested {
+		fw.uint16(enc{ST_UINT16, 1}, uint16(t.leBase.LedgerEntryType)) // LedgerEntryType
+	}
+	if t.Flags != nil {
+		fw.uint32(enc{ST_UINT32, 2}, uint32((*t.Flags))) // Flags
+	}
+	if t.leBase.PreviousTxnLgrSeq != nil {
+		fw.uint32(enc{ST_UINT32, 5}, uint32((*t.leBase.PreviousTxnLgrSeq))) // PreviousTxnLgrSeq
+	}
+	if t.TicketSequence != nil {
+		fw.uint32(enc{ST_UINT32, 41}, uint32((*t.TicketSequence))) // TicketSequence
+	}
+	if t.OwnerNode != nil {
+		fw.uint64(enc{ST_UINT64, 4}, uint64((*t.OwnerNode))) // OwnerNode
+	}
+	if t.leBase.PreviousTxnID != nil {
+		fw.wire(enc{ST_HASH256, 5}, t.leBase.PreviousTxnID) // PreviousTxnID
+	}
+	if t.Account != nil {
+		fw.wire(enc{ST_ACCOUNT, 1}, t.Account) // Account
+	}
+}
+
+func (t *Ticket) decodeField(r Reader, e enc) (bool, error) {
+	switch e {
+	case enc{ST_UINT16, 1}: // LedgerEntryType
+		v, err := readUint16(r)
+		t.leBase.LedgerEntryType = LedgerEntryType(v)
+		return true, err
+	case enc{ST_UINT32, 2}: // Flags
+		t.Flags = new(LedgerEntryFlag)
+		v, err := readUint32(r)
+		*t.Flags = LedgerEntryFlag(v)
+		return true, err
+	case enc{ST_UINT32, 5}: // PreviousTxnLgrSeq
+		t.leBase.PreviousTxnLgrSeq = new(uint32)
+		v, err := readUint32(r)
+		*t.leBase.PreviousTxnLgrSeq = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 41}: // TicketSequence
+		t.TicketSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.TicketSequence = uint32(v)
+		return true, err
+	case enc{ST_UINT64, 4}: // OwnerNode
+		t.OwnerNode = new(NodeIndex)
+		v, err := readUint64(r)
+		*t.OwnerNode = NodeIndex(v)
+		return true, err
+	case enc{ST_HASH256, 5}: // PreviousTxnID
+		t.leBase.PreviousTxnID = new(Hash256)
+		return true, t.leBase.PreviousTxnID.Unmarshal(r)
+	case enc{ST_HASH256, 6}: // LedgerIndex
+		t.leBase.LedgerIndex = new(Hash256)
+		return true, t.leBase.LedgerIndex.Unmarshal(r)
+	case enc{ST_ACCOUNT, 1}: // Account
+		t.Account = new(Account)
+		return true, t.Account.Unmarshal(r)
+	}
+	return false, nil
+}
+
+func (t *TicketCreate) encodeFields(fw *fieldWriter, nested bool) {
+	fw.setExtra(t.TxBase.Fields)
+	fw.uint16(enc{ST_UINT16, 2}, uint16(t.TxBase.TransactionType)) // TransactionType
+	if t.TxBase.Flags != nil {
+		fw.uint32(enc{ST_UINT32, 2}, uint32((*t.TxBase.Flags))) // Flags
+	}
+	if t.TxBase.SourceTag != nil {
+		fw.uint32(enc{ST_UINT32, 3}, uint32((*t.TxBase.SourceTag))) // SourceTag
+	}
+	fw.uint32(enc{ST_UINT32, 4}, uint32(t.TxBase.Sequence)) // Sequence
+	if t.TxBase.LastLedgerSequence != nil {
+		fw.uint32(enc{ST_UINT32, 27}, uint32((*t.TxBase.LastLedgerSequence))) // LastLedgerSequence
+	}
+	fw.uint32(enc{ST_UINT32, 40}, uint32(t.TicketCount)) // TicketCount
+	if t.TxBase.TicketSequence != nil {
+		fw.uint32(enc{ST_UINT32, 41}, uint32((*t.TxBase.TicketSequence))) // TicketSequence
+	}
+	if t.TxBase.PreviousTxnID != nil {
+		fw.wire(enc{ST_HASH256, 5}, t.TxBase.PreviousTxnID) // PreviousTxnID
+	}
+	if t.TxBase.AccountTxnID != nil {
+		fw.wire(enc{ST_HASH256, 9}, t.TxBase.AccountTxnID) // AccountTxnID
+	}
+	fw.wire(enc{ST_AMOUNT, 8}, &t.TxBase.Fee) // Fee
+	if t.TxBase.SigningPubKey != nil {
+		fw.wire(enc{ST_VL, 3}, t.TxBase.SigningPubKey) // SigningPubKey
+	}
+	if !fw.ignoreSigningFields && t.TxBase.TxnSignature != nil && len((*t.TxBase.TxnSignature)) > 0 {
+		fw.wire(enc{ST_VL, 4}, t.TxBase.TxnSignature) // TxnSignature
+	}
+	fw.wire(enc{ST_ACCOUNT, 1}, &t.TxBase.Account) // Account
+	if !fw.ignoreSigningFields && len(t.TxBase.Signers) > 0 {
+		fw.header(enc{ST_ARRAY, 3}) // Signers
+		for i := range t.TxBase.Signers {
+			e232 := &t.TxBase.Signers[i]
+			c233 := fw.child()
+			c233.header(enc{ST_OBJECT, 16}) // Signer
+			c234 := c233.child()
+			if e232.Signer.SigningPubKey != nil {
+				c234.wire(enc{ST_VL, 3}, e232.Signer.SigningPubKey) // SigningPubKey
+			}
+			if !fw.ignoreSigningFields && e232.Signer.TxnSignature != nil && len((*e232.Signer.TxnSignature)) > 0 {
+				c234.wire(enc{ST_VL, 4}, e232.Signer.TxnSignature) // TxnSignature
+			}
+			c234.wire(enc{ST_ACCOUNT, 1}, &e232.Signer.Account) // Account
+			c233.close(&c234)
+			c233.end(endOfObject)
+			fw.close(&c233)
+		}
+		fw.end(endOfArray)
+	}
+	if len(t.TxBase.Memos) > 0 {
+		fw.header(enc{ST_ARRAY, 9}) // Memos
+		for i := range t.TxBase.Memos {
+			e235 := &t.TxBase.Memos[i]
+			c236 := fw.child()
+			c236.header(enc{ST_OBJECT, 10}) // Memo
+			c237 := c236.child()
+			if len(e235.Memo.MemoType) > 0 {
+				c237.wire(enc{ST_VL, 12}, &e235.Memo.MemoType) // MemoType
+			}
+			if len(e235.Memo.MemoData) > 0 {
+				c237.wire(enc{ST_VL, 13}, &e235.Memo.MemoData) // MemoData
+			}
+			if len(e235.Memo.MemoFormat) > 0 {
+				c237.wire(enc{ST_VL, 14}, &e235.Memo.MemoFormat) // MemoFormat
+			}
+			c236.close(&c237)
+			c236.end(endOfObject)
+			fw.close(&c236)
+		}
+		fw.end(endOfArray)
+	}
+}
+
+func (t *TicketCreate) decodeField(r Reader, e enc) (bool, error) {
+	switch e {
+	case enc{ST_UINT16, 2}: // TransactionType
+		v, err := readUint16(r)
+		t.TxBase.TransactionType = TransactionType(v)
+		return true, err
+	case enc{ST_UINT32, 2}: // Flags
+		t.TxBase.Flags = new(TransactionFlag)
+		v, err := readUint32(r)
+		*t.TxBase.Flags = TransactionFlag(v)
+		return true, err
+	case enc{ST_UINT32, 3}: // SourceTag
+		t.TxBase.SourceTag = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.SourceTag = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 4}: // Sequence
+		v, err := readUint32(r)
+		t.TxBase.Sequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 27}: // LastLedgerSequence
+		t.TxBase.LastLedgerSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.LastLedgerSequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 40}: // TicketCount
+		v, err := readUint32(r)
+		t.TicketCount = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 41}: // TicketSequence
+		t.TxBase.TicketSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.TicketSequence = uint32(v)
+		return true, err
+	case enc{ST_HASH256, 5}: // PreviousTxnID
+		t.TxBase.PreviousTxnID = new(Hash256)
+		return true, t.TxBase.PreviousTxnID.Unmarshal(r)
+	case enc{ST_HASH256, 9}: // AccountTxnID
+		t.TxBase.AccountTxnID = new(Hash256)
+		return true, t.TxBase.AccountTxnID.Unmarshal(r)
+	case enc{ST_AMOUNT, 8}: // Fee
+		return true, t.TxBase.Fee.Unmarshal(r)
+	case enc{ST_VL, 3}: // SigningPubKey
+		t.TxBase.SigningPubKey = new(PublicKey)
+		return true, t.TxBase.SigningPubKey.Unmarshal(r)
+	case enc{ST_VL, 4}: // TxnSignature
+		t.TxBase.TxnSignature = new(VariableLength)
+		return true, t.TxBase.TxnSignature.Unmarshal(r)
+	case enc{ST_ACCOUNT, 1}: // Account
+		return true, t.TxBase.Account.Unmarshal(r)
+	}
+	return false, nil
+}
+
+func (t *TrustSet) encodeFields(fw *fieldWriter, nested bool) {
+	fw.setExtra(t.TxBase.Fields)
+	fw.uint16(enc{ST_UINT16, 2}, uint16(t.TxBase.TransactionType)) // TransactionType
+	if t.TxBase.Flags != nil {
+		fw.uint32(enc{ST_UINT32, 2}, uint32((*t.TxBase.Flags))) // Flags
+	}
+	if t.TxBase.SourceTag != nil {
+		fw.uint32(enc{ST_UINT32, 3}, uint32((*t.TxBase.SourceTag))) // SourceTag
+	}
+	fw.uint32(enc{ST_UINT32, 4}, uint32(t.TxBase.Sequence)) // Sequence
+	if t.QualityIn != nil {
+		fw.uint32(enc{ST_UINT32, 20}, uint32((*t.QualityIn))) // QualityIn
+	}
+	if t.QualityOut != nil {
+		fw.uint32(enc{ST_UINT32, 21}, uint32((*t.QualityOut))) // QualityOut
+	}
+	if t.TxBase.LastLedgerSequence != nil {
+		fw.uint32(enc{ST_UINT32, 27}, uint32((*t.TxBase.LastLedgerSequence))) // LastLedgerSequence
+	}
+	if t.TxBase.TicketSequence != nil {
+		fw.uint32(enc{ST_UINT32, 41}, uint32((*t.TxBase.TicketSequence))) // TicketSequence
+	}
+	if t.TxBase.PreviousTxnID != nil {
+		fw.wire(enc{ST_HASH256, 5}, t.TxBase.PreviousTxnID) // PreviousTxnID
+	}
+	if t.TxBase.AccountTxnID != nil {
+		fw.wire(enc{ST_HASH256, 9}, t.TxBase.AccountTxnID) // AccountTxnID
+	}
+	fw.wire(enc{ST_AMOUNT, 3}, &t.LimitAmount) // LimitAmount
+	fw.wire(enc{ST_AMOUNT, 8}, &t.TxBase.Fee)  // Fee
+	if t.TxBase.SigningPubKey != nil {
+		fw.wire(enc{ST_VL, 3}, t.TxBase.SigningPubKey) // SigningPubKey
+	}
+	if !fw.ignoreSigningFields && t.TxBase.TxnSignature != nil && len((*t.TxBase.TxnSignature)) > 0 {
+		fw.wire(enc{ST_VL, 4}, t.TxBase.TxnSignature) // TxnSignature
+	}
+	fw.wire(enc{ST_ACCOUNT, 1}, &t.TxBase.Account) // Account
+	if !fw.ignoreSigningFields && len(t.TxBase.Signers) > 0 {
+		fw.header(enc{ST_ARRAY, 3}) // Signers
+		for i := range t.TxBase.Signers {
+			e238 := &t.TxBase.Signers[i]
+			c239 := fw.child()
+			c239.header(enc{ST_OBJECT, 16}) // Signer
+			c240 := c239.child()
+			if e238.Signer.SigningPubKey != nil {
+				c240.wire(enc{ST_VL, 3}, e238.Signer.SigningPubKey) // SigningPubKey
+			}
+			if !fw.ignoreSigningFields && e238.Signer.TxnSignature != nil && len((*e238.Signer.TxnSignature)) > 0 {
+				c240.wire(enc{ST_VL, 4}, e238.Signer.TxnSignature) // TxnSignature
+			}
+			c240.wire(enc{ST_ACCOUNT, 1}, &e238.Signer.Account) // Account
+			c239.close(&c240)
+			c239.end(endOfObject)
+			fw.close(&c239)
+		}
+		fw.end(endOfArray)
+	}
+	if len(t.TxBase.Memos) > 0 {
+		fw.header(enc{ST_ARRAY, 9}) // Memos
+		for i := range t.TxBase.Memos {
+			e241 := &t.TxBase.Memos[i]
+			c242 := fw.child()
+			c242.header(enc{ST_OBJECT, 10}) // Memo
+			c243 := c242.child()
+			if len(e241.Memo.MemoType) > 0 {
+				c243.wire(enc{ST_VL, 12}, &e241.Memo.MemoType) // MemoType
+			}
+			if len(e241.Memo.MemoData) > 0 {
+				c243.wire(enc{ST_VL, 13}, &e241.Memo.MemoData) // MemoData
+			}
+			if len(e241.Memo.MemoFormat) > 0 {
+				c243.wire(enc{ST_VL, 14}, &e241.Memo.MemoFormat) // MemoFormat
+			}
+			c242.close(&c243)
+			c242.end(endOfObject)
+			fw.close(&c242)
+		}
+		fw.end(endOfArray)
+	}
+}
+
+func (t *TrustSet) decodeField(r Reader, e enc) (bool, error) {
+	switch e {
+	case enc{ST_UINT16, 2}: // TransactionType
+		v, err := readUint16(r)
+		t.TxBase.TransactionType = TransactionType(v)
+		return true, err
+	case enc{ST_UINT32, 2}: // Flags
+		t.TxBase.Flags = new(TransactionFlag)
+		v, err := readUint32(r)
+		*t.TxBase.Flags = TransactionFlag(v)
+		return true, err
+	case enc{ST_UINT32, 3}: // SourceTag
+		t.TxBase.SourceTag = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.SourceTag = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 4}: // Sequence
+		v, err := readUint32(r)
+		t.TxBase.Sequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 20}: // QualityIn
+		t.QualityIn = new(uint32)
+		v, err := readUint32(r)
+		*t.QualityIn = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 21}: // QualityOut
+		t.QualityOut = new(uint32)
+		v, err := readUint32(r)
+		*t.QualityOut = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 27}: // LastLedgerSequence
+		t.TxBase.LastLedgerSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.LastLedgerSequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 41}: // TicketSequence
+		t.TxBase.TicketSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.TicketSequence = uint32(v)
+		return true, err
+	case enc{ST_HASH256, 5}: // PreviousTxnID
+		t.TxBase.PreviousTxnID = new(Hash256)
+		return true, t.TxBase.PreviousTxnID.Unmarshal(r)
+	case enc{ST_HASH256, 9}: // AccountTxnID
+		t.TxBase.AccountTxnID = new(Hash256)
+		return true, t.TxBase.AccountTxnID.Unmarshal(r)
+	case enc{ST_AMOUNT, 3}: // LimitAmount
+		return true, t.LimitAmount.Unmarshal(r)
+	case enc{ST_AMOUNT, 8}: // Fee
+		return true, t.TxBase.Fee.Unmarshal(r)
+	case enc{ST_VL, 3}: // SigningPubKey
+		t.TxBase.SigningPubKey = new(PublicKey)
+		return true, t.TxBase.SigningPubKey.Unmarshal(r)
+	case enc{ST_VL, 4}: // TxnSignature
+		t.TxBase.TxnSignature = new(VariableLength)
+		return true, t.TxBase.TxnSignature.Unmarshal(r)
+	case enc{ST_ACCOUNT, 1}: // Account
+		return true, t.TxBase.Account.Unmarshal(r)
+	}
+	return false, nil
+}
+
+func (t *UnknowTx) encodeFields(fw *fieldWriter, nested bool) {
+	fw.setExtra(t.TxBase.Fields)
+	fw.uint16(enc{ST_UINT16, 2}, uint16(t.TxBase.TransactionType)) // TransactionType
+	if t.TxBase.Flags != nil {
+		fw.uint32(enc{ST_UINT32, 2}, uint32((*t.TxBase.Flags))) // Flags
+	}
+	if t.TxBase.SourceTag != nil {
+		fw.uint32(enc{ST_UINT32, 3}, uint32((*t.TxBase.SourceTag))) // SourceTag
+	}
+	fw.uint32(enc{ST_UINT32, 4}, uint32(t.TxBase.Sequence)) // Sequence
+	if t.TxBase.LastLedgerSequence != nil {
+		fw.uint32(enc{ST_UINT32, 27}, uint32((*t.TxBase.LastLedgerSequence))) // LastLedgerSequence
+	}
+	if t.TxBase.TicketSequence != nil {
+		fw.uint32(enc{ST_UINT32, 41}, uint32((*t.TxBase.TicketSequence))) // TicketSequence
+	}
+	if t.TxBase.PreviousTxnID != nil {
+		fw.wire(enc{ST_HASH256, 5}, t.TxBase.PreviousTxnID) // PreviousTxnID
+	}
+	if t.TxBase.AccountTxnID != nil {
+		fw.wire(enc{ST_HASH256, 9}, t.TxBase.AccountTxnID) // AccountTxnID
+	}
+	fw.wire(enc{ST_AMOUNT, 8}, &t.TxBase.Fee) // Fee
+	if t.TxBase.SigningPubKey != nil {
+		fw.wire(enc{ST_VL, 3}, t.TxBase.SigningPubKey) // SigningPubKey
+	}
+	if !fw.ignoreSigningFields && t.TxBase.TxnSignature != nil && len((*t.TxBase.TxnSignature)) > 0 {
+		fw.wire(enc{ST_VL, 4}, t.TxBase.TxnSignature) // TxnSignature
+	}
+	fw.wire(enc{ST_ACCOUNT, 1}, &t.TxBase.Account) // Account
+	if !fw.ignoreSigningFields && len(t.TxBase.Signers) > 0 {
+		fw.header(enc{ST_ARRAY, 3}) // Signers
+		for i := range t.TxBase.Signers {
+			e244 := &t.TxBase.Signers[i]
+			c245 := fw.child()
+			c245.header(enc{ST_OBJECT, 16}) // Signer
+			c246 := c245.child()
+			if e244.Signer.SigningPubKey != nil {
+				c246.wire(enc{ST_VL, 3}, e244.Signer.SigningPubKey) // SigningPubKey
+			}
+			if !fw.ignoreSigningFields && e244.Signer.TxnSignature != nil && len((*e244.Signer.TxnSignature)) > 0 {
+				c246.wire(enc{ST_VL, 4}, e244.Signer.TxnSignature) // TxnSignature
+			}
+			c246.wire(enc{ST_ACCOUNT, 1}, &e244.Signer.Account) // Account
+			c245.close(&c246)
+			c245.end(endOfObject)
+			fw.close(&c245)
+		}
+		fw.end(endOfArray)
+	}
+	if len(t.TxBase.Memos) > 0 {
+		fw.header(enc{ST_ARRAY, 9}) // Memos
+		for i := range t.TxBase.Memos {
+			e247 := &t.TxBase.Memos[i]
+			c248 := fw.child()
+			c248.header(enc{ST_OBJECT, 10}) // Memo
+			c249 := c248.child()
+			if len(e247.Memo.MemoType) > 0 {
+				c249.wire(enc{ST_VL, 12}, &e247.Memo.MemoType) // MemoType
+			}
+			if len(e247.Memo.MemoData) > 0 {
+				c249.wire(enc{ST_VL, 13}, &e247.Memo.MemoData) // MemoData
+			}
+			if len(e247.Memo.MemoFormat) > 0 {
+				c249.wire(enc{ST_VL, 14}, &e247.Memo.MemoFormat) // MemoFormat
+			}
+			c248.close(&c249)
+			c248.end(endOfObject)
+			fw.close(&c248)
+		}
+		fw.end(endOfArray)
+	}
+}
+
+func (t *UnknowTx) decodeField(r Reader, e enc) (bool, error) {
+	switch e {
+	case enc{ST_UINT16, 2}: // TransactionType
+		v, err := readUint16(r)
+		t.TxBase.TransactionType = TransactionType(v)
+		return true, err
+	case enc{ST_UINT32, 2}: // Flags
+		t.TxBase.Flags = new(TransactionFlag)
+		v, err := readUint32(r)
+		*t.TxBase.Flags = TransactionFlag(v)
+		return true, err
+	case enc{ST_UINT32, 3}: // SourceTag
+		t.TxBase.SourceTag = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.SourceTag = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 4}: // Sequence
+		v, err := readUint32(r)
+		t.TxBase.Sequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 27}: // LastLedgerSequence
+		t.TxBase.LastLedgerSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.LastLedgerSequence = uint32(v)
+		return true, err
+	case enc{ST_UINT32, 41}: // TicketSequence
+		t.TxBase.TicketSequence = new(uint32)
+		v, err := readUint32(r)
+		*t.TxBase.TicketSequence = uint32(v)
+		return true, err
+	case enc{ST_HASH256, 5}: // PreviousTxnID
+		t.TxBase.PreviousTxnID = new(Hash256)
+		return true, t.TxBase.PreviousTxnID.Unmarshal(r)
+	case enc{ST_HASH256, 9}: // AccountTxnID
+		t.TxBase.AccountTxnID = new(Hash256)
+		return true, t.TxBase.AccountTxnID.Unmarshal(r)
+	case enc{ST_AMOUNT, 8}: // Fee
+		return true, t.TxBase.Fee.Unmarshal(r)
+	case enc{ST_VL, 3}: // SigningPubKey
+		t.TxBase.SigningPubKey = new(PublicKey)
+		return true, t.TxBase.SigningPubKey.Unmarshal(r)
+	case enc{ST_VL, 4}: // TxnSignature
+		t.TxBase.TxnSignature = new(VariableLength)
+		return true, t.TxBase.TxnSignature.Unmarshal(r)
+	case enc{ST_ACCOUNT, 1}: // Account
+		return true, t.TxBase.Account.Unmarshal(r)
+	}
+	return false, nil
+}
@@ -0,0 +1,256 @@
+package data
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/goodwood511/ripple_lib/ripple-sdk/crypto"
+)
+
+// testdata/ledger.json holds made up transactions of the common types, as
+// the ledger command returns them, signed with the seeds of the other
+// tests, among them a multisigned payment. Its hashes are this package's
+// own, so it only checks the codecs against each other, see
+// loadRippledTransactions for transactions which rippled serialized.
+func loadLedgerFixture(tb testing.TB) TransactionSlice {
+	b, err := os.ReadFile(filepath.Join("testdata", "ledger.json"))
+	if err != nil {
+		tb.Fatal(err)
+	}
+	var fixture struct {
+		Ledger struct {
+			Transactions TransactionSlice `json:"transactions"`
+		} `json:"ledger"`
+	}
+	if err := json.Unmarshal(b, &fixture); err != nil {
+		tb.Fatal(err)
+	}
+	if len(fixture.Ledger.Transactions) == 0 {
+		tb.Fatal("no transactions in testdata/ledger.json")
+	}
+	return fixture.Ledger.Transactions
+}
+
+// rippledTransaction is a signed transaction as rippled returns it with
+// binary set, with its hash
+type rippledTransaction struct {
+	Source string  `json:"source"`
+	Hash   Hash256 `json:"hash"`
+	TxBlob string  `json:"tx_blob"`
+}
+
+// testdata/rippled_transactions.json holds transactions which were
+// submitted to the network, with the hashes that were published for them
+func loadRippledTransactions(tb testing.TB) []rippledTransaction {
+	b, err := os.ReadFile(filepath.Join("testdata", "rippled_transactions.json"))
+	if err != nil {
+		tb.Fatal(err)
+	}
+	var txs []rippledTransaction
+	if err := json.Unmarshal(b, &txs); err != nil {
+		tb.Fatal(err)
+	}
+	if len(txs) == 0 {
+		tb.Fatal("no transactions in testdata/rippled_transactions.json")
+	}
+	return txs
+}
+
+// useGeneratedCodec selects the generated codec or reflection for the rest
+// of the test, restoring the codec however the test ends
+func useGeneratedCodec(tb testing.TB, generated bool) {
+	previous := GeneratedCodec
+	GeneratedCodec = generated
+	tb.Cleanup(func() { GeneratedCodec = previous })
+}
+
+// rawWith encodes h with the generated codec or with reflection
+func rawWith(tb testing.TB, generated bool, h Hashable) (Hash256, []byte) {
+	previous := GeneratedCodec
+	GeneratedCodec = generated
+	defer func() { GeneratedCodec = previous }()
+	hash, raw, err := Raw(h)
+	if err != nil {
+		tb.Fatalf("generated %v: %v", generated, err)
+	}
+	return hash, raw
+}
+
+func TestGeneratedCodecMatchesReflection(t *testing.T) {
+	for _, txm := range loadLedgerFixture(t) {
+		name := txm.GetType() + " " + txm.GetHash().String()
+		hash, want := rawWith(t, false, txm.Transaction)
+		generatedHash, got := rawWith(t, true, txm.Transaction)
+		if !bytes.Equal(got, want) || generatedHash != hash {
+			t.Errorf("%s: generated\n%X\nreflection\n%X", name, got, want)
+		}
+		if hash != *txm.GetHash() {
+			t.Errorf("%s: hash is %s", name, hash)
+		}
+
+		_, wantNode := rawWith(t, false, txm)
+		_, gotNode := rawWith(t, true, txm)
+		if !bytes.Equal(gotNode, wantNode) {
+			t.Errorf("%s: node generated\n%X\nreflection\n%X", name, gotNode, wantNode)
+		}
+
+		for _, generated := range []bool{false, true} {
+			useGeneratedCodec(t, generated)
+			signing, _, err := SigningHash(txm.Transaction.(Signer))
+			if err != nil {
+				t.Fatal(err)
+			}
+			read, err := ReadTransaction(NewStrictReader(bytes.NewReader(want), DefaultDecodeLimits))
+			if err != nil {
+				t.Fatalf("%s generated %v: %v", name, generated, err)
+			}
+			readSigning, _, err := SigningHash(read.(Signer))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if readSigning != signing {
+				t.Errorf("%s generated %v: signing hash %s, read back %s", name, generated, signing, readSigning)
+			}
+			if _, again := rawWith(t, generated, read); !bytes.Equal(again, want) {
+				t.Errorf("%s generated %v: read back as\n%X\nwant\n%X", name, generated, again, want)
+			}
+		}
+	}
+}
+
+// Both codecs must give back rippled's blobs and hashes
+func TestRippledTransactions(t *testing.T) {
+	for _, rippled := range loadRippledTransactions(t) {
+		blob := mustDecodeHex(t, rippled.TxBlob)
+		// The transaction id, without this package's encoder
+		if id := crypto.Sha512Half(append(HP_TRANSACTION_ID.Bytes(), blob...)); !bytes.Equal(id, rippled.Hash[:]) {
+			t.Fatalf("%s: blob is not that of %s", rippled.Source, rippled.Hash)
+		}
+		for _, generated := range []bool{false, true} {
+			useGeneratedCodec(t, generated)
+			name := fmt.Sprintf("%s generated %v", rippled.Source, generated)
+			tx, err := ReadTransaction(NewStrictReader(bytes.NewReader(blob), DefaultDecodeLimits))
+			if err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+			hash, raw, err := Raw(tx)
+			if err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+			if !bytes.Equal(raw, blob) || hash != rippled.Hash {
+				t.Errorf("%s: hash %s of\n%X\nwant %s of\n%X", name, hash, raw, rippled.Hash, blob)
+			}
+			if ok, err := CheckSignature(tx.(Signer)); err != nil || !ok {
+				t.Errorf("%s: signature is not valid: %v", name, err)
+			}
+
+			j, err := json.Marshal(tx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var txm TransactionWithMetaData
+			if err := json.Unmarshal(j, &txm); err != nil {
+				t.Fatalf("%s: %v in %s", name, err, j)
+			}
+			if hash, raw, err = Raw(txm.Transaction); err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(raw, blob) || hash != rippled.Hash {
+				t.Errorf("%s: JSON round trip of %s gives %s", name, j, hash)
+			}
+		}
+	}
+}
+
+// codec_gen.go must be what go generate writes for the types as they are
+func TestCodecGenUpToDate(t *testing.T) {
+	if testing.Short() {
+		t.Skip("runs the generator")
+	}
+	goTool, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("no go tool")
+	}
+	out := filepath.Join(t.TempDir(), "codec_gen.go")
+	cmd := exec.Command(goTool, "run", "-tags", "codecgen", "./internal/codecgen", "-o", out)
+	if b, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("%v: %s", err, b)
+	}
+	generated, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	current, err := os.ReadFile("codec_gen.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(generated, current) {
+		t.Error("codec_gen.go is out of date, run go generate in ripple-sdk/data")
+	}
+}
+
+func benchmarkCodec(b *testing.B, f func(b *testing.B, txs TransactionSlice, raws [][]byte)) {
+	txs := loadLedgerFixture(b)
+	var raws [][]byte
+	for _, txm := range txs {
+		_, raw := rawWith(b, true, txm.Transaction)
+		raws = append(raws, raw)
+	}
+	for _, rippled := range loadRippledTransactions(b) {
+		blob := mustDecodeHex(b, rippled.TxBlob)
+		tx, err := ReadTransaction(bytes.NewReader(blob))
+		if err != nil {
+			b.Fatal(err)
+		}
+		txs = append(txs, &TransactionWithMetaData{Transaction: tx})
+		raws = append(raws, blob)
+	}
+	for _, generated := range []bool{true, false} {
+		name := "reflection"
+		if generated {
+			name = "generated"
+		}
+		b.Run(name, func(b *testing.B) {
+			useGeneratedCodec(b, generated)
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				f(b, txs, raws)
+			}
+		})
+	}
+}
+
+func BenchmarkRaw(b *testing.B) {
+	benchmarkCodec(b, func(b *testing.B, txs TransactionSlice, _ [][]byte) {
+		for _, txm := range txs {
+			if _, _, err := Raw(txm.Transaction); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func BenchmarkSigningHash(b *testing.B) {
+	benchmarkCodec(b, func(b *testing.B, txs TransactionSlice, _ [][]byte) {
+		for _, txm := range txs {
+			if _, _, err := SigningHash(txm.Transaction.(Signer)); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func BenchmarkReadTransaction(b *testing.B) {
+	benchmarkCodec(b, func(b *testing.B, _ TransactionSlice, raws [][]byte) {
+		for _, raw := range raws {
+			if _, err := ReadTransaction(bytes.NewReader(raw)); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
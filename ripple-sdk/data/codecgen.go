@@ -0,0 +1,375 @@
+//go:build codecgen
+
+package data
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// GenerateCodec writes codec_gen.go. The encoders follow getFields and the
+// decoders follow readObject, so that they read and write the same bytes.
+func GenerateCodec(w io.Writer) error {
+	g := &codecGen{generated: make(map[reflect.Type]bool)}
+	for _, t := range codecTypes() {
+		g.generated[t] = true
+	}
+	g.printf("// Code generated by codecgen; DO NOT EDIT.\n\n//go:build !codecgen\n\npackage data\n")
+	for _, t := range codecTypes() {
+		if err := g.encoder(t); err != nil {
+			return err
+		}
+		if err := g.decoder(t); err != nil {
+			return err
+		}
+	}
+	src, err := format.Source(g.buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("codecgen: %s\n%s", err, g.buf.Bytes())
+	}
+	_, err = w.Write(src)
+	return err
+}
+
+// codecTypes are those with a factory, other than UnknowLedger, which is
+// written as it was read, along with the multisigned transactions and
+// metadata
+func codecTypes() []reflect.Type {
+	seen := make(map[reflect.Type]bool)
+	var types []reflect.Type
+	add := func(v interface{}) {
+		t := reflect.TypeOf(v).Elem()
+		if !seen[t] {
+			seen[t] = true
+			types = append(types, t)
+		}
+	}
+	for _, f := range TxFactory {
+		if f != nil {
+			add(f())
+		}
+	}
+	for _, f := range LedgerEntryFactory {
+		if f != nil {
+			if _, ok := f().(*UnknowLedger); !ok {
+				add(f())
+			}
+		}
+	}
+	add(&MultiSignPayment{})
+	add(&MultiSignerListSet{})
+	add(&MetaData{})
+	add(&AffectedNode{})
+	sort.Slice(types, func(i, j int) bool { return types[i].Name() < types[j].Name() })
+	return types
+}
+
+type codecGen struct {
+	buf       bytes.Buffer
+	generated map[reflect.Type]bool
+	vars      int
+}
+
+func (g *codecGen) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+// newVar returns a name for a writer or element which is not in use
+func (g *codecGen) newVar(prefix string) string {
+	g.vars++
+	return fmt.Sprintf("%s%d", prefix, g.vars)
+}
+
+var (
+	wireType      = reflect.TypeOf((*Wire)(nil)).Elem()
+	ledgerEntryIf = reflect.TypeOf((*LedgerEntry)(nil)).Elem()
+)
+
+var stNames = map[uint8]string{
+	ST_UINT16:        "ST_UINT16",
+	ST_UINT32:        "ST_UINT32",
+	ST_UINT64:        "ST_UINT64",
+	ST_HASH128:       "ST_HASH128",
+	ST_HASH256:       "ST_HASH256",
+	ST_AMOUNT:        "ST_AMOUNT",
+	ST_VL:            "ST_VL",
+	ST_ACCOUNT:       "ST_ACCOUNT",
+	ST_OBJECT:        "ST_OBJECT",
+	ST_ARRAY:         "ST_ARRAY",
+	ST_UINT8:         "ST_UINT8",
+	ST_HASH160:       "ST_HASH160",
+	ST_PATHSET:       "ST_PATHSET",
+	ST_VECTOR256:     "ST_VECTOR256",
+	ST_ISSUE:         "ST_ISSUE",
+	ST_NUMBER:        "ST_NUMBER",
+	ST_INT32:         "ST_INT32",
+	ST_INT64:         "ST_INT64",
+	ST_UINT96:        "ST_UINT96",
+	ST_HASH192:       "ST_HASH192",
+	ST_UINT384:       "ST_UINT384",
+	ST_UINT512:       "ST_UINT512",
+	ST_XCHAIN_BRIDGE: "ST_XCHAIN_BRIDGE",
+	ST_CURRENCY:      "ST_CURRENCY",
+}
+
+func encLiteral(e enc) string {
+	if name, ok := stNames[e.typ]; ok {
+		return fmt.Sprintf("enc{%s, %d}", name, e.field)
+	}
+	return fmt.Sprintf("enc{%d, %d}", e.typ, e.field)
+}
+
+func typeName(t reflect.Type) (string, error) {
+	switch {
+	case t.Kind() == reflect.Ptr:
+		name, err := typeName(t.Elem())
+		return "*" + name, err
+	case t.Name() != "":
+		return t.Name(), nil
+	case t.Kind() == reflect.Slice:
+		name, err := typeName(t.Elem())
+		return "[]" + name, err
+	default:
+		return "", fmt.Errorf("codecgen: no name for type: %s", t)
+	}
+}
+
+var uintKinds = map[reflect.Kind]string{
+	reflect.Uint8:  "uint8",
+	reflect.Uint16: "uint16",
+	reflect.Uint32: "uint32",
+	reflect.Uint64: "uint64",
+}
+
+// genField is a field to be written, with the code which writes it
+type genField struct {
+	e    enc
+	code string
+}
+
+func (g *codecGen) encoder(t reflect.Type) error {
+	var extra string
+	fields, err := g.fields(t, "t", "fw", 0, &extra)
+	if err != nil {
+		return err
+	}
+	g.printf("\nfunc (t *%s) encodeFields(fw *fieldWriter, nested bool) {\n", t.Name())
+	if extra != "" {
+		g.printf("fw.setExtra(%s)\n", extra)
+	}
+	for _, f := range fields {
+		g.printf("%s", f.code)
+	}
+	g.printf("}\n")
+	return nil
+}
+
+// fields returns the fields of the struct x, of type t, which writer w
+// writes, in the order of getFields. extra is set to the Fields of x.
+func (g *codecGen) fields(t reflect.Type, x, w string, depth int, extra *string) ([]genField, error) {
+	var fields []genField
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		name := sf.Name
+		if name == "Hash" || name == "Id" {
+			continue
+		}
+		if name == "LedgerIndex" && t.Name() == "leBase" {
+			continue
+		}
+		if !sf.IsExported() && !sf.Anonymous {
+			continue
+		}
+		e := reverseEncodings[name]
+		ft := sf.Type
+		expr := x + "." + name
+		value := expr
+		var conds []string
+		if name == "LedgerEntryType" && t.Name() == "leBase" {
+			conds = append(conds, "!nested")
+		}
+		switch ft.Kind() {
+		case reflect.Interface:
+			if ft != ledgerEntryIf || e.typ != ST_OBJECT {
+				return nil, fmt.Errorf("codecgen: unsupported interface field: %s.%s", t, name)
+			}
+			c := g.newVar("c")
+			fields = append(fields, g.guard(e, []string{fmt.Sprintf("present(%s)", expr)}, fmt.Sprintf(
+				"%s.header(%s) // %s\n%s := %s.child()\n%s.entry(%s)\n%s.close(&%s)\n%s.end(endOfObject)\n",
+				w, encLiteral(e), name, c, w, c, expr, w, c, w)))
+			continue
+		case reflect.Ptr:
+			conds = append(conds, expr+" != nil")
+			ft = ft.Elem()
+			value = "(*" + expr + ")"
+		}
+		if ft.Kind() == reflect.Slice {
+			conds = append(conds, fmt.Sprintf("len(%s) > 0", value))
+		}
+		if ft == fieldsType {
+			*extra = expr
+			continue
+		}
+		switch e.typ {
+		case ST_UINT8, ST_UINT16, ST_UINT32, ST_UINT64,
+			ST_HASH128, ST_HASH256, ST_AMOUNT, ST_VL, ST_ACCOUNT, ST_HASH160, ST_PATHSET, ST_VECTOR256, ST_ISSUE:
+			fields = append(fields, g.guard(e, conds, g.scalar(ft, e, name, expr, value, w)))
+		case ST_ARRAY:
+			if ft.Kind() != reflect.Slice || ft.Elem().Kind() != reflect.Struct {
+				fields = append(fields, g.guard(e, conds, fmt.Sprintf("%s.unsupported(%q)\n", w, name)))
+				continue
+			}
+			el, c := g.newVar("e"), g.newVar("c")
+			var inner string
+			children, err := g.fields(ft.Elem(), el, c, depth+1, &inner)
+			if err != nil {
+				return nil, err
+			}
+			code := fmt.Sprintf("%s.header(%s) // %s\nfor i := range %s {\n%s := &%s[i]\n%s := %s.child()\n",
+				w, encLiteral(e), name, value, el, value, c, w)
+			if inner != "" {
+				code += fmt.Sprintf("%s.setExtra(%s)\n", c, inner)
+			}
+			for _, child := range children {
+				code += child.code
+			}
+			code += fmt.Sprintf("%s.close(&%s)\n}\n%s.end(endOfArray)\n", w, c, w)
+			fields = append(fields, g.guard(e, conds, code))
+		case ST_OBJECT:
+			if ft.Kind() != reflect.Struct {
+				return nil, fmt.Errorf("codecgen: unsupported object field: %s.%s", t, name)
+			}
+			c := g.newVar("c")
+			code := fmt.Sprintf("%s.header(%s) // %s\n%s := %s.child()\n", w, encLiteral(e), name, c, w)
+			if g.generated[ft] {
+				code += fmt.Sprintf("%s.encodeFields(&%s, false)\n", expr, c)
+			} else {
+				var inner string
+				children, err := g.fields(ft, value, c, depth+1, &inner)
+				if err != nil {
+					return nil, err
+				}
+				if inner != "" {
+					code += fmt.Sprintf("%s.setExtra(%s)\n", c, inner)
+				}
+				for _, child := range children {
+					code += child.code
+				}
+			}
+			code += fmt.Sprintf("%s.close(&%s)\n%s.end(endOfObject)\n", w, c, w)
+			fields = append(fields, g.guard(e, conds, code))
+		default:
+			// Embedded structs such as TxBase and leBase are written as
+			// though their fields were those of x
+			if ft.Kind() != reflect.Struct {
+				continue
+			}
+			if len(conds) > 0 {
+				return nil, fmt.Errorf("codecgen: unsupported field: %s.%s", t, name)
+			}
+			children, err := g.fields(ft, value, w, depth+1, extra)
+			if err != nil {
+				return nil, err
+			}
+			fields = append(fields, children...)
+		}
+	}
+	sort.SliceStable(fields, func(i, j int) bool { return fields[i].e.Priority() < fields[j].e.Priority() })
+	return fields, nil
+}
+
+// guard writes code only when conds hold, and not at all for signing
+// fields when they are left out
+func (g *codecGen) guard(e enc, conds []string, code string) genField {
+	if e.SigningField() {
+		conds = append([]string{"!fw.ignoreSigningFields"}, conds...)
+	}
+	if len(conds) > 0 {
+		code = fmt.Sprintf("if %s {\n%s}\n", strings.Join(conds, " && "), code)
+	}
+	return genField{e, code}
+}
+
+// scalar is the code which writes a field which is neither an object nor
+// an array. expr is the field and value is what it points to, if it is a
+// pointer.
+func (g *codecGen) scalar(t reflect.Type, e enc, name, expr, value, w string) string {
+	addr := "&" + value
+	if expr != value {
+		addr = expr
+	}
+	if reflect.PtrTo(t).Implements(wireType) {
+		return fmt.Sprintf("%s.wire(%s, %s) // %s\n", w, encLiteral(e), addr, name)
+	}
+	if kind, ok := uintKinds[t.Kind()]; ok {
+		return fmt.Sprintf("%s.%s(%s, %s(%s)) // %s\n", w, kind, encLiteral(e), kind, value, name)
+	}
+	return fmt.Sprintf("%s.value(%s, %s) // %s\n", w, encLiteral(e), addr, name)
+}
+
+// decoder writes decodeField for the fields readObject would find with
+// FieldByName, other than objects and arrays
+func (g *codecGen) decoder(t reflect.Type) error {
+	var encs []enc
+	for e := range encodings {
+		encs = append(encs, e)
+	}
+	sort.Slice(encs, func(i, j int) bool { return encs[i].Priority() < encs[j].Priority() })
+	g.printf("\nfunc (t *%s) decodeField(r Reader, e enc) (bool, error) {\nswitch e {\n", t.Name())
+	for _, e := range encs {
+		if e.typ == ST_OBJECT || e.typ == ST_ARRAY {
+			continue
+		}
+		name := encodings[e]
+		sf, ok := t.FieldByName(name)
+		if !ok || !sf.IsExported() || sf.Type.Kind() == reflect.Interface || sf.Type == fieldsType {
+			continue
+		}
+		expr := "t"
+		for i := range sf.Index {
+			expr += "." + t.FieldByIndex(sf.Index[:i+1]).Name
+		}
+		code, err := g.read(sf.Type, expr)
+		if err != nil {
+			return err
+		}
+		g.printf("case %s: // %s\n%s", encLiteral(e), name, code)
+	}
+	g.printf("}\nreturn false, nil\n}\n")
+	return nil
+}
+
+// read is the code which reads the field expr of type t
+func (g *codecGen) read(t reflect.Type, expr string) (string, error) {
+	var code string
+	value := expr
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+		name, err := typeName(t)
+		if err != nil {
+			return "", err
+		}
+		code = fmt.Sprintf("%s = new(%s)\n", expr, name)
+		value = "*" + expr
+	}
+	name, err := typeName(t)
+	if err != nil {
+		return "", err
+	}
+	if reflect.PtrTo(t).Implements(wireType) {
+		return code + fmt.Sprintf("return true, %s.Unmarshal(r)\n", expr), nil
+	}
+	if kind, ok := uintKinds[t.Kind()]; ok {
+		fn := "readUint" + strings.TrimPrefix(kind, "uint")
+		return code + fmt.Sprintf("v, err := %s(r)\n%s = %s(v)\nreturn true, err\n", fn, value, name), nil
+	}
+	if value == expr {
+		expr = "&" + expr
+	}
+	return code + fmt.Sprintf("return true, read(r, %s)\n", expr), nil
+}
@@ -12,6 +12,15 @@ import (
 type Currency [20]byte
 type CurrencyType uint8
 
+// Issue is a currency without a value, as used by the Asset fields of
+// AMM transactions. XRP has no Issuer. An MPT is an Issue with only an
+// MPTIssuanceID, whose last 20 bytes are the issuer.
+type Issue struct {
+	Currency      Currency
+	Issuer        Account
+	MPTIssuanceID Hash192
+}
+
 const (
 	CT_XRP       CurrencyType = 0
 	CT_STANDARD  CurrencyType = 1
@@ -57,6 +66,34 @@ func (c Currency) Equals(other Currency) bool {
 	return c == other
 }
 
+// noAccount follows the issuer of an MPT in binary, where a currency's
+// issuer would be
+var noAccount = Account{19: 1}
+
+func (i Issue) IsMPT() bool {
+	return !i.MPTIssuanceID.IsZero()
+}
+
+func (i Issue) Less(other Issue) bool {
+	if i.IsMPT() || other.IsMPT() {
+		return bytes.Compare(i.MPTIssuanceID[:], other.MPTIssuanceID[:]) < 0
+	}
+	if !i.Currency.Equals(other.Currency) {
+		return i.Currency.Less(other.Currency)
+	}
+	return i.Issuer.Less(other.Issuer)
+}
+
+func (i Issue) String() string {
+	if i.IsMPT() {
+		return i.MPTIssuanceID.String()
+	}
+	if i.Currency.IsNative() {
+		return i.Currency.Machine()
+	}
+	return i.Currency.Machine() + "/" + i.Issuer.String()
+}
+
 func (c Currency) Clone() Currency {
 	var n Currency
 	copy(n[:], c[:])
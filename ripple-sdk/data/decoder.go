@@ -1,8 +1,10 @@
 package data
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"io"
 	"reflect"
 )
 
@@ -66,14 +68,23 @@ func ReadValidation(r Reader) (*Validation, error) {
 	return validation, nil
 }
 
+// ReadTransaction reads a transaction, which is all that is left in r when
+// r is a StrictReader
 func ReadTransaction(r Reader) (Transaction, error) {
+	if err := checkBlob(r, "Transaction"); err != nil {
+		return nil, err
+	}
 	txType, err := expectType(r, "TransactionType")
 	if err != nil {
 		return nil, err
 	}
-	tx := TxFactory[txType]()
+	tx := newTransaction(TransactionType(txType))
 	v := reflect.ValueOf(tx)
-	if err := readObject(r, &v); err != nil {
+	first := reverseEncodings["TransactionType"]
+	if err := readObjectAfter(r, &v, &first); err != nil {
+		return nil, err
+	}
+	if err := checkTrailing(r, "Transaction"); err != nil {
 		return nil, err
 	}
 	return tx, nil
@@ -94,6 +105,9 @@ func ReadTransactionAndMetadata(tx, meta Reader, hash Hash256, ledger uint32) (*
 	if err := readObject(meta, &m); err != nil {
 		return nil, err
 	}
+	if err := checkTrailing(meta, "MetaData"); err != nil {
+		return nil, err
+	}
 	*txm.GetHash() = hash
 	if txm.Id, err = NodeId(txm); err != nil {
 		return nil, err
@@ -124,10 +138,16 @@ func readTransactionWithMetadata(r Reader, ledger uint32, nodeId Hash256) (*Tran
 	if err := readObject(br, &meta); err != nil {
 		return nil, err
 	}
+	if err := checkTrailing(br, "MetaData"); err != nil {
+		return nil, err
+	}
 	hash, err := readHash(r)
 	if err != nil {
 		return nil, err
 	}
+	if err := checkTrailing(r, "TransactionWithMetaData"); err != nil {
+		return nil, err
+	}
 	copy(txm.GetHash()[:], hash.Bytes())
 	return txm, nil
 }
@@ -149,31 +169,66 @@ func readCompressedInnerNode(r Reader, typ NodeType, nodeId Hash256) (*InnerNode
 	inner.Type = typ
 	var entry CompressedNodeEntry
 	for read(r, &entry) == nil {
+		if int(entry.Pos) >= len(inner.Children) {
+			return nil, fmt.Errorf("Bad position: %d in compressed inner node", entry.Pos)
+		}
 		inner.Children[entry.Pos] = entry.Hash
 	}
 	copy(inner.Id[:], nodeId.Bytes())
 	return &inner, nil
 }
 
+// ReadLedgerEntry reads a ledger entry suffixed with its index, which is
+// all that is left in r when r is a StrictReader
 func ReadLedgerEntry(r Reader, nodeId Hash256) (LedgerEntry, error) {
+	if err := checkBlob(r, "LedgerEntry"); err != nil {
+		return nil, err
+	}
 	leType, err := expectType(r, "LedgerEntryType")
 	if err != nil {
 		return nil, err
 	}
-	le := LedgerEntryFactory[leType]()
+	le := newLedgerEntry(LedgerEntryType(leType))
 	v := reflect.ValueOf(le)
 	// LedgerEntries have 32 bytes of index suffixed
 	// but don't have a variable bytes indicator
 	lr := LimitedByteReader(r, int64(r.Len()-32))
-	if err := readObject(lr, &v); err != nil {
+	first := reverseEncodings["LedgerEntryType"]
+	if err := readObjectAfter(lr, &v, &first); err != nil {
 		return nil, err
 	}
 	hash, err := readHash(r)
 	if err != nil {
 		return nil, err
 	}
+	if err := checkTrailing(r, "LedgerEntry"); err != nil {
+		return nil, err
+	}
 	copy(le.GetHash()[:], hash.Bytes())
 	copy(le.NodeId()[:], nodeId.Bytes())
+	// The suffix is the index, which not every entry can work out
+	if index := reflect.ValueOf(le).Elem().FieldByName("LedgerIndex"); index.IsNil() {
+		index.Set(reflect.ValueOf(hash))
+	}
+	return le, nil
+}
+
+// NewUnknowLedger keeps a ledger entry which ReadLedgerEntry could not
+// read as it is. b is the entry with its index suffixed, as in
+// ReadLedgerEntry.
+func NewUnknowLedger(b []byte) (*UnknowLedger, error) {
+	if len(b) < 32 {
+		return nil, fmt.Errorf("Ledger entry is too short: %d bytes", len(b))
+	}
+	var index Hash256
+	copy(index[:], b[len(b)-32:])
+	le := &UnknowLedger{
+		leBase: leBase{LedgerEntryType: UNKNOW_LEDGER_TYPE, LedgerIndex: &index},
+		Binary: append(VariableLength(nil), b[:len(b)-32]...),
+	}
+	if leType, err := expectType(bytes.NewReader(le.Binary), "LedgerEntryType"); err == nil {
+		le.LedgerEntryType = LedgerEntryType(leType)
+	}
 	return le, nil
 }
 
@@ -219,16 +274,52 @@ var (
 )
 
 func readObject(r Reader, v *reflect.Value) error {
-	var err error
-	for enc, err := readEncoding(r); err == nil; enc, err = readEncoding(r) {
+	return readObjectAfter(r, v, nil)
+}
+
+// readObjectAfter reads the rest of an object of which last is the field
+// already read, or nil
+func readObjectAfter(r Reader, v *reflect.Value, last *enc) error {
+	leave, err := enter(r)
+	if err != nil {
+		return err
+	}
+	defer leave()
+	var fd fieldDecoder
+	if GeneratedCodec && v.Kind() == reflect.Ptr {
+		fd, _ = v.Interface().(fieldDecoder)
+	}
+	for enc, err := readEncoding(r); err != io.EOF; enc, err = readEncoding(r) {
+		if err != nil {
+			// Only a StrictReader rejects a field cut short at the end
+			if strictOf(r) != nil {
+				return err
+			}
+			return nil
+		}
 		name := encodings[*enc]
 		// fmt.Println(name, v, v.IsValid(), enc.typ, enc.field)
+		if name != "ObjectEndMarker" && name != "ArrayEndMarker" {
+			if err := checkOrder(r, last, enc); err != nil {
+				return err
+			}
+			last = enc
+		}
 		switch enc.typ {
 		case ST_ARRAY:
-			if name == "EndOfArray" {
+			if name == "ArrayEndMarker" {
 				return errorEndOfArray
 			}
 			array := getField(v, enc)
+			if !array.IsValid() {
+				if err := readUnknownField(r, v, enc); err != nil {
+					return err
+				}
+				continue
+			}
+			if array.Kind() != reflect.Slice {
+				return fmt.Errorf("Unexpected array: %s for field: %s", v.Type(), name)
+			}
 		loop:
 			for {
 				child := reflect.New(array.Type().Elem()).Elem()
@@ -238,17 +329,23 @@ func readObject(r Reader, v *reflect.Value) error {
 					break loop
 				case errorEndOfObject:
 					array.Set(reflect.Append(*array, child))
+					if err := checkArrayLength(r, array.Len(), enc); err != nil {
+						return err
+					}
 				default:
 					return err
 				}
 			}
 		case ST_OBJECT:
 			switch name {
-			case "EndOfObject":
+			case "ObjectEndMarker":
 				return errorEndOfObject
 			case "PreviousFields", "NewFields", "FinalFields":
+				if v.Type() != reflect.TypeOf((*AffectedNode)(nil)) {
+					return fmt.Errorf("Unexpected object: %s for field: %s", v.Type(), name)
+				}
 				leType := LedgerEntryType(v.Elem().FieldByName("LedgerEntryType").Uint())
-				le := LedgerEntryFactory[leType]()
+				le := newLedgerEntry(leType)
 				fields := reflect.ValueOf(le)
 				v.Elem().FieldByName(name).Set(fields)
 				if err := readObject(r, &fields); err != nil && err != errorEndOfObject {
@@ -265,36 +362,118 @@ func readObject(r Reader, v *reflect.Value) error {
 				n := reflect.ValueOf(&node)
 				var effect NodeEffect
 				e := reflect.ValueOf(&effect)
+				if err := checkObject(v, e, name); err != nil {
+					return err
+				}
 				e.Elem().FieldByName(name).Set(n)
 				v.Set(e.Elem())
 				return readObject(r, &n)
 			case "SignerEntry":
-				var signerEntry SignerEntry
-				s := reflect.ValueOf(&signerEntry)
-				err := readObject(r, &s)
-				v.Set(s.Elem())
+				var signerEntry SignerEntryEx
+				m := reflect.ValueOf(&signerEntry)
+				if err := checkObject(v, m, name); err != nil {
+					return err
+				}
+				inner := reflect.ValueOf(&signerEntry.SignerEntry)
+				err := readObject(r, &inner)
+				v.Set(m.Elem())
 				return err
+			case "Signer":
+				var signer MultiSignerEntryEx
+				m := reflect.ValueOf(&signer)
+				if err := checkObject(v, m, name); err != nil {
+					return err
+				}
+				inner := reflect.ValueOf(&signer.Signer)
+				err := readObject(r, &inner)
+				v.Set(m.Elem())
+				return err
+			case "NFToken":
+				var token NFToken
+				m := reflect.ValueOf(&token)
+				if err := checkObject(v, m, name); err != nil {
+					return err
+				}
+				inner := reflect.ValueOf(&token.NFToken)
+				err := readObject(r, &inner)
+				v.Set(m.Elem())
+				return err
+			case "VoteEntry":
+				var vote VoteEntry
+				m := reflect.ValueOf(&vote)
+				if err := checkObject(v, m, name); err != nil {
+					return err
+				}
+				inner := reflect.ValueOf(&vote.VoteEntry)
+				err := readObject(r, &inner)
+				v.Set(m.Elem())
+				return err
+			case "AuthAccount":
+				var auth AuthAccount
+				m := reflect.ValueOf(&auth)
+				if err := checkObject(v, m, name); err != nil {
+					return err
+				}
+				inner := reflect.ValueOf(&auth.AuthAccount)
+				err := readObject(r, &inner)
+				v.Set(m.Elem())
+				return err
+			case "AuctionSlot":
+				slot := getField(v, enc)
+				if !slot.CanAddr() {
+					return fmt.Errorf("Unexpected object: %s for field: %s", v.Type(), name)
+				}
+				addr := slot.Addr()
+				if err := readObject(r, &addr); err != errorEndOfObject {
+					return err
+				}
 			case "Majority":
 				var majority Majority
 				m := reflect.ValueOf(&majority)
-				err := readObject(r, &m)
+				if err := checkObject(v, m, name); err != nil {
+					return err
+				}
+				inner := reflect.ValueOf(&majority.Majority)
+				err := readObject(r, &inner)
 				v.Set(m.Elem())
 				return err
 			case "Memo":
 				var memo Memo
 				m := reflect.ValueOf(&memo)
+				if err := checkObject(v, m, name); err != nil {
+					return err
+				}
 				inner := reflect.ValueOf(&memo.Memo)
 				err := readObject(r, &inner)
 				v.Set(m.Elem())
 				return err
 			default:
-				return fmt.Errorf("Unexpected object: %s for field: %s", v.Type(), name)
+				if fieldsOf(v) == nil {
+					return fmt.Errorf("Unexpected object: %s for field: %s", v.Type(), name)
+				}
+				if err := readUnknownField(r, v, enc); err != nil {
+					return err
+				}
 			}
 		default:
 			if v.Kind() == reflect.Struct {
 				return fmt.Errorf("Unexpected struct: %s for field: %s", v.Type(), name)
 			}
+			if fd != nil {
+				if ok, err := fd.decodeField(r, *enc); ok {
+					if err != nil {
+						return err
+					}
+					continue
+				}
+			}
 			field := getField(v, enc)
+			if !field.IsValid() && fieldsOf(v) != nil {
+				if err := readUnknownField(r, v, enc); err != nil {
+					return err
+				}
+				continue
+			}
 			if !field.CanAddr() {
 				return fmt.Errorf("Missing field: %s %+v", name, enc)
 			}
@@ -310,10 +489,39 @@ func readObject(r Reader, v *reflect.Value) error {
 			}
 		}
 	}
-	return err
+	return checkEnd(r)
 }
 
+// readUnknownField reads a field which the struct v points to does not
+// have into its Fields
+func readUnknownField(r Reader, v *reflect.Value, e *enc) error {
+	fields := fieldsOf(v)
+	if fields == nil {
+		return fmt.Errorf("Missing field: %s %+v", encodings[*e], e)
+	}
+	value, err := readField(r, *e)
+	if err != nil {
+		return err
+	}
+	fields[fieldName(*e)] = value
+	return nil
+}
+
+// checkObject checks that v, an element of the array being read, can be
+// set to the wrapper object which m points to
+func checkObject(v *reflect.Value, m reflect.Value, name string) error {
+	if !v.CanSet() || v.Type() != m.Type().Elem() {
+		return fmt.Errorf("Unexpected object: %s for field: %s", v.Type(), name)
+	}
+	return nil
+}
+
+// getField returns the field named for e of the struct v points to, or
+// the zero Value if there is none
 func getField(v *reflect.Value, e *enc) *reflect.Value {
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return &reflect.Value{}
+	}
 	name := encodings[*e]
 	field := v.Elem().FieldByName(name)
 	if field.Kind() == reflect.Ptr {
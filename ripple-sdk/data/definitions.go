@@ -0,0 +1,134 @@
+package data
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+)
+
+// Definitions are the type, field, transaction and ledger entry codes in the
+// form of rippled's server_definitions command and its definitions.json
+type Definitions struct {
+	Types              map[string]int    `json:"TYPES"`
+	LedgerEntryTypes   map[string]int    `json:"LEDGER_ENTRY_TYPES"`
+	Fields             []FieldDefinition `json:"FIELDS"`
+	TransactionResults map[string]int    `json:"TRANSACTION_RESULTS,omitempty"`
+	TransactionTypes   map[string]int    `json:"TRANSACTION_TYPES"`
+	Hash               string            `json:"hash,omitempty"`
+}
+
+// FieldDefinition is one of the FIELDS, each of which is a pair of the
+// name and the rest in JSON
+type FieldDefinition struct {
+	Name           string `json:"-"`
+	Nth            int    `json:"nth"`
+	IsVLEncoded    bool   `json:"isVLEncoded"`
+	IsSerialized   bool   `json:"isSerialized"`
+	IsSigningField bool   `json:"isSigningField"`
+	Type           string `json:"type"`
+}
+
+// rippled's definitions.json, as of rippled 2.4.0
+//
+//go:embed definitions.json
+var defaultDefinitions []byte
+
+func init() {
+	d, err := LoadDefinitions(bytes.NewReader(defaultDefinitions))
+	if err != nil {
+		panic(fmt.Sprintf("Bad definitions.json: %s", err))
+	}
+	if err := SetDefinitions(d); err != nil {
+		panic(fmt.Sprintf("Bad definitions.json: %s", err))
+	}
+}
+
+// LoadDefinitions reads definitions in the JSON form of rippled's
+// definitions.json
+func LoadDefinitions(r io.Reader) (*Definitions, error) {
+	var d Definitions
+	if err := json.NewDecoder(r).Decode(&d); err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+// SetDefinitions adds the fields, transaction types, ledger entry types and
+// results in d which this package does not know. Transactions and ledger
+// entries of the added types are read into a GenericTx or
+// GenericLedgerEntry. Where d names a known code differently, the known
+// name is kept and the other is read as an alias of it.
+//
+// SetDefinitions must not be called while anything is being encoded or
+// decoded.
+func SetDefinitions(d *Definitions) error {
+	for _, f := range d.Fields {
+		typ, ok := d.Types[f.Type]
+		if !ok {
+			return fmt.Errorf("Unknown type: %s for field: %s", f.Type, f.Name)
+		}
+		// Fields such as "hash" and "index" are only in JSON
+		if !f.IsSerialized || f.Nth < 1 || f.Nth > math.MaxUint8 || typ < 1 || typ > math.MaxUint8 {
+			continue
+		}
+		e := enc{uint8(typ), uint8(f.Nth)}
+		if _, ok := reverseEncodings[f.Name]; !ok {
+			reverseEncodings[f.Name] = e
+		}
+		if _, ok := encodings[e]; ok {
+			continue
+		}
+		encodings[e] = f.Name
+		if !f.IsSigningField {
+			signingFields[e] = struct{}{}
+		}
+	}
+	for name, code := range d.TransactionTypes {
+		if code < 0 || code >= len(TxFactory) {
+			continue
+		}
+		typ := TransactionType(code)
+		if _, ok := txTypes[name]; !ok {
+			txTypes[name] = typ
+		}
+		if _, ok := txNames[typ]; ok {
+			continue
+		}
+		txNames[typ] = name
+		if TxFactory[typ] == nil {
+			TxFactory[typ] = func() Transaction { return &GenericTx{TxBase: TxBase{TransactionType: typ}} }
+		}
+	}
+	for name, code := range d.LedgerEntryTypes {
+		if code < 0 || code >= len(LedgerEntryFactory) {
+			continue
+		}
+		typ := LedgerEntryType(code)
+		if _, ok := ledgerEntryTypes[name]; !ok {
+			ledgerEntryTypes[name] = typ
+		}
+		if _, ok := ledgerEntryNames[typ]; ok {
+			continue
+		}
+		ledgerEntryNames[typ] = name
+		if LedgerEntryFactory[typ] == nil {
+			LedgerEntryFactory[typ] = func() LedgerEntry { return &GenericLedgerEntry{leBase: leBase{LedgerEntryType: typ}} }
+		}
+	}
+	for name, code := range d.TransactionResults {
+		result := TransactionResult(code)
+		if _, ok := reverseResults[name]; !ok {
+			reverseResults[name] = result
+		}
+		if _, ok := resultNames[result]; !ok {
+			resultNames[result] = struct {
+				Token string
+				Human string
+			}{name, name}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,311 @@
+package data
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+const (
+	testAccount   = "B5F762798A53D543A014CAF8B297CFF8F2F937E8" // rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh
+	testAccount2  = "F667B0CA50CC7709A220B0561B85E53A48461FA8" // rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe
+	testTxnID     = "6E4A1B1C8F7A3C0D2E5B9A8C7D6E5F4A3B2C1D0E9F8A7B6C5D4E3F2A1B0C9D8E"
+	testIndex     = "1F2E3D4C5B6A79880F1E2D3C4B5A69788796A5B4C3D2E1F00F1E2D3C4B5A6978"
+	xrpCurrency   = "0000000000000000000000000000000000000000"
+	usdCurrency   = "0000000000000000000000005553440000000000"
+	mptIssuanceID = "00000001" + testAccount
+)
+
+// Ledger entries of types which have no Go type, with the field types
+// rippled has added, in the layout of rippled
+var (
+	mpTokenIssuanceBlob = "11007E" + // LedgerEntryType
+		"140032" + // TransferFee
+		"2200000000" + "2400000001" + "2500000005" + // Flags, Sequence, PreviousTxnLgrSeq
+		"340000000000000000" + // OwnerNode
+		"3018" + "000000000000FFFF" + // MaximumAmount
+		"3019" + "0000000000000064" + // OutstandingAmount
+		"55" + testTxnID + // PreviousTxnID
+		"701E" + "03414243" + // MPTokenMetadata
+		"8414" + testAccount + // Issuer
+		"051002" + // AssetScale
+		testIndex
+
+	mpTokenBlob = "11007F" + "2200000000" + "2500000005" + "340000000000000000" +
+		"301A" + "0000000000000064" + // MPTAmount
+		"55" + testTxnID +
+		"8114" + testAccount2 + // Account
+		"0115" + mptIssuanceID + // MPTokenIssuanceID
+		testIndex
+
+	bridgeBlob = "110069" + "2200000000" + "2500000005" + "340000000000000000" +
+		"3014" + "0000000000000001" + // XChainClaimID
+		"55" + testTxnID +
+		"601D" + "4000000000000064" + // SignatureReward
+		"8114" + testAccount +
+		"0119" + // XChainBridge
+		"14" + testAccount + xrpCurrency +
+		"14" + testAccount2 + xrpCurrency +
+		testIndex
+
+	oracleBlob = "110080" + "2200000000" + "2500000005" +
+		"2F0000000A" + // LastUpdateTime
+		"340000000000000000" +
+		"55" + testTxnID +
+		"701C" + "0863757272656E6379" + // AssetClass
+		"701D" + "0470726F76" + // Provider
+		"8214" + testAccount + // Owner
+		"F018" + // PriceDataSeries
+		"E020" + // PriceData
+		"3017" + "0000000000000064" + // AssetPrice
+		"041001" + // Scale
+		"011A" + xrpCurrency + // BaseAsset
+		"021A" + usdCurrency + // QuoteAsset
+		"E1" + "F1" +
+		testIndex
+)
+
+// testLedgerEntryRoundTrip reads blob, with and without the generated
+// codec, and writes it back in binary and through JSON
+func testLedgerEntryRoundTrip(t *testing.T, name, blob string) LedgerEntry {
+	b, err := hex.DecodeString(blob)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var le LedgerEntry
+	for _, generated := range []bool{false, true} {
+		useGeneratedCodec(t, generated)
+		le, err = ReadLedgerEntry(NewStrictReader(bytes.NewReader(b), DefaultDecodeLimits), Hash256{})
+		if err != nil {
+			t.Fatalf("%s generated %v: %v", name, generated, err)
+		}
+		if le.GetLedgerEntryType().String() != name {
+			t.Fatalf("%s generated %v: read %s", name, generated, le.GetLedgerEntryType())
+		}
+		_, raw, err := Raw(le)
+		if err != nil {
+			t.Fatalf("%s generated %v: %v", name, generated, err)
+		}
+		if !bytes.Equal(raw, b) {
+			t.Errorf("%s generated %v: round trip\n got %X\nwant %X", name, generated, raw, b)
+		}
+		j, err := json.Marshal(LedgerEntrySlice{le})
+		if err != nil {
+			t.Fatalf("%s generated %v: %v", name, generated, err)
+		}
+		var les LedgerEntrySlice
+		if err := json.Unmarshal(j, &les); err != nil {
+			t.Fatalf("%s generated %v: %v in %s", name, generated, err, j)
+		}
+		if _, raw, err = Raw(les[0]); err != nil {
+			t.Fatalf("%s generated %v: %v", name, generated, err)
+		}
+		if !bytes.Equal(raw, b) {
+			t.Errorf("%s generated %v: JSON round trip of %s\n got %X\nwant %X", name, generated, j, raw, b)
+		}
+	}
+	return le
+}
+
+func TestMPTokenEntries(t *testing.T) {
+	le := testLedgerEntryRoundTrip(t, "MPTokenIssuance", mpTokenIssuanceBlob)
+	j, err := json.Marshal(le)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// rippled writes the MPT amounts in decimal
+	for _, want := range []string{`"MaximumAmount":"65535"`, `"OutstandingAmount":"100"`, `"OwnerNode":"0000000000000000"`, `"AssetScale":2`} {
+		if !strings.Contains(string(j), want) {
+			t.Errorf("%s is not in %s", want, j)
+		}
+	}
+
+	le = testLedgerEntryRoundTrip(t, "MPToken", mpTokenBlob)
+	fields := le.(*GenericLedgerEntry).Fields
+	if id, ok := fields["MPTokenIssuanceID"].(*Hash192); !ok || id.String() != mptIssuanceID {
+		t.Errorf("MPTokenIssuanceID is %v", fields["MPTokenIssuanceID"])
+	}
+	if amount, ok := fields["MPTAmount"].(*Uint64Dec); !ok || *amount != 100 {
+		t.Errorf("MPTAmount is %v", fields["MPTAmount"])
+	}
+}
+
+func TestBridgeEntry(t *testing.T) {
+	le := testLedgerEntryRoundTrip(t, "Bridge", bridgeBlob)
+	bridge, ok := le.(*GenericLedgerEntry).Fields["XChainBridge"].(*XChainBridge)
+	if !ok {
+		t.Fatalf("XChainBridge is %v", le.(*GenericLedgerEntry).Fields["XChainBridge"])
+	}
+	if bridge.LockingChainDoor.String() != "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh" ||
+		bridge.IssuingChainDoor.String() != "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe" ||
+		!bridge.LockingChainIssue.Currency.IsNative() || !bridge.IssuingChainIssue.Currency.IsNative() {
+		t.Errorf("XChainBridge is %+v", bridge)
+	}
+	j, err := json.Marshal(bridge)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"LockingChainDoor":"rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh","LockingChainIssue":{"currency":"XRP"},` +
+		`"IssuingChainDoor":"rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe","IssuingChainIssue":{"currency":"XRP"}}`
+	if string(j) != want {
+		t.Errorf("XChainBridge JSON is %s, want %s", j, want)
+	}
+}
+
+func TestOracleEntry(t *testing.T) {
+	le := testLedgerEntryRoundTrip(t, "Oracle", oracleBlob)
+	series, ok := le.(*GenericLedgerEntry).Fields["PriceDataSeries"].([]Fields)
+	if !ok || len(series) != 1 {
+		t.Fatalf("PriceDataSeries is %v", le.(*GenericLedgerEntry).Fields["PriceDataSeries"])
+	}
+	data := series[0]["PriceData"].(Fields)
+	if base, ok := data["BaseAsset"].(*Currency); !ok || base.Machine() != "XRP" {
+		t.Errorf("BaseAsset is %v", data["BaseAsset"])
+	}
+	if quote, ok := data["QuoteAsset"].(*Currency); !ok || quote.Machine() != "USD" {
+		t.Errorf("QuoteAsset is %v", data["QuoteAsset"])
+	}
+}
+
+func TestMPTIssue(t *testing.T) {
+	b, err := hex.DecodeString(testAccount + "0000000000000000000000000000000000000001" + "01000000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var issue Issue
+	if err := issue.Unmarshal(bytes.NewReader(b)); err != nil {
+		t.Fatal(err)
+	}
+	if !issue.IsMPT() || issue.MPTIssuanceID.String() != mptIssuanceID {
+		t.Errorf("Issue is %+v", issue)
+	}
+	var buf bytes.Buffer
+	if err := issue.Marshal(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(buf.Bytes(), b) {
+		t.Errorf("Issue is %X, want %X", buf.Bytes(), b)
+	}
+	j, err := json.Marshal(issue)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"mpt_issuance_id":"` + mptIssuanceID + `"}`; string(j) != want {
+		t.Errorf("Issue JSON is %s, want %s", j, want)
+	}
+	var fromJSON Issue
+	if err := json.Unmarshal(j, &fromJSON); err != nil || fromJSON != issue {
+		t.Errorf("Issue from JSON is %+v, %v", fromJSON, err)
+	}
+	if err := json.Unmarshal([]byte(`{"currency":"USD","mpt_issuance_id":"`+mptIssuanceID+`"}`), &fromJSON); err == nil {
+		t.Error("read an Issue with both a currency and an mpt_issuance_id")
+	}
+}
+
+func TestPaymentNetworkID(t *testing.T) {
+	tx := testPayment(t, "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh")
+	networkID := uint32(21337)
+	tx.Fields = Fields{"NetworkID": &networkID}
+	_, raw, err := Raw(tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// NetworkID is the first UInt32
+	if !bytes.Contains(raw, []byte{0x21, 0x00, 0x00, 0x53, 0x59}) {
+		t.Errorf("NetworkID is not in %X", raw)
+	}
+	read, err := ReadTransaction(bytes.NewReader(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	payment := read.(*Payment)
+	if id, ok := payment.Fields["NetworkID"].(*uint32); !ok || *id != networkID {
+		t.Errorf("NetworkID is %v", payment.Fields["NetworkID"])
+	}
+	var txm TransactionWithMetaData
+	j, err := json.Marshal(&TransactionWithMetaData{Transaction: payment})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(j), `"NetworkID":21337`) {
+		t.Errorf("NetworkID is not in %s", j)
+	}
+	if err := json.Unmarshal(j, &txm); err != nil {
+		t.Fatal(err)
+	}
+	_, fromJSON, err := Raw(txm.Transaction)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(fromJSON, raw) {
+		t.Errorf("JSON round trip\n got %X\nwant %X", fromJSON, raw)
+	}
+}
+
+// Types which no field of rippled's definitions.json has yet
+func TestNewFieldTypes(t *testing.T) {
+	defs := &Definitions{
+		Types: map[string]int{"Number": 9, "Int32": 10, "Int64": 11, "UInt96": 20, "UInt384": 22, "UInt512": 23},
+		Fields: []FieldDefinition{
+			{Name: "TestNumber", Nth: 200, IsSerialized: true, IsSigningField: true, Type: "Number"},
+			{Name: "TestInt32", Nth: 200, IsSerialized: true, IsSigningField: true, Type: "Int32"},
+			{Name: "TestInt64", Nth: 200, IsSerialized: true, IsSigningField: true, Type: "Int64"},
+			{Name: "TestUInt96", Nth: 200, IsSerialized: true, IsSigningField: true, Type: "UInt96"},
+			{Name: "TestUInt384", Nth: 200, IsSerialized: true, IsSigningField: true, Type: "UInt384"},
+			{Name: "TestUInt512", Nth: 200, IsSerialized: true, IsSigningField: true, Type: "UInt512"},
+		},
+	}
+	if err := SetDefinitions(defs); err != nil {
+		t.Fatal(err)
+	}
+	blob := "11007F" + "2200000000" + "2500000005" + "340000000000000000" +
+		"301A" + "0000000000000064" +
+		"55" + testTxnID +
+		"8114" + testAccount2 +
+		"90C8" + "00038D7EA4C68000" + "FFFFFFF0" + // TestNumber, 0.1
+		"A0C8" + "FFFFFFFE" + // TestInt32, -2
+		"B0C8" + "FFFFFFFFFFFFFFFD" + // TestInt64, -3
+		"0014C8" + strings.Repeat("01", 12) + // TestUInt96
+		"0115" + mptIssuanceID +
+		"0016C8" + strings.Repeat("02", 48) + // TestUInt384
+		"0017C8" + strings.Repeat("03", 64) + // TestUInt512
+		testIndex
+	le := testLedgerEntryRoundTrip(t, "MPToken", blob)
+	j, err := json.Marshal(le)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{`"TestNumber":"0.1"`, `"TestInt32":-2`, `"TestInt64":-3`, `"TestUInt96":"` + strings.Repeat("01", 12) + `"`} {
+		if !strings.Contains(string(j), want) {
+			t.Errorf("%s is not in %s", want, j)
+		}
+	}
+}
+
+// The results this package names agree with rippled's definitions.json
+func TestResultCodes(t *testing.T) {
+	d, err := LoadDefinitions(bytes.NewReader(defaultDefinitions))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for result, name := range resultNames {
+		if result == tesUNKNOWN_TYPE {
+			continue
+		}
+		if code, ok := d.TransactionResults[name.Token]; !ok || code != int(result) {
+			t.Errorf("%s is %d, rippled has %d", name.Token, result, code)
+		}
+	}
+	for typ, name := range ledgerEntryNames {
+		if code, ok := d.LedgerEntryTypes[name]; ok && code != int(typ) {
+			t.Errorf("%s is %d, rippled has %d", name, typ, code)
+		}
+	}
+	for typ, name := range txNames {
+		if code, ok := d.TransactionTypes[name]; ok && code != int(typ) {
+			t.Errorf("%s is %d, rippled has %d", name, typ, code)
+		}
+	}
+}
@@ -23,13 +23,18 @@ func NodeId(h Hashable) (Hash256, error) {
 	return nodeid, err
 }
 
+// SigningHash leaves out the signing fields, so that it is the same
+// before and after s is signed
 func SigningHash(s Signer) (Hash256, []byte, error) {
 
-	return raw(s, s.SigningPrefix(), false)
+	return raw(s, s.SigningPrefix(), true)
 }
+
+// MultiSignHash is the hash which the signer with the given account
+// signs, for any Transaction or MultiSignTransaction
 func MultiSignHash(s Signer, account []byte) (Hash256, []byte, error) {
 
-	prefix := s.SigningPrefix()
+	prefix := HP_TRANSACTION_MULTSIGN
 	suffix := account
 	buf := new(bytes.Buffer)
 
@@ -70,18 +75,21 @@ func Node(h Storer) (Hash256, []byte, error) {
 }
 
 func raw(value interface{}, prefix HashPrefix, ignoreSigningFields bool) (Hash256, []byte, error) {
-	buf := new(bytes.Buffer)
-	hasher := sha512.New()
-	multi := io.MultiWriter(buf, hasher)
-	if err := write(hasher, prefix); err != nil {
+	buf := getBuffer()
+	defer bufferPool.Put(buf)
+	if err := write(buf, prefix); err != nil {
 		return zero256, nil, err
 	}
-	if err := writeRaw(multi, value, ignoreSigningFields); err != nil {
+	if err := writeRaw(buf, value, ignoreSigningFields); err != nil {
 		return zero256, nil, err
 	}
+	hasher := getHasher()
+	defer hasherPool.Put(hasher)
+	hasher.Write(buf.Bytes())
+	var sum [sha512.Size]byte
 	var hash Hash256
-	copy(hash[:], hasher.Sum(nil))
-	return hash, buf.Bytes(), nil
+	copy(hash[:], hasher.Sum(sum[:0]))
+	return hash, append([]byte(nil), buf.Bytes()[4:]...), nil
 }
 
 // Disgusting node format and ordering handled here
@@ -117,8 +125,15 @@ func writeRaw(w io.Writer, value interface{}, ignoreSigningFields bool) error {
 		return write(w, txid)
 	case Transaction:
 		return encode(w, value, ignoreSigningFields)
+	case MultiSignTransaction:
+		return encode(w, value, ignoreSigningFields)
 	case LedgerEntry:
-		if err := encode(w, v, ignoreSigningFields); err != nil {
+		// Entries which could not be read are written as they were
+		if unknow, ok := v.(*UnknowLedger); ok && unknow.Binary != nil {
+			if err := write(w, []byte(unknow.Binary)); err != nil {
+				return err
+			}
+		} else if err := encode(w, v, ignoreSigningFields); err != nil {
 			return err
 		}
 		index, err := LedgerIndex(v)
@@ -132,29 +147,36 @@ func writeRaw(w io.Writer, value interface{}, ignoreSigningFields bool) error {
 }
 
 func encode(w io.Writer, value interface{}, ignoreSigningFields bool) error {
+	if fe, ok := value.(fieldEncoder); ok && GeneratedCodec {
+		fw := fieldWriter{w: w, ignoreSigningFields: ignoreSigningFields}
+		fe.encodeFields(&fw, false)
+		return fw.finish()
+	}
 	v := reflect.Indirect(reflect.ValueOf(value))
 	fields := getFields(&v, 0)
+	if ignoreSigningFields {
+		fields = fields.withoutSigningFields()
+	}
 	// fmt.Println(fields.String())
 	return fields.Each(func(e enc, v interface{}) error {
-		if ignoreSigningFields && e.SigningField() {
-			return nil
-		}
-		if err := writeEncoding(w, e); err != nil {
-			return err
-		}
-		var err error
-		switch v2 := v.(type) {
-		case Wire:
-			err = v2.Marshal(w)
-		case nil:
-			break
-		default:
-			err = write(w, v2)
-		}
-		return err
+		return writeField(w, e, v)
 	})
 }
 
+func writeField(w io.Writer, e enc, v interface{}) error {
+	if err := writeEncoding(w, e); err != nil {
+		return err
+	}
+	switch v := v.(type) {
+	case Wire:
+		return v.Marshal(w)
+	case nil:
+		return nil
+	default:
+		return write(w, v)
+	}
+}
+
 type field struct {
 	encoding enc
 	value    interface{}
@@ -187,6 +209,10 @@ func getFields(v *reflect.Value, depth int) fieldSlice {
 		if fieldName == "LedgerEntryType" && depth > 1 && typ.Name() == "leBase" {
 			continue
 		}
+		// The index of a ledger entry follows it rather than being a field
+		if fieldName == "LedgerIndex" && typ.Name() == "leBase" {
+			continue
+		}
 		encoding := reverseEncodings[fieldName]
 		f := v.Field(i)
 		// fmt.Println(fieldName, encoding, f, f.Kind())
@@ -196,13 +222,19 @@ func getFields(v *reflect.Value, depth int) fieldSlice {
 		if f.Kind() == reflect.Ptr {
 			f = f.Elem()
 		}
-		if !f.IsValid() || !f.CanInterface() || (f.Kind() == reflect.Slice && f.Len() == 0) {
+		// The fields of an unexported embedded struct such as leBase can
+		// still be encoded
+		if !f.IsValid() || (!f.CanInterface() && !typ.Field(i).Anonymous) || (f.Kind() == reflect.Slice && f.Len() == 0) {
+			continue
+		}
+		if f.Type() == fieldsType {
+			fields = append(fields, f.Interface().(Fields).fieldSlice()...)
 			continue
 		}
 		switch encoding.typ {
 		case ST_UINT8, ST_UINT16, ST_UINT32, ST_UINT64:
 			fields.Append(encoding, f.Addr().Interface(), nil)
-		case ST_HASH128, ST_HASH256, ST_AMOUNT, ST_VL, ST_ACCOUNT, ST_HASH160, ST_PATHSET, ST_VECTOR256:
+		case ST_HASH128, ST_HASH256, ST_AMOUNT, ST_VL, ST_ACCOUNT, ST_HASH160, ST_PATHSET, ST_VECTOR256, ST_ISSUE:
 			fields.Append(encoding, f.Addr().Interface(), nil)
 		case ST_ARRAY:
 			var children fieldSlice
@@ -210,14 +242,18 @@ func getFields(v *reflect.Value, depth int) fieldSlice {
 				f2 := f.Index(i)
 				children = append(children, getFields(&f2, depth+1)...)
 			}
-			children.Append(reverseEncodings["EndOfArray"], nil, nil)
+			children.Append(reverseEncodings["ArrayEndMarker"], nil, nil)
 			fields.Append(encoding, nil, children)
 		case ST_OBJECT:
 			children := getFields(&f, depth+1)
-			children.Append(reverseEncodings["EndOfObject"], nil, nil)
+			children.Append(reverseEncodings["ObjectEndMarker"], nil, nil)
 			fields.Append(encoding, nil, children)
 		default:
-			fields = append(fields, getFields(&f, depth+1)...)
+			// Fields with no encoding which are not embedded structs, such
+			// as the Name of an UnknowTx, are only in Go
+			if f.Kind() == reflect.Struct {
+				fields = append(fields, getFields(&f, depth+1)...)
+			}
 		}
 	}
 	fields.Sort()
@@ -236,6 +272,20 @@ func (s fieldSlice) Each(f func(e enc, v interface{}) error) error {
 	return nil
 }
 
+// Signing fields are dropped along with their children, so that
+// an array such as Signers is left out entirely
+func (s fieldSlice) withoutSigningFields() fieldSlice {
+	fields := make(fieldSlice, 0, len(s))
+	for _, field := range s {
+		if field.encoding.SigningField() {
+			continue
+		}
+		field.children = field.children.withoutSigningFields()
+		fields = append(fields, field)
+	}
+	return fields
+}
+
 func (f fieldSlice) String() string {
 	var s []string
 	f.Each(func(e enc, v interface{}) error {
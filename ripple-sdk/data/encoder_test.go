@@ -0,0 +1,56 @@
+package data
+
+import (
+	"bytes"
+	"testing"
+)
+
+// A MultiSignPayment encodes as the Payment with the same fields
+func TestRawMultiSignTransaction(t *testing.T) {
+	payment := testPayment(t, "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh")
+	multi := &MultiSignPayment{
+		MultiSignTxBase: MultiSignTxBase{
+			TransactionType: payment.TransactionType,
+			Account:         payment.Account,
+			Sequence:        payment.Sequence,
+			Fee:             payment.Fee,
+		},
+		Destination: payment.Destination,
+		Amount:      payment.Amount,
+	}
+	for _, signing := range []bool{false, true} {
+		want, err := encodeRaw(payment, signing)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := encodeRaw(multi, signing)
+		if err != nil {
+			t.Fatalf("signing %v: %v", signing, err)
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("signing %v:\n got %X\nwant %X", signing, got, want)
+		}
+	}
+
+	tx, err := ReadTransaction(bytes.NewReader(mustRaw(t, multi)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if read, ok := tx.(*Payment); !ok || !read.Destination.Equals(payment.Destination) {
+		t.Errorf("read back %+v", tx)
+	}
+}
+
+func encodeRaw(h Hashable, ignoreSigningFields bool) ([]byte, error) {
+	var b bytes.Buffer
+	err := writeRaw(&b, h, ignoreSigningFields)
+	return b.Bytes(), err
+}
+
+func mustRaw(t *testing.T, h Hashable) []byte {
+	_, raw, err := Raw(h)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return raw
+}
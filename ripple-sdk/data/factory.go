@@ -21,7 +21,11 @@ const (
 	FEE_SETTINGS       LedgerEntryType = 0x73 // 's'
 	ESCROW             LedgerEntryType = 0x75 // 'u'
 	PAY_CHANNEL        LedgerEntryType = 0x78 // 'x'
-	CHECK              LedgerEntryType = 0x63 // 'C'
+	CHECK              LedgerEntryType = 0x43 // 'C'
+	NFTOKEN_OFFER      LedgerEntryType = 0x37 // '7'
+	NFTOKEN_PAGE       LedgerEntryType = 0x50 // 'P'
+	AMM                LedgerEntryType = 0x79 // 'y'
+	DEPOSIT_PREAUTH    LedgerEntryType = 0x70 // 'p'
 	UNKNOW_LEDGER_TYPE LedgerEntryType = math.MaxUint16 - 1
 
 	// TransactionType values come from rippled's "TxFormats.h"
@@ -34,7 +38,6 @@ const (
 	OFFER_CREATE    TransactionType = 7
 	OFFER_CANCEL    TransactionType = 8
 	TICKET_CREATE   TransactionType = 10
-	TICKET_CANCEL   TransactionType = 11
 	SIGNER_LIST_SET TransactionType = 12
 	PAYCHAN_CREATE  TransactionType = 13
 	PAYCHAN_FUND    TransactionType = 14
@@ -42,7 +45,20 @@ const (
 	CHECK_CREATE    TransactionType = 16
 	CHECK_CASH      TransactionType = 17
 	CHECK_CANCEL    TransactionType = 18
+	PREAUTHORIZE    TransactionType = 19
 	TRUST_SET       TransactionType = 20
+	ACCOUNT_DELETE  TransactionType = 21
+	NFTOKEN_MINT    TransactionType = 25
+	NFTOKEN_BURN    TransactionType = 26
+	NFTOKEN_CREATE  TransactionType = 27
+	NFTOKEN_CANCEL  TransactionType = 28
+	NFTOKEN_ACCEPT  TransactionType = 29
+	AMM_CREATE      TransactionType = 35
+	AMM_DEPOSIT     TransactionType = 36
+	AMM_WITHDRAW    TransactionType = 37
+	AMM_VOTE        TransactionType = 38
+	AMM_BID         TransactionType = 39
+	AMM_DELETE      TransactionType = 40
 	AMENDMENT       TransactionType = 100
 	SET_FEE         TransactionType = 101
 	UNKNOW_TX_TYPE  TransactionType = math.MaxUint16
@@ -65,6 +81,10 @@ var LedgerEntryFactory = [...]func() LedgerEntry{
 	TICKET:             func() LedgerEntry { return &Ticket{leBase: leBase{LedgerEntryType: TICKET}} },
 	PAY_CHANNEL:        func() LedgerEntry { return &PayChannel{leBase: leBase{LedgerEntryType: PAY_CHANNEL}} },
 	CHECK:              func() LedgerEntry { return &Check{leBase: leBase{LedgerEntryType: CHECK}} },
+	NFTOKEN_OFFER:      func() LedgerEntry { return &NFTokenOffer{leBase: leBase{LedgerEntryType: NFTOKEN_OFFER}} },
+	NFTOKEN_PAGE:       func() LedgerEntry { return &NFTokenPage{leBase: leBase{LedgerEntryType: NFTOKEN_PAGE}} },
+	AMM:                func() LedgerEntry { return &AMMRoot{leBase: leBase{LedgerEntryType: AMM}} },
+	DEPOSIT_PREAUTH:    func() LedgerEntry { return &DepositPreauthEntry{leBase: leBase{LedgerEntryType: DEPOSIT_PREAUTH}} },
 	UNKNOW_LEDGER_TYPE: func() LedgerEntry { return &UnknowLedger{leBase: leBase{LedgerEntryType: UNKNOW_LEDGER_TYPE}} },
 }
 
@@ -75,6 +95,7 @@ var TxFactory = [...]func() Transaction{
 	OFFER_CREATE:    func() Transaction { return &OfferCreate{TxBase: TxBase{TransactionType: OFFER_CREATE}} },
 	OFFER_CANCEL:    func() Transaction { return &OfferCancel{TxBase: TxBase{TransactionType: OFFER_CANCEL}} },
 	TRUST_SET:       func() Transaction { return &TrustSet{TxBase: TxBase{TransactionType: TRUST_SET}} },
+	ACCOUNT_DELETE:  func() Transaction { return &AccountDelete{TxBase: TxBase{TransactionType: ACCOUNT_DELETE}} },
 	AMENDMENT:       func() Transaction { return &Amendment{TxBase: TxBase{TransactionType: AMENDMENT}} },
 	SET_FEE:         func() Transaction { return &SetFee{TxBase: TxBase{TransactionType: SET_FEE}} },
 	ESCROW_CREATE:   func() Transaction { return &EscrowCreate{TxBase: TxBase{TransactionType: ESCROW_CREATE}} },
@@ -87,46 +108,68 @@ var TxFactory = [...]func() Transaction{
 	CHECK_CREATE:    func() Transaction { return &CheckCreate{TxBase: TxBase{TransactionType: CHECK_CREATE}} },
 	CHECK_CASH:      func() Transaction { return &CheckCash{TxBase: TxBase{TransactionType: CHECK_CASH}} },
 	CHECK_CANCEL:    func() Transaction { return &CheckCancel{TxBase: TxBase{TransactionType: CHECK_CANCEL}} },
+	PREAUTHORIZE:    func() Transaction { return &DepositPreauth{TxBase: TxBase{TransactionType: PREAUTHORIZE}} },
+	TICKET_CREATE:   func() Transaction { return &TicketCreate{TxBase: TxBase{TransactionType: TICKET_CREATE}} },
+	NFTOKEN_MINT:    func() Transaction { return &NFTokenMint{TxBase: TxBase{TransactionType: NFTOKEN_MINT}} },
+	NFTOKEN_BURN:    func() Transaction { return &NFTokenBurn{TxBase: TxBase{TransactionType: NFTOKEN_BURN}} },
+	NFTOKEN_CREATE:  func() Transaction { return &NFTokenCreateOffer{TxBase: TxBase{TransactionType: NFTOKEN_CREATE}} },
+	NFTOKEN_CANCEL:  func() Transaction { return &NFTokenCancelOffer{TxBase: TxBase{TransactionType: NFTOKEN_CANCEL}} },
+	NFTOKEN_ACCEPT:  func() Transaction { return &NFTokenAcceptOffer{TxBase: TxBase{TransactionType: NFTOKEN_ACCEPT}} },
+	AMM_CREATE:      func() Transaction { return &AMMCreate{TxBase: TxBase{TransactionType: AMM_CREATE}} },
+	AMM_DEPOSIT:     func() Transaction { return &AMMDeposit{TxBase: TxBase{TransactionType: AMM_DEPOSIT}} },
+	AMM_WITHDRAW:    func() Transaction { return &AMMWithdraw{TxBase: TxBase{TransactionType: AMM_WITHDRAW}} },
+	AMM_VOTE:        func() Transaction { return &AMMVote{TxBase: TxBase{TransactionType: AMM_VOTE}} },
+	AMM_BID:         func() Transaction { return &AMMBid{TxBase: TxBase{TransactionType: AMM_BID}} },
+	AMM_DELETE:      func() Transaction { return &AMMDelete{TxBase: TxBase{TransactionType: AMM_DELETE}} },
 	UNKNOW_TX_TYPE:  func() Transaction { return &UnknowTx{TxBase: TxBase{TransactionType: UNKNOW_TX_TYPE}} },
 }
 
-var ledgerEntryNames = [...]string{
-	ACCOUNT_ROOT:  "AccountRoot",
-	DIRECTORY:     "DirectoryNode",
-	AMENDMENTS:    "Amendments",
-	LEDGER_HASHES: "LedgerHashes",
-	OFFER:         "Offer",
-	RIPPLE_STATE:  "RippleState",
-	FEE_SETTINGS:  "FeeSettings",
-	ESCROW:        "Escrow",
-	SIGNER_LIST:   "SignerList",
-	TICKET:        "Ticket",
-	PAY_CHANNEL:   "PayChannel",
-	CHECK:         "Check",
+var ledgerEntryNames = map[LedgerEntryType]string{
+	ACCOUNT_ROOT:    "AccountRoot",
+	DIRECTORY:       "DirectoryNode",
+	AMENDMENTS:      "Amendments",
+	LEDGER_HASHES:   "LedgerHashes",
+	OFFER:           "Offer",
+	RIPPLE_STATE:    "RippleState",
+	FEE_SETTINGS:    "FeeSettings",
+	ESCROW:          "Escrow",
+	SIGNER_LIST:     "SignerList",
+	TICKET:          "Ticket",
+	PAY_CHANNEL:     "PayChannel",
+	CHECK:           "Check",
+	NFTOKEN_OFFER:   "NFTokenOffer",
+	NFTOKEN_PAGE:    "NFTokenPage",
+	AMM:             "AMM",
+	DEPOSIT_PREAUTH: "DepositPreauth",
 }
 
 var ledgerEntryTypes = map[string]LedgerEntryType{
-	"AccountRoot":   ACCOUNT_ROOT,
-	"DirectoryNode": DIRECTORY,
-	"Amendments":    AMENDMENTS,
-	"LedgerHashes":  LEDGER_HASHES,
-	"Offer":         OFFER,
-	"RippleState":   RIPPLE_STATE,
-	"FeeSettings":   FEE_SETTINGS,
-	"Escrow":        ESCROW,
-	"SignerList":    SIGNER_LIST,
-	"Ticket":        TICKET,
-	"PayChannel":    PAY_CHANNEL,
-	"Check":         CHECK,
+	"AccountRoot":    ACCOUNT_ROOT,
+	"DirectoryNode":  DIRECTORY,
+	"Amendments":     AMENDMENTS,
+	"LedgerHashes":   LEDGER_HASHES,
+	"Offer":          OFFER,
+	"RippleState":    RIPPLE_STATE,
+	"FeeSettings":    FEE_SETTINGS,
+	"Escrow":         ESCROW,
+	"SignerList":     SIGNER_LIST,
+	"Ticket":         TICKET,
+	"PayChannel":     PAY_CHANNEL,
+	"Check":          CHECK,
+	"NFTokenOffer":   NFTOKEN_OFFER,
+	"NFTokenPage":    NFTOKEN_PAGE,
+	"AMM":            AMM,
+	"DepositPreauth": DEPOSIT_PREAUTH,
 }
 
-var txNames = [...]string{
+var txNames = map[TransactionType]string{
 	PAYMENT:         "Payment",
 	ACCOUNT_SET:     "AccountSet",
 	SET_REGULAR_KEY: "SetRegularKey",
 	OFFER_CREATE:    "OfferCreate",
 	OFFER_CANCEL:    "OfferCancel",
 	TRUST_SET:       "TrustSet",
+	ACCOUNT_DELETE:  "AccountDelete",
 	AMENDMENT:       "EnableAmendment",
 	SET_FEE:         "SetFee",
 	ESCROW_CREATE:   "EscrowCreate",
@@ -139,6 +182,19 @@ var txNames = [...]string{
 	CHECK_CREATE:    "CheckCreate",
 	CHECK_CASH:      "CheckCash",
 	CHECK_CANCEL:    "CheckCancel",
+	PREAUTHORIZE:    "DepositPreauth",
+	TICKET_CREATE:   "TicketCreate",
+	NFTOKEN_MINT:    "NFTokenMint",
+	NFTOKEN_BURN:    "NFTokenBurn",
+	NFTOKEN_CREATE:  "NFTokenCreateOffer",
+	NFTOKEN_CANCEL:  "NFTokenCancelOffer",
+	NFTOKEN_ACCEPT:  "NFTokenAcceptOffer",
+	AMM_CREATE:      "AMMCreate",
+	AMM_DEPOSIT:     "AMMDeposit",
+	AMM_WITHDRAW:    "AMMWithdraw",
+	AMM_VOTE:        "AMMVote",
+	AMM_BID:         "AMMBid",
+	AMM_DELETE:      "AMMDelete",
 }
 
 var txTypes = map[string]TransactionType{
@@ -148,6 +204,7 @@ var txTypes = map[string]TransactionType{
 	"OfferCreate":          OFFER_CREATE,
 	"OfferCancel":          OFFER_CANCEL,
 	"TrustSet":             TRUST_SET,
+	"AccountDelete":        ACCOUNT_DELETE,
 	"EnableAmendment":      AMENDMENT,
 	"SetFee":               SET_FEE,
 	"EscrowCreate":         ESCROW_CREATE,
@@ -160,6 +217,19 @@ var txTypes = map[string]TransactionType{
 	"CheckCreate":          CHECK_CREATE,
 	"CheckCash":            CHECK_CASH,
 	"CheckCancel":          CHECK_CANCEL,
+	"DepositPreauth":       PREAUTHORIZE,
+	"TicketCreate":         TICKET_CREATE,
+	"NFTokenMint":          NFTOKEN_MINT,
+	"NFTokenBurn":          NFTOKEN_BURN,
+	"NFTokenCreateOffer":   NFTOKEN_CREATE,
+	"NFTokenCancelOffer":   NFTOKEN_CANCEL,
+	"NFTokenAcceptOffer":   NFTOKEN_ACCEPT,
+	"AMMCreate":            AMM_CREATE,
+	"AMMDeposit":           AMM_DEPOSIT,
+	"AMMWithdraw":          AMM_WITHDRAW,
+	"AMMVote":              AMM_VOTE,
+	"AMMBid":               AMM_BID,
+	"AMMDelete":            AMM_DELETE,
 }
 
 var HashableTypes []string
@@ -187,10 +257,34 @@ func (le LedgerEntryType) String() string {
 	return ledgerEntryNames[le]
 }
 
+// GetTxFactoryByType returns a factory for UnknowTx when txType is
+// neither a known name nor a number
 func GetTxFactoryByType(txType string) func() Transaction {
-	return TxFactory[txTypes[txType]]
+	var typ TransactionType
+	typ.UnmarshalText([]byte(txType))
+	return func() Transaction { return newTransaction(typ) }
 }
 
+// GetLedgerEntryFactoryByType returns a factory for UnknowLedger when
+// leType is neither a known name nor a number
 func GetLedgerEntryFactoryByType(leType string) func() LedgerEntry {
-	return LedgerEntryFactory[ledgerEntryTypes[leType]]
+	var typ LedgerEntryType
+	typ.UnmarshalText([]byte(leType))
+	return func() LedgerEntry { return newLedgerEntry(typ) }
+}
+
+// newTransaction is a GenericTx for types with no factory
+func newTransaction(typ TransactionType) Transaction {
+	if int(typ) < len(TxFactory) && TxFactory[typ] != nil {
+		return TxFactory[typ]()
+	}
+	return &GenericTx{TxBase: TxBase{TransactionType: typ}}
+}
+
+// newLedgerEntry is a GenericLedgerEntry for types with no factory
+func newLedgerEntry(typ LedgerEntryType) LedgerEntry {
+	if int(typ) < len(LedgerEntryFactory) && LedgerEntryFactory[typ] != nil {
+		return LedgerEntryFactory[typ]()
+	}
+	return &GenericLedgerEntry{leBase: leBase{LedgerEntryType: typ}}
 }
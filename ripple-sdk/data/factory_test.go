@@ -0,0 +1,41 @@
+package data
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+func TestUnknownTypes(t *testing.T) {
+	tx := GetTxFactoryByType("FutureTransaction")()
+	if unknown, ok := tx.(*UnknowTx); !ok || unknown.TransactionType != UNKNOW_TX_TYPE {
+		t.Errorf("unknown transaction type gave %T %v", tx, tx.GetTransactionType())
+	}
+	le := GetLedgerEntryFactoryByType("FutureEntry")()
+	if unknown, ok := le.(*UnknowLedger); !ok || unknown.LedgerEntryType != UNKNOW_LEDGER_TYPE {
+		t.Errorf("unknown ledger entry type gave %T %v", le, le.GetLedgerEntryType())
+	}
+	var result TransactionResult
+	if err := result.UnmarshalText([]byte("tecFUTURE_RESULT")); err != nil || result != tesUNKNOWN_TYPE {
+		t.Errorf("unknown result gave %v, %v", result, err)
+	}
+}
+
+func TestNewUnknowLedger(t *testing.T) {
+	blob, err := hex.DecodeString(nfTokenPageBlob)
+	if err != nil {
+		t.Fatal(err)
+	}
+	le, err := NewUnknowLedger(blob)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if le.LedgerEntryType != NFTOKEN_PAGE {
+		t.Errorf("LedgerEntryType is %v", le.LedgerEntryType)
+	}
+	if le.LedgerIndex.String() != nfTokenPageBlob[len(nfTokenPageBlob)-64:] {
+		t.Errorf("LedgerIndex is %s", le.LedgerIndex)
+	}
+	if len(le.Binary) != len(blob)-32 {
+		t.Errorf("Binary is %d bytes, want %d", len(le.Binary), len(blob)-32)
+	}
+}
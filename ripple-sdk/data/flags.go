@@ -54,6 +54,25 @@ const (
 	// PaymentChannelClaim flags
 	TxRenew TransactionFlag = 0x00010000
 	TxClose TransactionFlag = 0x00020000
+
+	// NFTokenMint flags
+	TxBurnable     TransactionFlag = 0x00000001
+	TxOnlyXRP      TransactionFlag = 0x00000002
+	TxTrustLine    TransactionFlag = 0x00000004
+	TxTransferable TransactionFlag = 0x00000008
+
+	// NFTokenCreateOffer flags
+	TxSellNFToken TransactionFlag = 0x00000001
+
+	// AMMDeposit and AMMWithdraw flags
+	TxLPToken             TransactionFlag = 0x00010000
+	TxWithdrawAll         TransactionFlag = 0x00020000 // AMMWithdraw only
+	TxOneAssetWithdrawAll TransactionFlag = 0x00040000 // AMMWithdraw only
+	TxSingleAsset         TransactionFlag = 0x00080000
+	TxTwoAsset            TransactionFlag = 0x00100000
+	TxOneAssetLPToken     TransactionFlag = 0x00200000
+	TxLimitLPToken        TransactionFlag = 0x00400000
+	TxTwoAssetIfEmpty     TransactionFlag = 0x00800000 // AMMDeposit only
 )
 
 // Ledger entry flags
@@ -67,6 +86,7 @@ const (
 	LsNoFreeze       LedgerEntryFlag = 0x00200000
 	LsGlobalFreeze   LedgerEntryFlag = 0x00400000
 	LsDefaultRipple  LedgerEntryFlag = 0x00800000
+	LsDepositAuth    LedgerEntryFlag = 0x01000000
 
 	// Offer flags
 	LsPassive LedgerEntryFlag = 0x00010000
@@ -81,6 +101,10 @@ const (
 	LsHighNoRipple LedgerEntryFlag = 0x00200000
 	LsLowFreeze    LedgerEntryFlag = 0x00400000
 	LsHighFreeze   LedgerEntryFlag = 0x00800000
+	LsAMMNode      LedgerEntryFlag = 0x01000000
+
+	// NFTokenOffer flags
+	LsSellNFToken LedgerEntryFlag = 0x00000001
 )
 
 var txFlagNames = map[TransactionType][]struct {
@@ -119,6 +143,32 @@ var txFlagNames = map[TransactionType][]struct {
 		{TxSetFreeze, "SetFreeze"},
 		{TxClearFreeze, "ClearFreeze"},
 	},
+	NFTOKEN_MINT: {
+		{TxBurnable, "Burnable"},
+		{TxOnlyXRP, "OnlyXRP"},
+		{TxTrustLine, "TrustLine"},
+		{TxTransferable, "Transferable"},
+	},
+	NFTOKEN_CREATE: {
+		{TxSellNFToken, "SellNFToken"},
+	},
+	AMM_DEPOSIT: {
+		{TxLPToken, "LPToken"},
+		{TxSingleAsset, "SingleAsset"},
+		{TxTwoAsset, "TwoAsset"},
+		{TxOneAssetLPToken, "OneAssetLPToken"},
+		{TxLimitLPToken, "LimitLPToken"},
+		{TxTwoAssetIfEmpty, "TwoAssetIfEmpty"},
+	},
+	AMM_WITHDRAW: {
+		{TxLPToken, "LPToken"},
+		{TxWithdrawAll, "WithdrawAll"},
+		{TxOneAssetWithdrawAll, "OneAssetWithdrawAll"},
+		{TxSingleAsset, "SingleAsset"},
+		{TxTwoAsset, "TwoAsset"},
+		{TxOneAssetLPToken, "OneAssetLPToken"},
+		{TxLimitLPToken, "LimitLPToken"},
+	},
 }
 
 var leFlagNames = map[LedgerEntryType][]struct {
@@ -132,6 +182,7 @@ var leFlagNames = map[LedgerEntryType][]struct {
 		{LsDisallowXRP, "DisallowXRP"},
 		{LsDisableMaster, "DisableMaster"},
 		{LsNoFreeze, "NoFreeze"},
+		{LsDepositAuth, "DepositAuth"},
 	},
 	OFFER: {
 		{LsPassive, "Passive"},
@@ -146,6 +197,10 @@ var leFlagNames = map[LedgerEntryType][]struct {
 		{LsHighNoRipple, "HighNoRipple"},
 		{LsLowFreeze, "LowFreeze"},
 		{LsHighFreeze, "HighFreeze"},
+		{LsAMMNode, "AMMNode"},
+	},
+	NFTOKEN_OFFER: {
+		{LsSellNFToken, "SellNFToken"},
 	},
 }
 
@@ -0,0 +1,98 @@
+package data
+
+import (
+	"bytes"
+	"testing"
+)
+
+// Fuzz targets, which read with a StrictReader with the
+// DefaultDecodeLimits. Anything which is read must be written and read
+// again the same.
+//
+//	go test -fuzz FuzzReadTransaction ./ripple-sdk/data
+
+func fuzzSeedTransactions(f *testing.F) [][]byte {
+	var seeds [][]byte
+	for _, tx := range []Transaction{
+		testPayment(f, "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh"),
+		func() Transaction {
+			tx := testPayment(f, "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh")
+			networkID := uint32(21337)
+			tx.Fields = Fields{"NetworkID": &networkID, "Unknown:2:60": &networkID}
+			return tx
+		}(),
+	} {
+		_, raw, err := Raw(tx)
+		if err != nil {
+			f.Fatal(err)
+		}
+		seeds = append(seeds, raw)
+	}
+	return seeds
+}
+
+func FuzzReadTransaction(f *testing.F) {
+	for _, seed := range fuzzSeedTransactions(f) {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, in []byte) {
+		tx, err := ReadTransaction(NewStrictReader(bytes.NewReader(in), DefaultDecodeLimits))
+		if err != nil {
+			return
+		}
+		checkRoundTrip(t, tx, func(r Reader) (Hashable, error) { return ReadTransaction(r) })
+	})
+}
+
+// FuzzReadLedgerEntry reads a ledger entry suffixed with its index, as in
+// ReadLedgerEntry
+func FuzzReadLedgerEntry(f *testing.F) {
+	for _, seed := range []string{nfTokenPageBlob, mpTokenIssuanceBlob, mpTokenBlob, bridgeBlob, oracleBlob} {
+		f.Add(mustDecodeHex(f, seed))
+	}
+	f.Fuzz(func(t *testing.T, in []byte) {
+		le, err := ReadLedgerEntry(NewStrictReader(bytes.NewReader(in), DefaultDecodeLimits), Hash256{})
+		if err != nil {
+			return
+		}
+		checkRoundTrip(t, le, func(r Reader) (Hashable, error) { return ReadLedgerEntry(r, Hash256{}) })
+	})
+}
+
+// FuzzReadWire reads a transaction with metadata, or any other node,
+// prefixed with its hash prefix, as in ReadWire
+func FuzzReadWire(f *testing.F) {
+	for _, seed := range fuzzSeedTransactions(f) {
+		tx, err := ReadTransaction(bytes.NewReader(seed))
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(testTransactionNode(f, tx))
+	}
+	var leaf bytes.Buffer
+	if err := write(&leaf, HP_LEAF_NODE); err != nil {
+		f.Fatal(err)
+	}
+	f.Add(append(leaf.Bytes(), mustDecodeHex(f, nfTokenPageBlob)...))
+	f.Fuzz(func(t *testing.T, in []byte) {
+		ReadWire(NewStrictReader(bytes.NewReader(in), DefaultDecodeLimits), NT_TRANSACTION_NODE, 0, Hash256{})
+	})
+}
+
+func checkRoundTrip(t *testing.T, h Hashable, read func(Reader) (Hashable, error)) {
+	_, b, err := Raw(h)
+	if err != nil {
+		return
+	}
+	again, err := read(NewStrictReader(bytes.NewReader(b), DefaultDecodeLimits))
+	if err != nil {
+		t.Fatalf("written as %X, which does not read: %v", b, err)
+	}
+	_, c, err := Raw(again)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(b, c) {
+		t.Fatalf("written as %X, then as %X after reading", b, c)
+	}
+}
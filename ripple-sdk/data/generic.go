@@ -0,0 +1,247 @@
+package data
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Fields holds fields by name, for objects, types and fields which have no
+// Go type. Values are pointers to the Go type for the field's type, such as
+// *uint32, *Uint64Hex, *Number or *Amount, except that objects are Fields
+// and arrays are []Fields, each element of which has the name of the inner
+// object as its only field. The UInt64 fields which rippled writes in
+// decimal are *Uint64Dec.
+//
+// Transactions, ledger entries and metadata keep the fields they were read
+// with but have no Go field for in their Fields, and write them back in
+// Raw. Fields of a known type which the definitions do not name are held
+// by their type and nth, such as "Unknown:2:60", and JSON members which are
+// not fields at all are held as json.RawMessage, which is only written back
+// in JSON. In JSON they are written back by TransactionWithMetaData,
+// AffectedNode and the generic types, but not by json.Marshal of a
+// transaction or ledger entry of another type.
+type Fields map[string]interface{}
+
+// GenericTx is a transaction of a type which has no Go type. Fields holds
+// all but the common fields.
+type GenericTx struct {
+	TxBase
+}
+
+// GenericLedgerEntry is a ledger entry of a type which has no Go type. Its
+// index cannot be worked out, so LedgerIndex must be set for it to be
+// encoded.
+type GenericLedgerEntry struct {
+	leBase
+}
+
+func (g *GenericLedgerEntry) Affects(account Account) bool {
+	for _, name := range []string{"Account", "Owner", "Destination"} {
+		if a, ok := g.Fields[name].(*Account); ok && a.Equals(account) {
+			return true
+		}
+	}
+	return false
+}
+
+var fieldsType = reflect.TypeOf(Fields(nil))
+
+// fieldsOf returns the Fields of the struct which v points to, for fields
+// which the struct does not have, or nil if it has none
+func fieldsOf(v *reflect.Value) Fields {
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return nil
+	}
+	f := v.Elem().FieldByName("Fields")
+	if !f.IsValid() || f.Type() != fieldsType {
+		return nil
+	}
+	if f.IsNil() {
+		f.Set(reflect.MakeMap(fieldsType))
+	}
+	return f.Interface().(Fields)
+}
+
+// baseTenFields are the UInt64 fields which rippled writes in decimal,
+// rather than hex, in json
+var baseTenFields = map[string]bool{
+	"MaximumAmount":     true,
+	"OutstandingAmount": true,
+	"MPTAmount":         true,
+}
+
+func newFieldValue(e enc) (interface{}, error) {
+	switch e.typ {
+	case ST_UINT8:
+		return new(uint8), nil
+	case ST_UINT16:
+		return new(uint16), nil
+	case ST_UINT32:
+		return new(uint32), nil
+	case ST_UINT64:
+		if baseTenFields[encodings[e]] {
+			return new(Uint64Dec), nil
+		}
+		return new(Uint64Hex), nil
+	case ST_INT32:
+		return new(int32), nil
+	case ST_INT64:
+		return new(int64), nil
+	case ST_NUMBER:
+		return new(Number), nil
+	case ST_UINT96:
+		return new(Hash96), nil
+	case ST_HASH128:
+		return new(Hash128), nil
+	case ST_HASH160:
+		return new(Hash160), nil
+	case ST_HASH192:
+		return new(Hash192), nil
+	case ST_HASH256:
+		return new(Hash256), nil
+	case ST_UINT384:
+		return new(Hash384), nil
+	case ST_UINT512:
+		return new(Hash512), nil
+	case ST_CURRENCY:
+		return new(Currency), nil
+	case ST_XCHAIN_BRIDGE:
+		return new(XChainBridge), nil
+	case ST_AMOUNT:
+		return new(Amount), nil
+	case ST_VL:
+		return new(VariableLength), nil
+	case ST_ACCOUNT:
+		return new(Account), nil
+	case ST_PATHSET:
+		return new(PathSet), nil
+	case ST_VECTOR256:
+		return new(Vector256), nil
+	case ST_ISSUE:
+		return new(Issue), nil
+	default:
+		return nil, fmt.Errorf("Unsupported type: %d for field: %s", e.typ, encodings[e])
+	}
+}
+
+// fieldName is the name a field is held by in Fields
+func fieldName(e enc) string {
+	if name, ok := encodings[e]; ok {
+		return name
+	}
+	return fmt.Sprintf("Unknown:%d:%d", e.typ, e.field)
+}
+
+// fieldEncoding is the encoding of a name in Fields, if it has one
+func fieldEncoding(name string) (enc, bool) {
+	if e, ok := reverseEncodings[name]; ok {
+		return e, true
+	}
+	var e enc
+	if _, err := fmt.Sscanf(name, "Unknown:%d:%d", &e.typ, &e.field); err != nil || fieldName(e) != name {
+		return enc{}, false
+	}
+	return e, true
+}
+
+// readField reads the value of a field as Fields holds it
+func readField(r Reader, e enc) (interface{}, error) {
+	switch e.typ {
+	case ST_OBJECT:
+		return readFields(r)
+	case ST_ARRAY:
+		leave, err := enter(r)
+		if err != nil {
+			return nil, err
+		}
+		defer leave()
+		var array []Fields
+		for {
+			inner, err := readEncoding(r)
+			if err != nil {
+				return nil, err
+			}
+			name := fieldName(*inner)
+			if name == "ArrayEndMarker" {
+				return array, nil
+			}
+			if inner.typ != ST_OBJECT {
+				return nil, fmt.Errorf("Unexpected field: %s in array: %s", name, encodings[e])
+			}
+			fields, err := readFields(r)
+			if err != nil {
+				return nil, err
+			}
+			array = append(array, Fields{name: fields})
+			if err := checkArrayLength(r, len(array), &e); err != nil {
+				return nil, err
+			}
+		}
+	}
+	value, err := newFieldValue(e)
+	if err != nil {
+		return nil, err
+	}
+	switch v := value.(type) {
+	case Wire:
+		err = v.Unmarshal(r)
+	default:
+		err = read(r, v)
+	}
+	return value, err
+}
+
+// readFields reads an inner object up to its ObjectEndMarker
+func readFields(r Reader) (Fields, error) {
+	leave, err := enter(r)
+	if err != nil {
+		return nil, err
+	}
+	defer leave()
+	fields := make(Fields)
+	var last *enc
+	for {
+		e, err := readEncoding(r)
+		if err != nil {
+			return nil, err
+		}
+		name := fieldName(*e)
+		if name == "ObjectEndMarker" {
+			return fields, nil
+		}
+		if err := checkOrder(r, last, e); err != nil {
+			return nil, err
+		}
+		last = e
+		if fields[name], err = readField(r, *e); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (f Fields) fieldSlice() fieldSlice {
+	fields := make(fieldSlice, 0, len(f))
+	for name, value := range f {
+		e, ok := fieldEncoding(name)
+		if !ok {
+			continue
+		}
+		switch v := value.(type) {
+		case Fields:
+			children := v.fieldSlice()
+			children.Append(reverseEncodings["ObjectEndMarker"], nil, nil)
+			fields.Append(e, nil, children)
+		case []Fields:
+			var children fieldSlice
+			for _, inner := range v {
+				children = append(children, inner.fieldSlice()...)
+			}
+			children.Append(reverseEncodings["ArrayEndMarker"], nil, nil)
+			fields.Append(e, nil, children)
+		default:
+			fields.Append(e, value, nil)
+		}
+	}
+	fields.Sort()
+	return fields
+}
@@ -0,0 +1,132 @@
+package data
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestUnknownFieldsRoundTrip(t *testing.T) {
+	tx := testPayment(t, "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh")
+	u32, h := uint32(7), Hash256{31: 1}
+	tx.Fields = Fields{
+		"Unknown:2:60":  &u32, // a UInt32 which the definitions do not name
+		"Unknown:5:99":  &h,
+		"Unknown:14:99": Fields{"Unknown:2:61": &u32},
+	}
+	_, raw, err := Raw(tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range [][]byte{{0x20, 0x3C, 0, 0, 0, 7}, {0x50, 0x63}, {0xE0, 0x63, 0x20, 0x3D, 0, 0, 0, 7, 0xE1}} {
+		if !bytes.Contains(raw, want) {
+			t.Errorf("%X is not in %X", want, raw)
+		}
+	}
+	for _, generated := range []bool{false, true} {
+		useGeneratedCodec(t, generated)
+		read, err := ReadTransaction(NewStrictReader(bytes.NewReader(raw), DefaultDecodeLimits))
+		if err != nil {
+			t.Fatalf("generated %v: %v", generated, err)
+		}
+		fields := read.(*Payment).Fields
+		if v, ok := fields["Unknown:2:60"].(*uint32); !ok || *v != 7 {
+			t.Errorf("generated %v: Unknown:2:60 is %v", generated, fields["Unknown:2:60"])
+		}
+		if v, ok := fields["Unknown:5:99"].(*Hash256); !ok || *v != h {
+			t.Errorf("generated %v: Unknown:5:99 is %v", generated, fields["Unknown:5:99"])
+		}
+		_, again, err := Raw(read)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(again, raw) {
+			t.Errorf("generated %v: round trip\n got %X\nwant %X", generated, again, raw)
+		}
+
+		j, err := json.Marshal(&TransactionWithMetaData{Transaction: read})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var txm TransactionWithMetaData
+		if err := json.Unmarshal(j, &txm); err != nil {
+			t.Fatalf("generated %v: %v in %s", generated, err, j)
+		}
+		if _, again, err = Raw(txm.Transaction); err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(again, raw) {
+			t.Errorf("generated %v: JSON round trip of %s\n got %X\nwant %X", generated, j, again, raw)
+		}
+	}
+}
+
+func TestUnknownJSONMembers(t *testing.T) {
+	const tx = `{"Account":"rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh","Amount":"1000000",` +
+		`"Destination":"rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe","Fee":"12","Sequence":1,"TransactionType":"Payment",` +
+		`"ctid":"C000000100000000","validated":true,"FutureMember":{"a":[1,2]},` +
+		`"hash":"0000000000000000000000000000000000000000000000000000000000000000",` +
+		`"date":1,"inLedger":2,"ledger_index":2,"meta":{"AffectedNodes":[],"TransactionIndex":0,"TransactionResult":"tesSUCCESS"}}`
+	var txm TransactionWithMetaData
+	if err := json.Unmarshal([]byte(tx), &txm); err != nil {
+		t.Fatal(err)
+	}
+	fields := txm.Transaction.(*Payment).Fields
+	if len(fields) != 3 {
+		t.Errorf("Fields are %v", fields)
+	}
+	if raw, ok := fields["FutureMember"].(json.RawMessage); !ok || string(raw) != `{"a":[1,2]}` {
+		t.Errorf("FutureMember is %v", fields["FutureMember"])
+	}
+
+	// They are written back in JSON, once, but not in binary
+	j, err := json.Marshal(txm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{`"ctid":"C000000100000000"`, `"validated":true`, `"FutureMember":{"a":[1,2]}`, `"inLedger":2`} {
+		if strings.Count(string(j), want) != 1 {
+			t.Errorf("%s is not in %s once", want, j)
+		}
+	}
+	_, raw, err := Raw(txm.Transaction)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, want, err := Raw(testPayment(t, "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(raw, want) {
+		t.Errorf("Raw is %X, want %X", raw, want)
+	}
+}
+
+func TestTransactionWithMetaDataLedger(t *testing.T) {
+	txm := TransactionWithMetaData{Transaction: testPayment(t, "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh")}
+	j, err := json.Marshal(txm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, member := range []string{`"inLedger"`, `"ledger_index"`, `"date"`} {
+		if strings.Contains(string(j), member) {
+			t.Errorf("%s is in %s", member, j)
+		}
+	}
+	var again TransactionWithMetaData
+	if err := json.Unmarshal(j, &again); err != nil {
+		t.Fatalf("%v in %s", err, j)
+	}
+	if again.LedgerSequence != 0 {
+		t.Errorf("LedgerSequence is %d", again.LedgerSequence)
+	}
+
+	txm.LedgerSequence = 90000000
+	if j, err = json.Marshal(txm); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(j), `"inLedger":90000000,"ledger_index":90000000`) {
+		t.Errorf("ledger is not in %s", j)
+	}
+}
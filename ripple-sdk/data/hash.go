@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/goodwood511/ripple_lib/rubblelabs/ripple/crypto"
+	"github.com/goodwood511/ripple_lib/ripple-sdk/crypto"
 )
 
 type KeyType int
@@ -27,9 +27,13 @@ func (keyType KeyType) String() string {
 	}
 }
 
+type Hash96 [12]byte
 type Hash128 [16]byte
 type Hash160 [20]byte
+type Hash192 [24]byte
 type Hash256 [32]byte
+type Hash384 [48]byte
+type Hash512 [64]byte
 type Vector256 []Hash256
 type VariableLength []byte
 type PublicKey [33]byte
@@ -42,6 +46,17 @@ var zeroAccount Account
 var zeroPublicKey PublicKey
 var zeroSeed Seed
 
+func (h *Hash96) Bytes() []byte {
+	if h == nil {
+		return nil
+	}
+	return h[:]
+}
+
+func (h Hash96) String() string {
+	return string(b2h(h[:]))
+}
+
 func (h *Hash128) Bytes() []byte {
 	if h == nil {
 		return nil
@@ -82,6 +97,43 @@ func (h *Hash160) Currency() *Currency {
 	return &c
 }
 
+func (h *Hash192) Bytes() []byte {
+	if h == nil {
+		return nil
+	}
+	return h[:]
+}
+
+func (h Hash192) String() string {
+	return string(b2h(h[:]))
+}
+
+func (h Hash192) IsZero() bool {
+	return h == Hash192{}
+}
+
+func (h *Hash384) Bytes() []byte {
+	if h == nil {
+		return nil
+	}
+	return h[:]
+}
+
+func (h Hash384) String() string {
+	return string(b2h(h[:]))
+}
+
+func (h *Hash512) Bytes() []byte {
+	if h == nil {
+		return nil
+	}
+	return h[:]
+}
+
+func (h Hash512) String() string {
+	return string(b2h(h[:]))
+}
+
 // Accepts either a hex string or a byte slice of length 32
 func NewHash256(value interface{}) (*Hash256, error) {
 	var h Hash256
@@ -194,6 +246,40 @@ func NewAccountFromAddress(s string) (*Account, error) {
 	return &account, nil
 }
 
+// NewAccountFromXAddress expects an X-address, returning its destination
+// tag, which is nil if it has none, and whether it is for a test network
+func NewAccountFromXAddress(s string) (*Account, *uint32, bool, error) {
+	id, tag, test, err := crypto.DecodeXAddress(s)
+	if err != nil {
+		return nil, nil, false, err
+	}
+	var account Account
+	copy(account[:], id)
+	return &account, tag, test, nil
+}
+
+// NewAccountAndTagFromAddress expects a classic address or an X-address of
+// any network, see NewAccountFromXAddress to check which. The tag is that
+// of an X-address, or nil.
+func NewAccountAndTagFromAddress(s string) (*Account, *uint32, error) {
+	if !crypto.IsXAddress(s) {
+		account, err := NewAccountFromAddress(s)
+		return account, nil, err
+	}
+	account, tag, _, err := NewAccountFromXAddress(s)
+	return account, tag, err
+}
+
+// XAddress is the X-address of the account with the given tag, which may be
+// nil, for the main network or, if test is true, for a test network
+func (a Account) XAddress(tag *uint32, test bool) string {
+	address, err := crypto.EncodeXAddress(a[:], tag, test)
+	if err != nil {
+		return fmt.Sprintf("Bad Address: %s", b2h(a[:]))
+	}
+	return address
+}
+
 func (a Account) Hash() (crypto.Hash, error) {
 	return crypto.NewAccountId(a[:])
 }
@@ -276,6 +362,71 @@ func NewSeedFromAddress(s string) (*Seed, error) {
 	return &seed, nil
 }
 
+// NewSeedFromRFC1751 expects the twelve RFC1751 words which rippled's
+// wallet_propose gives as master_key. rippled writes the seed's bytes in
+// reverse order.
+func NewSeedFromRFC1751(s string) (*Seed, error) {
+	key, err := crypto.RFC1751ToKey(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(key) != len(Seed{}) {
+		return nil, fmt.Errorf("RFC1751 seed must be 12 words, got: %d", len(key)/8*6)
+	}
+	var seed Seed
+	for i := range seed {
+		seed[i] = key[len(key)-1-i]
+	}
+	return &seed, nil
+}
+
+// RFC1751 is the seed as rippled's master_key
+func (s Seed) RFC1751() string {
+	var key Seed
+	for i := range key {
+		key[i] = s[len(s)-1-i]
+	}
+	words, _ := crypto.KeyToRFC1751(key[:])
+	return words
+}
+
+// Split splits the seed into count shares in base58 form, any threshold of
+// which recover it with NewSeedFromShares
+func (s Seed) Split(threshold, count int) ([]string, error) {
+	shares, err := crypto.SplitSecret(s[:], threshold, count)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]string, len(shares))
+	for i, share := range shares {
+		out[i] = share.String()
+	}
+	return out, nil
+}
+
+// NewSeedFromShares expects at least the threshold of shares returned by
+// Split
+func NewSeedFromShares(shares []string) (*Seed, error) {
+	hashes := make([]crypto.Hash, len(shares))
+	for i, share := range shares {
+		hash, err := crypto.NewSecretShare(share)
+		if err != nil {
+			return nil, err
+		}
+		hashes[i] = hash
+	}
+	secret, err := crypto.CombineShares(hashes)
+	if err != nil {
+		return nil, err
+	}
+	var seed Seed
+	if len(secret) != len(seed) {
+		return nil, fmt.Errorf("Shares are not of a seed")
+	}
+	copy(seed[:], secret)
+	return &seed, nil
+}
+
 func (s Seed) Hash() (crypto.Hash, error) {
 	return crypto.NewFamilySeed(s[:])
 }
@@ -28,22 +28,47 @@ func (i *NodeIndex) Next() *NodeIndex {
 func LedgerIndex(le LedgerEntry) (*Hash256, error) {
 	switch v := le.(type) {
 	case *AccountRoot:
-		return GetAccountRootIndex(*v.Account)
+		if v.Account != nil {
+			return GetAccountRootIndex(*v.Account)
+		}
 	case *RippleState:
-		return GetRippleStateIndex(v.LowLimit.Issuer, v.HighLimit.Issuer, v.Balance.Currency)
+		if v.LowLimit != nil && v.HighLimit != nil && v.Balance != nil {
+			return GetRippleStateIndex(v.LowLimit.Issuer, v.HighLimit.Issuer, v.Balance.Currency)
+		}
 	case *Offer:
-		return GetOfferIndex(*v.Account, *v.Sequence)
+		if v.Account != nil && v.Sequence != nil {
+			return GetOfferIndex(*v.Account, *v.Sequence)
+		}
 	case *LedgerHashes:
 		return GetLedgerHashIndex()
 	case *Directory:
-		return GetDirectoryNodeIndex(*v.RootIndex, v.IndexPrevious.Next())
+		if v.RootIndex != nil {
+			return GetDirectoryNodeIndex(*v.RootIndex, v.IndexPrevious.Next())
+		}
 	case *FeeSettings:
 		return buildIndex([]interface{}{NS_FEE})
 	case *Amendments:
 		return buildIndex([]interface{}{NS_AMENDMENT})
-	default:
-		return nil, fmt.Errorf("Unknown LedgerEntry")
+	case *Ticket:
+		if v.Account != nil && v.TicketSequence != nil {
+			return GetTicketIndex(*v.Account, *v.TicketSequence)
+		}
+	case *AMMRoot:
+		if v.Asset != nil && v.Asset2 != nil {
+			return GetAMMIndex(*v.Asset, *v.Asset2)
+		}
+	case *DepositPreauthEntry:
+		if v.Account != nil && v.Authorize != nil {
+			return GetDepositPreauthIndex(*v.Account, *v.Authorize)
+		}
+	}
+	// Entries read from the ledger keep the index they were read with, for
+	// those which it cannot be worked out for, such as NFTokenPage,
+	// NFTokenOffer and GenericLedgerEntry, or which lack the fields for it
+	if index := le.GetLedgerIndex(); index != nil {
+		return index, nil
 	}
+	return nil, fmt.Errorf("Missing index for %s", le.GetType())
 }
 
 func GetAccountRootIndex(account Account) (*Hash256, error) {
@@ -54,6 +79,68 @@ func GetOfferIndex(account Account, sequence uint32) (*Hash256, error) {
 	return buildIndex([]interface{}{NS_OFFER, account.Bytes(), sequence})
 }
 
+func GetNFTokenOfferIndex(account Account, sequence uint32) (*Hash256, error) {
+	return buildIndex([]interface{}{NS_NFTOKEN_OFFER, account.Bytes(), sequence})
+}
+
+// The directory of buy offers for an NFToken
+func GetNFTokenBuyOffersIndex(id Hash256) (*Hash256, error) {
+	return buildIndex([]interface{}{NS_NFTOKEN_BUYS, id})
+}
+
+// The directory of sell offers for an NFToken
+func GetNFTokenSellOffersIndex(id Hash256) (*Hash256, error) {
+	return buildIndex([]interface{}{NS_NFTOKEN_SELLS, id})
+}
+
+// NFTokenPage indexes are not hashed. They are the owner's account
+// followed by the low 96 bits of the greatest NFTokenID on the page, so an
+// account's pages lie between GetNFTokenPageMinIndex and
+// GetNFTokenPageMaxIndex, the last page always having the max index.
+func GetNFTokenPageMinIndex(owner Account) *Hash256 {
+	var index Hash256
+	copy(index[:], owner.Bytes())
+	return &index
+}
+
+func GetNFTokenPageMaxIndex(owner Account) *Hash256 {
+	index := GetNFTokenPageMinIndex(owner)
+	for i := len(owner); i < len(index); i++ {
+		index[i] = 0xFF
+	}
+	return index
+}
+
+// GetNFTokenPageIndex is the least index of a page which could hold id.
+// The token is on the first of the owner's pages at or after it.
+func GetNFTokenPageIndex(owner Account, id Hash256) *Hash256 {
+	index := GetNFTokenPageMinIndex(owner)
+	copy(index[len(owner):], id[len(owner):])
+	return index
+}
+
+// The AMM for a pair of assets, in either order
+func GetAMMIndex(a, b Issue) (*Hash256, error) {
+	if b.Less(a) {
+		a, b = b, a
+	}
+	return buildIndex([]interface{}{NS_AMM, a.Issuer.Bytes(), a.Currency.Bytes(), b.Issuer.Bytes(), b.Currency.Bytes()})
+}
+
+func GetTicketIndex(account Account, ticketSequence uint32) (*Hash256, error) {
+	return buildIndex([]interface{}{NS_TICKET, account.Bytes(), ticketSequence})
+}
+
+// The DepositPreauth entry letting authorized send to owner
+func GetDepositPreauthIndex(owner, authorized Account) (*Hash256, error) {
+	return buildIndex([]interface{}{NS_DEPOSIT_PREAUTH, owner.Bytes(), authorized.Bytes()})
+}
+
+// The SignerList of an account, with the only SignerListID rippled uses
+func GetSignerListIndex(account Account) (*Hash256, error) {
+	return buildIndex([]interface{}{NS_SIGNER_LIST, account.Bytes(), uint32(0)})
+}
+
 func GetRippleStateIndex(a, b Account, c Currency) (*Hash256, error) {
 	if bytes.Compare(a.Bytes(), b.Bytes()) < 0 {
 		return buildIndex([]interface{}{NS_RIPPLE_STATE, a.Bytes(), b.Bytes(), c.Bytes()})
@@ -0,0 +1,26 @@
+//go:build codecgen
+
+// Codecgen writes codec_gen.go, the generated encoders and decoders of
+// package data. It is run by go generate in ripple-sdk/data.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"log"
+	"os"
+
+	"github.com/goodwood511/ripple_lib/ripple-sdk/data"
+)
+
+func main() {
+	out := flag.String("o", "codec_gen.go", "file to write")
+	flag.Parse()
+	var buf bytes.Buffer
+	if err := data.GenerateCodec(&buf); err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*out, buf.Bytes(), 0644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"reflect"
 	"regexp"
 	"strconv"
 	"strings"
@@ -46,6 +47,10 @@ func (l *Ledger) UnmarshalJSON(b []byte) error {
 // when found in tx API call
 type txmNormal TransactionWithMetaData
 
+// txmNames are the members of a transaction with metadata which
+// TransactionWithMetaData writes, other than its fields
+var txmNames = []string{"inLedger", "metaData"}
+
 var (
 	txmSplitTypeRegex       = regexp.MustCompile(`"tx":`)
 	txmMetaDataRegex        = regexp.MustCompile(`"metaData":`)
@@ -69,7 +74,10 @@ func (txm *TransactionWithMetaData) UnmarshalJSON(b []byte) error {
 		if err := json.Unmarshal(split.Tx, txm); err != nil {
 			return err
 		}
-		return json.Unmarshal(split.Meta, &txm.MetaData)
+		if err := json.Unmarshal(split.Meta, &txm.MetaData); err != nil {
+			return err
+		}
+		return unmarshalExtraFieldsOf(split.Meta, &txm.MetaData)
 	}
 
 	// Sniff the transaction type, and allocate the appropriate type
@@ -82,6 +90,16 @@ func (txm *TransactionWithMetaData) UnmarshalJSON(b []byte) error {
 	if err := json.Unmarshal(b, txm.Transaction); err != nil {
 		return err
 	}
+	if err := unmarshalExtraFieldsOf(b, txm.Transaction); err != nil {
+		return err
+	}
+	// The members which TransactionWithMetaData reads and writes are not the
+	// transaction's
+	if f := fieldsValue(txm.Transaction); f.IsValid() && f.Len() > 0 {
+		for _, name := range append(jsonNames(reflect.TypeOf(*txm)), txmNames...) {
+			f.SetMapIndex(reflect.ValueOf(name), reflect.Value{})
+		}
+	}
 
 	if txmMetaDataRegex.Match(b) {
 		// Transaction has the form {...fields..., "metaData":{...}}
@@ -98,7 +116,10 @@ func (txm *TransactionWithMetaData) UnmarshalJSON(b []byte) error {
 			txmNormal: (*txmNormal)(txm),
 			MetaData:  &txm.MetaData,
 		}
-		return json.Unmarshal(b, extract)
+		if err := json.Unmarshal(b, extract); err != nil {
+			return err
+		}
+		return txm.unmarshalMetaFields(b)
 	}
 
 	// Transaction has the form {...fields..., "metaData":{...}}
@@ -112,33 +133,68 @@ func (txm *TransactionWithMetaData) UnmarshalJSON(b []byte) error {
 		Date:      &txm.Date,
 		MetaData:  &txm.MetaData,
 	}
-	return json.Unmarshal(b, extract)
+	if err := json.Unmarshal(b, extract); err != nil {
+		return err
+	}
+	return txm.unmarshalMetaFields(b)
+}
+
+// unmarshalMetaFields reads the fields of the metadata in b which MetaData
+// has no Go field for
+func (txm *TransactionWithMetaData) unmarshalMetaFields(b []byte) error {
+	var meta struct {
+		Meta     json.RawMessage `json:"meta"`
+		MetaData json.RawMessage `json:"metaData"`
+	}
+	if err := json.Unmarshal(b, &meta); err != nil {
+		return err
+	}
+	if meta.MetaData != nil {
+		meta.Meta = meta.MetaData
+	}
+	// Binary metadata is a string
+	if len(meta.Meta) == 0 || meta.Meta[0] != '{' {
+		return nil
+	}
+	return unmarshalExtraFieldsOf(meta.Meta, &txm.MetaData)
 }
 
 func (txm TransactionWithMetaData) marshalJSON() ([]byte, []byte, error) {
-	tx, err := json.Marshal(txm.Transaction)
+	tx, err := marshalExtraFields(txm.Transaction)
 	if err != nil {
 		return nil, nil, err
 	}
-	meta, err := json.Marshal(txm.MetaData)
+	meta, err := marshalExtraFields(&txm.MetaData)
 	if err != nil {
 		return nil, nil, err
 	}
 	return tx, meta, nil
 }
 
-const txmFormat = `%s,"hash":"%s","inLedger":%d,"ledger_index":%d,"meta":%s}`
+// The hash is in the transaction. The date and ledger are omitted when they
+// are not known.
+const (
+	txmFormat       = `%s,"meta":%s}`
+	txmDateFormat   = `,"date":%d`
+	txmLedgerFormat = `,"inLedger":%d,"ledger_index":%d`
+)
 
 func (txm TransactionWithMetaData) MarshalJSON() ([]byte, error) {
 	tx, meta, err := txm.marshalJSON()
 	if err != nil {
 		return nil, err
 	}
-	out := fmt.Sprintf(txmFormat, string(tx[:len(tx)-1]), txm.GetHash().String(), txm.LedgerSequence, txm.LedgerSequence, string(meta))
-	return []byte(out), nil
+	out := string(tx[:len(tx)-1])
+	if date := txm.Date.Uint32(); date != 0 {
+		out += fmt.Sprintf(txmDateFormat, date)
+	}
+	if txm.LedgerSequence != 0 {
+		out += fmt.Sprintf(txmLedgerFormat, txm.LedgerSequence, txm.LedgerSequence)
+	}
+	return []byte(fmt.Sprintf(txmFormat, out, string(meta))), nil
 }
 
-const txmSliceFormat = `%s,"hash":"%s","metaData":%s}`
+const txmSliceFormat = `%s,"metaData":%s}`
 
 func (s TransactionSlice) MarshalJSON() ([]byte, error) {
 	raw := make([]json.RawMessage, len(s))
@@ -148,7 +204,7 @@ func (s TransactionSlice) MarshalJSON() ([]byte, error) {
 		if tx, meta, err = txm.marshalJSON(); err != nil {
 			return nil, err
 		}
-		extra := fmt.Sprintf(txmSliceFormat, string(tx[:len(tx)-1]), txm.GetHash().String(), meta)
+		extra := fmt.Sprintf(txmSliceFormat, string(tx[:len(tx)-1]), meta)
 		raw[i] = json.RawMessage(extra)
 	}
 	return json.Marshal(raw)
@@ -177,25 +233,283 @@ func (l *LedgerEntrySlice) UnmarshalJSON(b []byte) error {
 		if err := json.Unmarshal(raw, &le); err != nil {
 			return err
 		}
+		if err := unmarshalExtraFieldsOf(raw, le); err != nil {
+			return err
+		}
 		*l = append(*l, le)
 	}
 	return nil
 }
 
-// const leSliceFormat = `%s,"LedgerEntryType":"%s"}`
+// Each entry has its LedgerEntryType and index, and the fields it has no
+// Go field for
+func (s LedgerEntrySlice) MarshalJSON() ([]byte, error) {
+	raw := make([]json.RawMessage, len(s))
+	for i, le := range s {
+		b, err := marshalExtraFields(le)
+		if err != nil {
+			return nil, err
+		}
+		raw[i] = b
+	}
+	return json.Marshal(raw)
+}
+
+// delivered_amount is "unavailable" for transactions from before it was
+// recorded
+func (m *MetaData) UnmarshalJSON(b []byte) error {
+	type metaData MetaData
+	meta := struct {
+		*metaData
+		DeliveredAmount json.RawMessage `json:"delivered_amount,omitempty"`
+	}{metaData: (*metaData)(m)}
+	if err := json.Unmarshal(b, &meta); err != nil {
+		return err
+	}
+	if len(meta.DeliveredAmount) > 0 && string(meta.DeliveredAmount) != `"unavailable"` {
+		m.DeliveredAmount = new(Amount)
+		if err := json.Unmarshal(meta.DeliveredAmount, m.DeliveredAmount); err != nil {
+			return err
+		}
+	}
+	return unmarshalExtraFields(b, reflect.TypeOf(*m), &m.Fields)
+}
+
+// rippled writes no affected nodes as an empty array
+func (n NodeEffects) MarshalJSON() ([]byte, error) {
+	if n == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal([]NodeEffect(n))
+}
+
+type fieldDefinitionJSON FieldDefinition
+
+func (f FieldDefinition) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]interface{}{f.Name, fieldDefinitionJSON(f)})
+}
+
+func (f *FieldDefinition) UnmarshalJSON(b []byte) error {
+	var pair []json.RawMessage
+	if err := json.Unmarshal(b, &pair); err != nil {
+		return err
+	}
+	if len(pair) != 2 {
+		return fmt.Errorf("Bad field definition: %s", b)
+	}
+	if err := json.Unmarshal(pair[0], &f.Name); err != nil {
+		return err
+	}
+	return json.Unmarshal(pair[1], (*fieldDefinitionJSON)(f))
+}
+
+// unmarshalField reads the JSON value of a field as Fields holds it
+func unmarshalField(e enc, b []byte) (interface{}, error) {
+	switch e.typ {
+	case ST_OBJECT:
+		var fields Fields
+		err := json.Unmarshal(b, &fields)
+		return fields, err
+	case ST_ARRAY:
+		var array []Fields
+		err := json.Unmarshal(b, &array)
+		return array, err
+	}
+	value, err := newFieldValue(e)
+	if err != nil {
+		return nil, err
+	}
+	return value, json.Unmarshal(b, value)
+}
+
+func (f *Fields) UnmarshalJSON(b []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	*f = make(Fields, len(raw))
+	for name, value := range raw {
+		v, err := unmarshalFieldNamed(name, value)
+		if err != nil {
+			return err
+		}
+		(*f)[name] = v
+	}
+	return nil
+}
+
+// unmarshalFieldNamed reads a member of a JSON object as Fields holds it,
+// which is as it is for members which are not fields
+func unmarshalFieldNamed(name string, b json.RawMessage) (interface{}, error) {
+	e, ok := fieldEncoding(name)
+	if !ok {
+		return b, nil
+	}
+	return unmarshalField(e, b)
+}
+
+// marshalWithFields adds fields to the JSON object of base
+func marshalWithFields(base interface{}, fields Fields) ([]byte, error) {
+	b, err := json.Marshal(base)
+	if err != nil || len(fields) == 0 {
+		return b, err
+	}
+	extra, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+	return append(append(b[:len(b)-1], ','), extra[1:]...), nil
+}
+
+// unmarshalWithFields reads b into the struct base points to, and the
+// fields it has no Go field for into fields
+func unmarshalWithFields(b []byte, base interface{}, fields *Fields) error {
+	if err := json.Unmarshal(b, base); err != nil {
+		return err
+	}
+	return unmarshalExtraFields(b, reflect.TypeOf(base).Elem(), fields)
+}
+
+// unmarshalExtraFields reads the members of b which typ has no Go field for
+// into fields
+func unmarshalExtraFields(b []byte, typ reflect.Type, fields *Fields) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	for _, name := range jsonNames(typ) {
+		delete(raw, name)
+	}
+	for name, value := range raw {
+		v, err := unmarshalFieldNamed(name, value)
+		if err != nil {
+			return err
+		}
+		if *fields == nil {
+			*fields = make(Fields)
+		}
+		(*fields)[name] = v
+	}
+	return nil
+}
+
+// jsonNames are the names of the fields of typ and of the structs it
+// embeds, as Go and JSON name them
+func jsonNames(typ reflect.Type) []string {
+	var names []string
+	for i := 0; i < typ.NumField(); i++ {
+		f := typ.Field(i)
+		if f.Anonymous && f.Type.Kind() == reflect.Struct {
+			names = append(names, jsonNames(f.Type)...)
+			continue
+		}
+		names = append(names, f.Name, strings.Split(f.Tag.Get("json"), ",")[0])
+	}
+	return names
+}
+
+// fieldsValue is the Fields of the transaction, ledger entry or metadata
+// v points to
+func fieldsValue(v interface{}) reflect.Value {
+	rv := reflect.Indirect(reflect.ValueOf(v))
+	if rv.Kind() != reflect.Struct {
+		return reflect.Value{}
+	}
+	if f := rv.FieldByName("Fields"); f.IsValid() && f.Type() == fieldsType {
+		return f
+	}
+	return reflect.Value{}
+}
+
+// marshalExtraFields marshals v with its Fields, unless it marshals
+// itself
+func marshalExtraFields(v interface{}) ([]byte, error) {
+	if _, ok := v.(json.Marshaler); ok {
+		return json.Marshal(v)
+	}
+	f := fieldsValue(v)
+	if !f.IsValid() || f.Len() == 0 {
+		return json.Marshal(v)
+	}
+	return marshalWithFields(v, f.Interface().(Fields))
+}
+
+// unmarshalExtraFieldsOf reads the fields in b which the struct v points to
+// has no Go field for into its Fields, unless it reads them itself
+func unmarshalExtraFieldsOf(b []byte, v interface{}) error {
+	if _, ok := v.(json.Unmarshaler); ok {
+		return nil
+	}
+	f := fieldsValue(v)
+	if !f.CanAddr() {
+		return nil
+	}
+	return unmarshalExtraFields(b, reflect.TypeOf(v).Elem(), f.Addr().Interface().(*Fields))
+}
+
+func (t GenericTx) MarshalJSON() ([]byte, error) {
+	return marshalWithFields(t.TxBase, t.Fields)
+}
+
+func (t *GenericTx) UnmarshalJSON(b []byte) error {
+	return unmarshalWithFields(b, &t.TxBase, &t.Fields)
+}
+
+func (le GenericLedgerEntry) MarshalJSON() ([]byte, error) {
+	return marshalWithFields(le.leBase, le.Fields)
+}
+
+func (le *GenericLedgerEntry) UnmarshalJSON(b []byte) error {
+	return unmarshalWithFields(b, &le.leBase, &le.Fields)
+}
+
+// UnknowTx is written with the name of its type
+func (t UnknowTx) MarshalJSON() ([]byte, error) {
+	name := t.Name
+	if name == "" {
+		name = t.TransactionType.String()
+	}
+	return marshalWithFields(struct {
+		TxBase
+		TransactionType string
+	}{t.TxBase, name}, t.Fields)
+}
 
-// func (s LedgerEntrySlice) MarshalJSON() ([]byte, error) {
-// 	var raw []json.RawMessage
-// 	for _, le := range s {
-// 		b, err := json.Marshal(le)
-// 		if err != nil {
-// 			return nil, err
-// 		}
-// 		extra := fmt.Sprintf(leSliceFormat, string(b[:len(b)-1]), le.GetLedgerEntryType())
-// 		raw = append(raw, json.RawMessage(extra))
-// 	}
-// 	return json.Marshal(raw)
-// }
+func (t *UnknowTx) UnmarshalJSON(b []byte) error {
+	var name struct{ TransactionType string }
+	if err := json.Unmarshal(b, &name); err != nil {
+		return err
+	}
+	t.Name = name.TransactionType
+	return unmarshalWithFields(b, &t.TxBase, &t.Fields)
+}
+
+// UnknowLedger is written with the name of its type
+func (le UnknowLedger) MarshalJSON() ([]byte, error) {
+	type unknowLedger UnknowLedger
+	name := le.Name
+	if name == "" {
+		text, _ := le.LedgerEntryType.MarshalText()
+		name = string(text)
+	}
+	return marshalWithFields(struct {
+		unknowLedger
+		LedgerEntryType string
+	}{unknowLedger(le), name}, le.Fields)
+}
+
+func (le *UnknowLedger) UnmarshalJSON(b []byte) error {
+	type unknowLedger UnknowLedger
+	var name struct{ LedgerEntryType string }
+	if err := json.Unmarshal(b, &name); err != nil {
+		return err
+	}
+	if err := unmarshalWithFields(b, (*unknowLedger)(le), &le.Fields); err != nil {
+		return err
+	}
+	le.Name = name.LedgerEntryType
+	return nil
+}
 
 type affectedNodeJSON struct {
 	LedgerEntryType   LedgerEntryType
@@ -219,32 +533,69 @@ func (a *AffectedNode) UnmarshalJSON(b []byte) error {
 		PreviousTxnLgrSeq: affected.PreviousTxnLgrSeq,
 	}
 	if affected.FinalFields != nil {
-		a.FinalFields = LedgerEntryFactory[a.LedgerEntryType]()
+		a.FinalFields = newLedgerEntry(a.LedgerEntryType)
 		if err := json.Unmarshal(affected.FinalFields, a.FinalFields); err != nil {
 			return err
 		}
+		if err := unmarshalExtraFieldsOf(affected.FinalFields, a.FinalFields); err != nil {
+			return err
+		}
 	}
 	if affected.PreviousFields != nil {
-		a.PreviousFields = LedgerEntryFactory[a.LedgerEntryType]()
+		a.PreviousFields = newLedgerEntry(a.LedgerEntryType)
 		if err := json.Unmarshal(affected.PreviousFields, a.PreviousFields); err != nil {
 			return err
 		}
+		if err := unmarshalExtraFieldsOf(affected.PreviousFields, a.PreviousFields); err != nil {
+			return err
+		}
 	}
 	if affected.NewFields != nil {
-		a.NewFields = LedgerEntryFactory[a.LedgerEntryType]()
+		a.NewFields = newLedgerEntry(a.LedgerEntryType)
 		if err := json.Unmarshal(affected.NewFields, a.NewFields); err != nil {
 			return err
 		}
+		if err := unmarshalExtraFieldsOf(affected.NewFields, a.NewFields); err != nil {
+			return err
+		}
 	}
 	return nil
 }
 
 const leTypeFormat = `:{"LedgerEntryType":"%s",`
 
+func marshalAffectedFields(le LedgerEntry) (json.RawMessage, error) {
+	if le == nil {
+		return nil, nil
+	}
+	return marshalExtraFields(le)
+}
+
 func (a *AffectedNode) MarshalJSON() ([]byte, error) {
-	b, err := json.Marshal(struct {
-		AffectedNode
-	}{*a})
+	var node struct {
+		FinalFields       json.RawMessage `json:",omitempty"`
+		LedgerEntryType   LedgerEntryType
+		LedgerIndex       *Hash256        `json:",omitempty"`
+		PreviousFields    json.RawMessage `json:",omitempty"`
+		NewFields         json.RawMessage `json:",omitempty"`
+		PreviousTxnID     *Hash256        `json:",omitempty"`
+		PreviousTxnLgrSeq *uint32         `json:",omitempty"`
+	}
+	node.LedgerEntryType = a.LedgerEntryType
+	node.LedgerIndex = a.LedgerIndex
+	node.PreviousTxnID = a.PreviousTxnID
+	node.PreviousTxnLgrSeq = a.PreviousTxnLgrSeq
+	var err error
+	if node.FinalFields, err = marshalAffectedFields(a.FinalFields); err != nil {
+		return nil, err
+	}
+	if node.PreviousFields, err = marshalAffectedFields(a.PreviousFields); err != nil {
+		return nil, err
+	}
+	if node.NewFields, err = marshalAffectedFields(a.NewFields); err != nil {
+		return nil, err
+	}
+	b, err := json.Marshal(node)
 	// I can only apologise
 	fixed := strings.Replace(string(b), fmt.Sprintf(leTypeFormat, a.LedgerEntryType), ":{", -1)
 	return []byte(fixed), err
@@ -285,14 +636,21 @@ func (r *TransactionResult) UnmarshalText(b []byte) error {
 	//return fmt.Errorf("Unknown TransactionResult: %s", string(b))
 }
 
+// Types with no name are written as numbers, so they can be read back
 func (l LedgerEntryType) MarshalText() ([]byte, error) {
-	return []byte(ledgerEntryNames[l]), nil
+	if name, ok := ledgerEntryNames[l]; ok {
+		return []byte(name), nil
+	}
+	return []byte(strconv.FormatUint(uint64(l), 10)), nil
 }
 
 func (l *LedgerEntryType) UnmarshalText(b []byte) error {
 	if leType, ok := ledgerEntryTypes[string(b)]; ok {
 		*l = leType
 		return nil
+	} else if n, err := strconv.ParseUint(string(b), 10, 16); err == nil {
+		*l = LedgerEntryType(n)
+		return nil
 	} else {
 		*l = UNKNOW_LEDGER_TYPE
 		return nil
@@ -301,14 +659,21 @@ func (l *LedgerEntryType) UnmarshalText(b []byte) error {
 	//return fmt.Errorf("Unknown LedgerEntryType: %s", string(b))
 }
 
+// Types with no name are written as numbers, so they can be read back
 func (t TransactionType) MarshalText() ([]byte, error) {
-	return []byte(txNames[t]), nil
+	if name, ok := txNames[t]; ok {
+		return []byte(name), nil
+	}
+	return []byte(strconv.FormatUint(uint64(t), 10)), nil
 }
 
 func (t *TransactionType) UnmarshalText(b []byte) error {
 	if txType, ok := txTypes[string(b)]; ok {
 		*t = txType
 		return nil
+	} else if n, err := strconv.ParseUint(string(b), 10, 16); err == nil {
+		*t = TransactionType(n)
+		return nil
 	} else {
 		*t = UNKNOW_TX_TYPE
 		return nil
@@ -400,6 +765,45 @@ func (a *Amount) UnmarshalJSON(b []byte) (err error) {
 	return nil
 }
 
+type issueJSON struct {
+	Currency      *Currency `json:"currency,omitempty"`
+	Issuer        *Account  `json:"issuer,omitempty"`
+	MPTIssuanceID *Hash192  `json:"mpt_issuance_id,omitempty"`
+}
+
+func (i Issue) MarshalJSON() ([]byte, error) {
+	if i.IsMPT() {
+		return json.Marshal(issueJSON{MPTIssuanceID: &i.MPTIssuanceID})
+	}
+	if i.Currency.IsNative() {
+		return json.Marshal(issueJSON{Currency: &i.Currency})
+	}
+	return json.Marshal(issueJSON{Currency: &i.Currency, Issuer: &i.Issuer})
+}
+
+func (i *Issue) UnmarshalJSON(b []byte) error {
+	var dummy issueJSON
+	if err := json.Unmarshal(b, &dummy); err != nil {
+		return err
+	}
+	*i = Issue{}
+	switch {
+	case dummy.MPTIssuanceID != nil:
+		if dummy.Currency != nil || dummy.Issuer != nil {
+			return fmt.Errorf("Issue has both a currency and an mpt_issuance_id: %s", b)
+		}
+		i.MPTIssuanceID = *dummy.MPTIssuanceID
+	case dummy.Currency == nil:
+		return fmt.Errorf("Issue has no currency: %s", b)
+	default:
+		i.Currency = *dummy.Currency
+		if dummy.Issuer != nil {
+			i.Issuer = *dummy.Issuer
+		}
+	}
+	return nil
+}
+
 func (c Currency) MarshalText() ([]byte, error) {
 	return []byte(c.Machine()), nil
 }
@@ -410,6 +814,15 @@ func (c *Currency) UnmarshalText(text []byte) error {
 	return err
 }
 
+func (h Hash96) MarshalText() ([]byte, error) {
+	return b2h(h[:]), nil
+}
+
+func (h *Hash96) UnmarshalText(b []byte) error {
+	_, err := hex.Decode(h[:], b)
+	return err
+}
+
 func (h Hash128) MarshalText() ([]byte, error) {
 	return b2h(h[:]), nil
 }
@@ -428,6 +841,33 @@ func (h *Hash160) UnmarshalText(b []byte) error {
 	return err
 }
 
+func (h Hash192) MarshalText() ([]byte, error) {
+	return b2h(h[:]), nil
+}
+
+func (h *Hash192) UnmarshalText(b []byte) error {
+	_, err := hex.Decode(h[:], b)
+	return err
+}
+
+func (h Hash384) MarshalText() ([]byte, error) {
+	return b2h(h[:]), nil
+}
+
+func (h *Hash384) UnmarshalText(b []byte) error {
+	_, err := hex.Decode(h[:], b)
+	return err
+}
+
+func (h Hash512) MarshalText() ([]byte, error) {
+	return b2h(h[:]), nil
+}
+
+func (h *Hash512) UnmarshalText(b []byte) error {
+	_, err := hex.Decode(h[:], b)
+	return err
+}
+
 func (h Hash256) MarshalText() ([]byte, error) {
 	return b2h(h[:]), nil
 }
@@ -527,6 +967,33 @@ func (h *Uint64Hex) UnmarshalText(b []byte) error {
 	return err
 }
 
+// A uint64 which gets represented as a decimal string in json, as rippled
+// does for MPT amounts
+type Uint64Dec uint64
+
+func (d Uint64Dec) MarshalText() ([]byte, error) {
+	return []byte(strconv.FormatUint(uint64(d), 10)), nil
+}
+
+func (d *Uint64Dec) UnmarshalText(b []byte) error {
+	n, err := strconv.ParseUint(string(b), 10, 64)
+	*d = Uint64Dec(n)
+	return err
+}
+
 func (keyType KeyType) MarshalText() ([]byte, error) {
 	return []byte(keyType.String()), nil
 }
+
+// Expects the names of String or rippled's secp256k1 and ed25519
+func (keyType *KeyType) UnmarshalText(b []byte) error {
+	switch strings.ToLower(string(b)) {
+	case "ecdsa", "secp256k1":
+		*keyType = ECDSA
+	case "ed25519":
+		*keyType = Ed25519
+	default:
+		return fmt.Errorf("Unknown key type: %s", b)
+	}
+	return nil
+}
@@ -1,5 +1,7 @@
 package data
 
+import "bytes"
+
 type LedgerEntrySlice []LedgerEntry
 
 type leBase struct {
@@ -9,6 +11,7 @@ type leBase struct {
 	PreviousTxnLgrSeq *uint32  `json:",omitempty"`
 	Hash              Hash256  `json:"-"`
 	Id                Hash256  `json:"-"`
+	Fields            Fields   `json:"-"` // those with no Go field
 }
 
 type AccountRoot struct {
@@ -28,6 +31,15 @@ type AccountRoot struct {
 	TransferRate  *uint32          `json:",omitempty"`
 	Domain        *VariableLength  `json:",omitempty"`
 	Signers       *VariableLength  `json:",omitempty"`
+	// NFToken fields
+	NFTokenMinter        *Account `json:",omitempty"`
+	MintedNFTokens       *uint32  `json:",omitempty"`
+	BurnedNFTokens       *uint32  `json:",omitempty"`
+	FirstNFTokenSequence *uint32  `json:",omitempty"`
+	// Set on the account of an AMM
+	AMMID *Hash256 `json:",omitempty"`
+	// Tickets the account holds
+	TicketCount *uint32 `json:",omitempty"`
 }
 
 type RippleState struct {
@@ -48,7 +60,7 @@ type Offer struct {
 	leBase
 	Flags         *LedgerEntryFlag `json:",omitempty"`
 	Account       *Account         `json:",omitempty"`
-	Sequence      *uint32          `json:"sequence,omitempty"`
+	Sequence      *uint32          `json:",omitempty"`
 	TakerPays     *Amount          `json:",omitempty"`
 	TakerGets     *Amount          `json:",omitempty"`
 	BookDirectory *Hash256         `json:",omitempty"`
@@ -81,8 +93,10 @@ type LedgerHashes struct {
 }
 
 type Majority struct {
-	Amendment *Hash256 `json:",omitempty"`
-	CloseTime *uint32  `json:",omitempty"`
+	Majority struct {
+		Amendment *Hash256 `json:",omitempty"`
+		CloseTime *uint32  `json:",omitempty"`
+	}
 }
 
 type Amendments struct {
@@ -129,18 +143,16 @@ type SignerList struct {
 	Flags         *LedgerEntryFlag `json:",omitempty"`
 	OwnerNode     *NodeIndex       `json:",omitempty"`
 	SignerQuorum  *uint32          `json:",omitempty"`
-	SignerEntries []SignerEntry    `json:",omitempty"`
+	SignerEntries []SignerEntryEx  `json:",omitempty"`
 	SignerListID  *uint32          `json:",omitempty"`
 }
 
 type Ticket struct {
 	leBase
-	Flags      *LedgerEntryFlag `json:",omitempty"`
-	Account    *Account         `json:",omitempty"`
-	Sequence   *uint32          `json:",omitempty"`
-	OwnerNode  *NodeIndex       `json:",omitempty"`
-	Target     *Account         `json:",omitempty"`
-	Expiration *uint32          `json:",omitempty"`
+	Flags          *LedgerEntryFlag `json:",omitempty"`
+	Account        *Account         `json:",omitempty"`
+	OwnerNode      *NodeIndex       `json:",omitempty"`
+	TicketSequence *uint32          `json:",omitempty"`
 }
 
 type PayChannel struct {
@@ -167,9 +179,98 @@ type Check struct {
 	SendMax     *Amount  `json:",omitempty"`
 	Sequence    *uint32  `json:",omitempty"`
 }
+
+// NFTokenPage holds up to 32 of an account's NFTokens. Its index is the
+// owner's account followed by the low 96 bits of its greatest NFTokenID.
+type NFTokenPage struct {
+	leBase
+	Flags           *LedgerEntryFlag `json:",omitempty"`
+	PreviousPageMin *Hash256         `json:",omitempty"`
+	NextPageMin     *Hash256         `json:",omitempty"`
+	NFTokens        []NFToken        `json:",omitempty"`
+}
+
+type NFTokenOffer struct {
+	leBase
+	Flags            *LedgerEntryFlag `json:",omitempty"`
+	Owner            *Account         `json:",omitempty"`
+	NFTokenID        *Hash256         `json:",omitempty"`
+	Amount           *Amount          `json:",omitempty"`
+	OwnerNode        *NodeIndex       `json:",omitempty"`
+	NFTokenOfferNode *NodeIndex       `json:",omitempty"`
+	Destination      *Account         `json:",omitempty"`
+	Expiration       *uint32          `json:",omitempty"`
+}
+
+// AMMRoot is the AMM ledger entry. The pool itself is held by Account,
+// as its XRP balance and its trust lines for the two assets.
+type AMMRoot struct {
+	leBase
+	Flags          *LedgerEntryFlag `json:",omitempty"`
+	Account        *Account         `json:",omitempty"`
+	Asset          *Issue           `json:",omitempty"`
+	Asset2         *Issue           `json:",omitempty"`
+	TradingFee     *uint16          `json:",omitempty"`
+	VoteSlots      []VoteEntry      `json:",omitempty"`
+	AuctionSlot    *AuctionSlot     `json:",omitempty"`
+	LPTokenBalance *Amount          `json:",omitempty"`
+	OwnerNode      *NodeIndex       `json:",omitempty"`
+}
+
+type VoteEntry struct {
+	VoteEntry struct {
+		Account    Account
+		TradingFee *uint16 `json:",omitempty"`
+		VoteWeight uint32
+	}
+}
+
+type AuctionSlot struct {
+	Account       Account
+	Expiration    uint32
+	DiscountedFee *uint16 `json:",omitempty"`
+	Price         Amount
+	AuthAccounts  []AuthAccount `json:",omitempty"`
+}
+
+type AuthAccount struct {
+	AuthAccount struct {
+		Account Account
+	}
+}
+
+// DepositPreauthEntry is the DepositPreauth ledger entry, which lets
+// Authorize send to Account while Account has DepositAuth set
+type DepositPreauthEntry struct {
+	leBase
+	Flags     *LedgerEntryFlag `json:",omitempty"`
+	Account   *Account         `json:",omitempty"`
+	Authorize *Account         `json:",omitempty"`
+	OwnerNode *NodeIndex       `json:",omitempty"`
+}
+
+// UnknowLedger is a ledger entry read from JSON whose LedgerEntryType is
+// neither known nor a number, or one which could not be read from binary.
+// Name is the LedgerEntryType and Binary the entry as it was read without
+// its index, which Raw writes back.
 type UnknowLedger struct {
 	leBase
-	Account *Account `json:",omitempty"`
+	Account *Account       `json:",omitempty"`
+	Name    string         `json:"-"`
+	Binary  VariableLength `json:"data,omitempty"`
+}
+
+func (p *NFTokenPage) Affects(account Account) bool {
+	return p.LedgerIndex != nil && bytes.Equal(p.LedgerIndex[:len(account)], account[:])
+}
+func (o *NFTokenOffer) Affects(account Account) bool {
+	return (o.Owner != nil && o.Owner.Equals(account)) || (o.Destination != nil && o.Destination.Equals(account))
+}
+func (a *AMMRoot) Affects(account Account) bool {
+	return a.Account != nil && a.Account.Equals(account)
+}
+func (d *DepositPreauthEntry) Affects(account Account) bool {
+	return (d.Account != nil && d.Account.Equals(account)) || (d.Authorize != nil && d.Authorize.Equals(account))
 }
 
 func (a *UnknowLedger) Affects(account Account) bool {
@@ -191,7 +292,7 @@ func (s *Escrow) Affects(account Account) bool {
 }
 func (s *SignerList) Affects(account Account) bool {
 	for _, entry := range s.SignerEntries {
-		if entry.Account != nil && entry.Account.Equals(account) {
+		if entry.SignerEntry.Account != nil && entry.SignerEntry.Account.Equals(account) {
 			return true
 		}
 	}
@@ -0,0 +1,64 @@
+package data
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+const (
+	previousPageMin = "B5F762798A53D543A014CAF8B297CFF8F2F937E8000000000000000000001A2A"
+	nextPageMin     = "B5F762798A53D543A014CAF8B297CFF8F2F937E8000000000000000000001B00"
+)
+
+// An NFTokenPage in the layout of rippled, with its index suffixed
+const nfTokenPageBlob = "1100502200000000" + // LedgerEntryType, Flags
+	"2504F3A1C2" + // PreviousTxnLgrSeq
+	"55" + "6E4A1B1C8F7A3C0D2E5B9A8C7D6E5F4A3B2C1D0E9F8A7B6C5D4E3F2A1B0C9D8E" + // PreviousTxnID
+	"501A" + previousPageMin + // PreviousPageMin
+	"501B" + nextPageMin + // NextPageMin
+	"FA" + // NFTokens
+	"EC" + "5A" + "00080000B5F762798A53D543A014CAF8B297CFF8F2F937E80000000000001A2B" +
+	"7542" + "697066733A2F2F62616679626569676479727A74357366703775646D37687537367568377932366E6633656675796C71616266336F636C67747179353566627A6469" +
+	"E1" +
+	"EC" + "5A" + "00080000B5F762798A53D543A014CAF8B297CFF8F2F937E80000000000001A2C" +
+	"E1" +
+	"F1" +
+	"B5F762798A53D543A014CAF8B297CFF8F2F937E8000000000000000000001A2C" // index
+
+func TestNFTokenPageRoundTrip(t *testing.T) {
+	blob, err := hex.DecodeString(nfTokenPageBlob)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, generated := range []bool{false, true} {
+		useGeneratedCodec(t, generated)
+		le, err := ReadLedgerEntry(NewStrictReader(bytes.NewReader(blob), DefaultDecodeLimits), Hash256{})
+		if err != nil {
+			t.Fatalf("generated %v: %v", generated, err)
+		}
+		page, ok := le.(*NFTokenPage)
+		if !ok {
+			t.Fatalf("generated %v: read %T", generated, le)
+		}
+		if page.PreviousPageMin == nil || page.PreviousPageMin.String() != previousPageMin {
+			t.Errorf("generated %v: PreviousPageMin is %v", generated, page.PreviousPageMin)
+		}
+		if page.NextPageMin == nil || page.NextPageMin.String() != nextPageMin {
+			t.Errorf("generated %v: NextPageMin is %v", generated, page.NextPageMin)
+		}
+		if len(page.NFTokens) != 2 || page.NFTokens[0].NFToken.URI == nil || page.NFTokens[1].NFToken.URI != nil {
+			t.Errorf("generated %v: NFTokens are %+v", generated, page.NFTokens)
+		}
+		if len(page.Fields) != 0 {
+			t.Errorf("generated %v: unexpected fields %v", generated, page.Fields)
+		}
+		_, raw, err := Raw(page)
+		if err != nil {
+			t.Fatalf("generated %v: %v", generated, err)
+		}
+		if !bytes.Equal(raw, blob) {
+			t.Errorf("generated %v: round trip\n got %X\nwant %X", generated, raw, blob)
+		}
+	}
+}
@@ -36,6 +36,7 @@ type MetaData struct {
 	TransactionIndex  uint32
 	TransactionResult TransactionResult
 	DeliveredAmount   *Amount `json:"delivered_amount,omitempty"`
+	Fields            Fields  `json:"-"`
 }
 
 type TransactionSlice []*TransactionWithMetaData
@@ -76,7 +77,7 @@ func (t *TransactionWithMetaData) Affects(account Account) bool {
 }
 
 func NewTransactionWithMetadata(typ TransactionType) *TransactionWithMetaData {
-	return &TransactionWithMetaData{Transaction: TxFactory[typ]()}
+	return &TransactionWithMetaData{Transaction: newTransaction(typ)}
 }
 
 // AffectedNode returns the AffectedNode, the current LedgerEntry,
@@ -95,13 +96,13 @@ func (effect *NodeEffect) AffectedNode() (*AffectedNode, LedgerEntry, LedgerEntr
 	case effect.ModifiedNode != nil && effect.ModifiedNode.FinalFields != nil:
 		node, final, state = effect.ModifiedNode, effect.ModifiedNode.FinalFields, Modified
 	case effect.ModifiedNode != nil && effect.ModifiedNode.FinalFields == nil:
-		node, final, state = effect.ModifiedNode, LedgerEntryFactory[effect.ModifiedNode.LedgerEntryType](), Modified
+		node, final, state = effect.ModifiedNode, newLedgerEntry(effect.ModifiedNode.LedgerEntryType), Modified
 	default:
 		panic(fmt.Sprintf("Unknown LedgerEntryState: %+v", effect))
 	}
 	previous = node.PreviousFields
 	if previous == nil {
-		previous = LedgerEntryFactory[final.GetLedgerEntryType()]()
+		previous = newLedgerEntry(final.GetLedgerEntryType())
 	}
 	return node, final, previous, state
 }
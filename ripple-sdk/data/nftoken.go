@@ -0,0 +1,52 @@
+package data
+
+import "encoding/binary"
+
+type NFToken struct {
+	NFToken struct {
+		NFTokenID Hash256
+		URI       *VariableLength `json:",omitempty"`
+	}
+}
+
+// NFTokenIDInfo holds the fields packed into an NFTokenID. Flags uses the
+// same bits as the NFTokenMint flags, e.g. TxBurnable and TxTransferable.
+type NFTokenIDInfo struct {
+	Flags       uint16
+	TransferFee uint16
+	Issuer      Account
+	Taxon       uint32
+	Sequence    uint32
+}
+
+// The taxon is scrambled with the sequence so that tokens with the same
+// taxon do not sort together
+func nftokenTaxonCipher(taxon, sequence uint32) uint32 {
+	return taxon ^ (384160001*sequence + 2459)
+}
+
+func ParseNFTokenID(id Hash256) NFTokenIDInfo {
+	var info NFTokenIDInfo
+	info.Flags = binary.BigEndian.Uint16(id[0:2])
+	info.TransferFee = binary.BigEndian.Uint16(id[2:4])
+	copy(info.Issuer[:], id[4:24])
+	info.Sequence = binary.BigEndian.Uint32(id[28:32])
+	info.Taxon = nftokenTaxonCipher(binary.BigEndian.Uint32(id[24:28]), info.Sequence)
+	return info
+}
+
+// NFTokenID packs info the way rippled does when minting, where Sequence
+// is the issuer's MintedNFTokens before the mint
+func (info NFTokenIDInfo) NFTokenID() Hash256 {
+	var id Hash256
+	binary.BigEndian.PutUint16(id[0:2], info.Flags)
+	binary.BigEndian.PutUint16(id[2:4], info.TransferFee)
+	copy(id[4:24], info.Issuer[:])
+	binary.BigEndian.PutUint32(id[24:28], nftokenTaxonCipher(info.Taxon, info.Sequence))
+	binary.BigEndian.PutUint32(id[28:32], info.Sequence)
+	return id
+}
+
+func (t *NFToken) Info() NFTokenIDInfo {
+	return ParseNFTokenID(t.NFToken.NFTokenID)
+}
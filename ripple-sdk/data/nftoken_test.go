@@ -0,0 +1,63 @@
+package data
+
+import (
+	"fmt"
+	"testing"
+)
+
+// The NFTokenID the XLS-20 spec and xrpl.org take apart field by field
+const testNFTokenID = "000B013A95F14B0044F78A264E41713C64B5F89242540EE2BC8B858E00000D65"
+
+func TestParseNFTokenID(t *testing.T) {
+	id, err := NewHash256(testNFTokenID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	info := ParseNFTokenID(*id)
+	if info.Flags != 0x000B || TransactionFlag(info.Flags)&TxBurnable == 0 || TransactionFlag(info.Flags)&TxTransferable == 0 {
+		t.Errorf("Flags %#04x", info.Flags)
+	}
+	if info.TransferFee != 314 {
+		t.Errorf("TransferFee %d, want 314", info.TransferFee)
+	}
+	if issuer := fmt.Sprintf("%X", info.Issuer[:]); issuer != "95F14B0044F78A264E41713C64B5F89242540EE2" {
+		t.Errorf("Issuer %s", issuer)
+	}
+	if info.Sequence != 3429 {
+		t.Errorf("Sequence %d, want 3429", info.Sequence)
+	}
+	if got := info.NFTokenID(); got != *id {
+		t.Errorf("Packed %s, want %s", got, id)
+	}
+
+	token := NFToken{}
+	token.NFToken.NFTokenID = *id
+	if token.Info() != info {
+		t.Errorf("Info %+v, want %+v", token.Info(), info)
+	}
+}
+
+func TestNFTokenTaxonCipher(t *testing.T) {
+	// The first token an issuer mints with taxon 0 has 0x99B where the
+	// taxon goes, as seen on so many tokens on the ledger
+	id, err := NewHash256("0008000095F14B0044F78A264E41713C64B5F89242540EE20000099B00000000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	info := ParseNFTokenID(*id)
+	if info.Taxon != 0 || info.Sequence != 0 || TransactionFlag(info.Flags) != TxTransferable {
+		t.Errorf("Wrong fields: %+v", info)
+	}
+
+	// The cipher is its own inverse, and scrambles the same taxon
+	// differently for each sequence
+	for _, seq := range []uint32{0, 1, 3429, 1<<32 - 1} {
+		info.Taxon, info.Sequence = 146999694, seq
+		if got := ParseNFTokenID(info.NFTokenID()); got != info {
+			t.Errorf("Sequence %d: parsed %+v, want %+v", seq, got, info)
+		}
+		if nftokenTaxonCipher(info.Taxon, seq) == nftokenTaxonCipher(info.Taxon, seq+1) {
+			t.Errorf("Sequence %d: taxon scrambled the same for the next sequence", seq)
+		}
+	}
+}
@@ -0,0 +1,157 @@
+package data
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Number is rippled's STNumber, a decimal floating point number. rippled
+// keeps the mantissa between 10^15 and 10^16, or zero with the lowest
+// exponent.
+type Number struct {
+	Mantissa int64
+	Exponent int32
+}
+
+const (
+	numberMinExponent = -32768
+	numberMaxExponent = 32768
+)
+
+var (
+	numberMinMantissa = big.NewInt(1e15)
+	numberMaxMantissa = big.NewInt(1e16 - 1)
+	numberRegex       = regexp.MustCompile(`^([-+]?)(\d+)(?:\.(\d*))?(?:[eE]([-+]?\d+))?$`)
+)
+
+var zeroNumber = Number{0, math.MinInt32}
+
+// NewNumber accepts a decimal such as "-1.5" or "15e-1" and rounds it to
+// 16 digits, as rippled does
+func NewNumber(s string) (*Number, error) {
+	m := numberRegex.FindStringSubmatch(strings.TrimSpace(s))
+	if m == nil {
+		return nil, fmt.Errorf("Bad Number: %s", s)
+	}
+	mantissa, _ := new(big.Int).SetString(m[2]+m[3], 10)
+	exponent := -len(m[3])
+	if m[4] != "" {
+		e, err := strconv.Atoi(m[4])
+		if err != nil || e < math.MinInt32 || e > math.MaxInt32 {
+			return nil, fmt.Errorf("Bad Number: %s", s)
+		}
+		exponent += e
+	}
+	if m[1] == "-" {
+		mantissa.Neg(mantissa)
+	}
+	return normalizeNumber(mantissa, exponent)
+}
+
+// normalizeNumber rounds to the nearest, ties to even, as rippled does
+func normalizeNumber(mantissa *big.Int, exponent int) (*Number, error) {
+	if mantissa.Sign() == 0 {
+		n := zeroNumber
+		return &n, nil
+	}
+	negative := mantissa.Sign() < 0
+	abs := new(big.Int).Abs(mantissa)
+	ten := big.NewInt(10)
+	for abs.Cmp(numberMinMantissa) < 0 {
+		abs.Mul(abs, ten)
+		exponent--
+	}
+	if excess := len(abs.String()) - 16; excess > 0 {
+		divisor := new(big.Int).Exp(ten, big.NewInt(int64(excess)), nil)
+		quotient, remainder := new(big.Int).QuoRem(abs, divisor, new(big.Int))
+		switch remainder.Lsh(remainder, 1).Cmp(divisor) {
+		case 1:
+			quotient.Add(quotient, big.NewInt(1))
+		case 0:
+			if quotient.Bit(0) == 1 {
+				quotient.Add(quotient, big.NewInt(1))
+			}
+		}
+		abs, exponent = quotient, exponent+excess
+		if abs.Cmp(numberMaxMantissa) > 0 {
+			abs.Quo(abs, ten)
+			exponent++
+		}
+	}
+	switch {
+	case exponent < numberMinExponent:
+		n := zeroNumber
+		return &n, nil
+	case exponent > numberMaxExponent:
+		return nil, fmt.Errorf("Number overflow: %se%d", abs, exponent)
+	}
+	n := &Number{abs.Int64(), int32(exponent)}
+	if negative {
+		n.Mantissa = -n.Mantissa
+	}
+	return n, nil
+}
+
+func (n Number) IsZero() bool {
+	return n.Mantissa == 0
+}
+
+// String is the decimal which rippled writes, in scientific notation when
+// the exponent is out of a small range
+func (n Number) String() string {
+	if n.IsZero() {
+		return "0"
+	}
+	if n.Exponent != 0 && (n.Exponent < -25 || n.Exponent > -5) {
+		return fmt.Sprintf("%de%d", n.Mantissa, n.Exponent)
+	}
+	mantissa := n.Mantissa
+	var sign string
+	if mantissa < 0 {
+		mantissa, sign = -mantissa, "-"
+	}
+	digits := strings.Repeat("0", 27) + strconv.FormatInt(mantissa, 10) + strings.Repeat("0", 23)
+	offset := int(n.Exponent) + 43
+	whole := strings.TrimLeft(digits[:offset], "0")
+	fraction := strings.TrimRight(digits[offset:], "0")
+	if whole == "" {
+		whole = "0"
+	}
+	if fraction != "" {
+		return sign + whole + "." + fraction
+	}
+	return sign + whole
+}
+
+func (n *Number) Unmarshal(r Reader) error {
+	if err := binary.Read(r, binary.BigEndian, &n.Mantissa); err != nil {
+		return err
+	}
+	return binary.Read(r, binary.BigEndian, &n.Exponent)
+}
+
+func (n *Number) Marshal(w io.Writer) error {
+	if err := binary.Write(w, binary.BigEndian, n.Mantissa); err != nil {
+		return err
+	}
+	return binary.Write(w, binary.BigEndian, n.Exponent)
+}
+
+func (n Number) MarshalText() ([]byte, error) {
+	return []byte(n.String()), nil
+}
+
+func (n *Number) UnmarshalText(b []byte) error {
+	number, err := NewNumber(string(b))
+	if err != nil {
+		return err
+	}
+	*n = *number
+	return nil
+}
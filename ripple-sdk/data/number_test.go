@@ -0,0 +1,59 @@
+package data
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestNumber(t *testing.T) {
+	for _, test := range []struct {
+		in       string
+		mantissa int64
+		exponent int32
+		out      string
+	}{
+		{"0", 0, -2147483648, "0"},
+		{"-0.000", 0, -2147483648, "0"},
+		{"1", 1000000000000000, -15, "1"},
+		{"0.1", 1000000000000000, -16, "0.1"},
+		{"-1.5", -1500000000000000, -15, "-1.5"},
+		{"15e-1", 1500000000000000, -15, "1.5"},
+		{"123456789", 1234567890000000, -7, "123456789"},
+		{"1e15", 1000000000000000, 0, "1000000000000000"},
+		{"1e16", 1000000000000000, 1, "1000000000000000e1"},
+		{"0.00001", 1000000000000000, -20, "0.00001"},
+		{"1e-11", 1000000000000000, -26, "1000000000000000e-26"},
+		// Rounded to 16 digits, ties to even
+		{"12345678901234565", 1234567890123456, 1, "1234567890123456e1"},
+		{"12345678901234575", 1234567890123458, 1, "1234567890123458e1"},
+		{"12345678901234566", 1234567890123457, 1, "1234567890123457e1"},
+		{"99999999999999999", 1000000000000000, 2, "1000000000000000e2"},
+		// Too small is zero
+		{"1e-32800", 0, -2147483648, "0"},
+	} {
+		n, err := NewNumber(test.in)
+		if err != nil {
+			t.Errorf("%s: %v", test.in, err)
+			continue
+		}
+		if n.Mantissa != test.mantissa || n.Exponent != test.exponent {
+			t.Errorf("%s is %de%d, want %de%d", test.in, n.Mantissa, n.Exponent, test.mantissa, test.exponent)
+		}
+		if n.String() != test.out {
+			t.Errorf("%s is written %s, want %s", test.in, n, test.out)
+		}
+		var buf bytes.Buffer
+		if err := n.Marshal(&buf); err != nil {
+			t.Fatal(err)
+		}
+		var read Number
+		if err := read.Unmarshal(bytes.NewReader(buf.Bytes())); err != nil || read != *n {
+			t.Errorf("%s read back as %v, %v", test.in, read, err)
+		}
+	}
+	for _, bad := range []string{"", "1.2.3", "e5", "0x10", "1e99999", "1e-"} {
+		if n, err := NewNumber(bad); err == nil {
+			t.Errorf("%q is %v", bad, n)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package data
 
 import (
+	"fmt"
 	"io"
 )
 
@@ -52,9 +53,138 @@ func (l *LimitByteReader) ReadByte() (c byte, err error) {
 }
 
 func (l *LimitByteReader) UnreadByte() error {
-	if err := l.UnreadByte(); err != nil {
+	if err := l.R.UnreadByte(); err != nil {
 		return err
 	}
 	l.N++
 	return nil
 }
+
+// DecodeLimits bound what is read through a StrictReader. A limit of 0 is
+// no limit.
+type DecodeLimits struct {
+	MaxBlobSize    int // bytes in a transaction or ledger entry
+	MaxVLLength    int // bytes in a variable length field
+	MaxDepth       int // objects and arrays nested in each other
+	MaxArrayLength int // objects in an array
+	MaxPaths       int // paths in a PathSet
+	MaxPathLength  int // elements in a path
+}
+
+// DefaultDecodeLimits are rippled's limits where it has them, and otherwise
+// well above anything a valid transaction or ledger entry needs
+var DefaultDecodeLimits = DecodeLimits{
+	MaxBlobSize:    1 << 20,
+	MaxVLLength:    1 << 16,
+	MaxDepth:       10,
+	MaxArrayLength: 1024,
+	MaxPaths:       6,
+	MaxPathLength:  8,
+}
+
+// StrictReader is for input which is not trusted, such as blobs from other
+// systems. Reading through it, the decoder keeps to the Limits and rejects
+// bytes after the transaction or ledger entry and fields which are
+// repeated or not in canonical order, none of which rippled writes.
+type StrictReader struct {
+	Reader
+	Limits DecodeLimits
+	depth  int
+}
+
+func NewStrictReader(r Reader, limits DecodeLimits) *StrictReader {
+	return &StrictReader{Reader: r, Limits: limits}
+}
+
+// strictOf returns the StrictReader which r reads from, or nil
+func strictOf(r Reader) *StrictReader {
+	for {
+		switch v := r.(type) {
+		case *StrictReader:
+			return v
+		case *LimitByteReader:
+			r = v.R
+		default:
+			return nil
+		}
+	}
+}
+
+// checkBlob checks the size of a transaction or ledger entry about to be
+// read from r
+func checkBlob(r Reader, prefix string) error {
+	if s := strictOf(r); s != nil && s.Limits.MaxBlobSize > 0 && r.Len() > s.Limits.MaxBlobSize {
+		return fmt.Errorf("%s: %d bytes is more than %d", prefix, r.Len(), s.Limits.MaxBlobSize)
+	}
+	return nil
+}
+
+// checkTrailing checks that nothing is left in r after a transaction or
+// ledger entry
+func checkTrailing(r Reader, prefix string) error {
+	if strictOf(r) != nil && r.Len() > 0 {
+		return fmt.Errorf("%s: %d trailing bytes", prefix, r.Len())
+	}
+	return nil
+}
+
+// checkVariableLength checks the length of a variable length field before
+// it is read from r
+func checkVariableLength(r Reader, length int, prefix string) error {
+	s := strictOf(r)
+	switch {
+	case s == nil:
+		return nil
+	case s.Limits.MaxVLLength > 0 && length > s.Limits.MaxVLLength:
+		return fmt.Errorf("%s: length %d is more than %d", prefix, length, s.Limits.MaxVLLength)
+	case length > r.Len():
+		return fmt.Errorf("%s: length %d is more than the %d bytes left", prefix, length, r.Len())
+	default:
+		return nil
+	}
+}
+
+// checkEnd checks that r has not ended inside an inner object or array,
+// which only the outermost object may do
+func checkEnd(r Reader) error {
+	if s := strictOf(r); s != nil && s.depth > 1 {
+		return fmt.Errorf("Object or array is cut short")
+	}
+	return nil
+}
+
+// enter is called as an object or array is read from r, and the function
+// it returns as it is left
+func enter(r Reader) (func(), error) {
+	s := strictOf(r)
+	if s == nil {
+		return func() {}, nil
+	}
+	s.depth++
+	if s.Limits.MaxDepth > 0 && s.depth > s.Limits.MaxDepth {
+		s.depth--
+		return nil, fmt.Errorf("Objects are nested more than %d deep", s.Limits.MaxDepth)
+	}
+	return func() { s.depth-- }, nil
+}
+
+// checkOrder checks that e comes after last, the previous field of the
+// same object, or nil
+func checkOrder(r Reader, last, e *enc) error {
+	switch {
+	case last == nil || strictOf(r) == nil || e.Priority() > last.Priority():
+		return nil
+	case e.Priority() == last.Priority():
+		return fmt.Errorf("Repeated field: %s", encodings[*e])
+	default:
+		return fmt.Errorf("Field: %s is out of order after: %s", encodings[*e], encodings[*last])
+	}
+}
+
+// checkArrayLength checks the number of objects read from r into an array
+func checkArrayLength(r Reader, n int, e *enc) error {
+	if s := strictOf(r); s != nil && s.Limits.MaxArrayLength > 0 && n > s.Limits.MaxArrayLength {
+		return fmt.Errorf("Array: %s has more than %d objects", encodings[*e], s.Limits.MaxArrayLength)
+	}
+	return nil
+}
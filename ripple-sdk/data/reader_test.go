@@ -0,0 +1,131 @@
+package data
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+func mustDecodeHex(t testing.TB, s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func testPathPayment(t *testing.T) []byte {
+	tx := testPayment(t, "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh")
+	var paths PathSet
+	for _, s := range []string{"rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe => USD/rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh", "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe"} {
+		path, err := NewPath(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		paths = append(paths, path)
+	}
+	tx.Paths = &paths
+	_, raw, err := Raw(tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return raw
+}
+
+// testTransactionNode is a transaction with metadata as ReadWire reads it
+func testTransactionNode(t testing.TB, tx Transaction) []byte {
+	txm := &TransactionWithMetaData{
+		Transaction: tx,
+		MetaData:    MetaData{TransactionResult: tesSUCCESS},
+	}
+	_, raw, err := Raw(txm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var node bytes.Buffer
+	if err := write(&node, HP_TRANSACTION_NODE); err != nil {
+		t.Fatal(err)
+	}
+	return append(node.Bytes(), raw...)
+}
+
+func readStrictWire(b []byte, limits DecodeLimits) error {
+	_, err := ReadWire(NewStrictReader(bytes.NewReader(b), limits), NT_TRANSACTION_NODE, 0, Hash256{})
+	return err
+}
+
+func readStrictTransaction(b []byte, limits DecodeLimits) error {
+	_, err := ReadTransaction(NewStrictReader(bytes.NewReader(b), limits))
+	return err
+}
+
+func readStrictLedgerEntry(b []byte, limits DecodeLimits) error {
+	_, err := ReadLedgerEntry(NewStrictReader(bytes.NewReader(b), limits), Hash256{})
+	return err
+}
+
+func TestDecodeLimits(t *testing.T) {
+	page := mustDecodeHex(t, nfTokenPageBlob)
+	payment := testPathPayment(t)
+	for _, test := range []struct {
+		name   string
+		read   func([]byte, DecodeLimits) error
+		blob   []byte
+		limits DecodeLimits
+		err    string
+	}{
+		{"blob size", readStrictLedgerEntry, page, DecodeLimits{MaxBlobSize: len(page) - 1}, "bytes is more than"},
+		{"VL length", readStrictLedgerEntry, page, DecodeLimits{MaxVLLength: 0x41}, "length 66 is more than 65"},
+		{"depth", readStrictLedgerEntry, page, DecodeLimits{MaxDepth: 1}, "nested more than 1 deep"},
+		{"array length", readStrictLedgerEntry, page, DecodeLimits{MaxArrayLength: 1}, "more than 1 objects"},
+		{"paths", readStrictTransaction, payment, DecodeLimits{MaxPaths: 1}, "PathSet"},
+		{"path length", readStrictTransaction, payment, DecodeLimits{MaxPathLength: 1}, "PathSet"},
+	} {
+		if err := test.read(test.blob, DefaultDecodeLimits); err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+		err := test.read(test.blob, test.limits)
+		if err == nil || !strings.Contains(err.Error(), test.err) {
+			t.Errorf("%s: %v, want %q", test.name, err, test.err)
+		}
+	}
+}
+
+func TestStrictReader(t *testing.T) {
+	payment := testPathPayment(t)
+	sequence := mustDecodeHex(t, "2400000001")
+	amount := mustDecodeHex(t, "6140000000000F4240")
+	fee := mustDecodeHex(t, "68400000000000000C")
+	if !bytes.Contains(payment, sequence) || !bytes.Contains(payment, append(amount, fee...)) {
+		t.Fatalf("unexpected payment %X", payment)
+	}
+	uri := strings.Index(nfTokenPageBlob, "7542")
+	for _, test := range []struct {
+		name string
+		read func([]byte, DecodeLimits) error
+		blob []byte
+		err  string
+	}{
+		{"trailing bytes", readStrictWire, append(testTransactionNode(t, testPayment(t, "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh")), 0), "1 trailing bytes"},
+		{"repeated field", readStrictTransaction,
+			bytes.Replace(payment, sequence, append(append([]byte(nil), sequence...), sequence...), 1), "Repeated field: Sequence"},
+		{"out of order", readStrictTransaction,
+			bytes.Replace(payment, append(amount, fee...), append(append([]byte(nil), fee...), amount...), 1), "Field: Amount is out of order after: Fee"},
+		{"VL past the end", readStrictLedgerEntry,
+			mustDecodeHex(t, nfTokenPageBlob[:uri]+"75C1FF"+nfTokenPageBlob[uri+4:]), "more than the"},
+		{"truncated", readStrictTransaction, payment[:len(payment)-1], ""},
+		{"array cut short", readStrictLedgerEntry, mustDecodeHex(t, "1100502200000000"+"FA"+"EC"+strings.Repeat("00", 32)), "cut short"},
+		{"non-canonical field", readStrictTransaction, mustDecodeHex(t, "120000"+"2002"+"00000001"), "Non-canonical field: 2"},
+	} {
+		err := test.read(test.blob, DefaultDecodeLimits)
+		if err == nil || !strings.Contains(err.Error(), test.err) {
+			t.Errorf("%s: %v, want %q", test.name, err, test.err)
+		}
+	}
+
+	// Without a StrictReader, they are read as they were before
+	if _, err := ReadTransaction(bytes.NewReader(bytes.Replace(payment, sequence, append(append([]byte(nil), sequence...), sequence...), 1))); err != nil {
+		t.Errorf("repeated field without a StrictReader: %v", err)
+	}
+}
@@ -60,8 +60,13 @@ const (
 	tecOVERSIZE
 	tecCRYPTOCONDITION_ERROR
 	tecINVARIANT_FAILED
-	tecKILLED
 	tecEXPIRED
+	tecDUPLICATE
+	tecKILLED
+)
+const (
+	tecHAS_OBLIGATIONS TransactionResult = iota + 151
+	tecTOO_SOON
 )
 
 const (
@@ -104,6 +109,7 @@ const (
 	temBAD_OFFER
 	temBAD_PATH
 	temBAD_PATH_LOOP
+	temBAD_REGKEY
 	temBAD_SEND_XRP_LIMIT
 	temBAD_SEND_XRP_MAX
 	temBAD_SEND_XRP_NO_DIRECT
@@ -124,6 +130,9 @@ const (
 	temBAD_QUORUM
 	temBAD_WEIGHT
 	temBAD_TICK_SIZE
+	temINVALID_ACCOUNT_ID
+	temCANNOT_PREAUTH_SELF
+	temINVALID_COUNT
 	temUNCERTAIN
 	temUNKNOWN
 )
@@ -141,14 +150,9 @@ const (
 	tefALREADY
 	tefBAD_ADD_AUTH
 	tefBAD_AUTH
-	tefBAD_CLAIM_ID
-	tefBAD_GEN_AUTH
 	tefBAD_LEDGER
-	tefCLAIMED
 	tefCREATED
-	tefDST_TAG_NEEDED
 	tefEXCEPTION
-	tefGEN_IN_USE
 	tefINTERNAL
 	tefNO_AUTH_REQUIRED // Can't set auth if auth is not required.
 	tefPAST_SEQ
@@ -224,18 +228,16 @@ var resultNames = map[TransactionResult]struct {
 	tecOVERSIZE:               {"tecOVERSIZE", "Object exceeded serialization limits"},
 	tecKILLED:                 {"tecKILLED", "The OfferCreate transaction specified the tfFillOrKill flag and could not be filled, so it was killed"},
 	tecEXPIRED:                {"tecEXPIRED", "The transaction tried to create an object (such as an Offer or a Check) whose provided Expiration time has already passed."},
+	tecDUPLICATE:              {"tecDUPLICATE", "Ledger object already exists."},
+	tecHAS_OBLIGATIONS:        {"tecHAS_OBLIGATIONS", "The account cannot be deleted since it has obligations."},
+	tecTOO_SOON:               {"tecTOO_SOON", "It is too early to attempt the requested operation. Please wait."},
 	tefFAILURE:                {"tefFAILURE", "Failed to apply."},
 	tefALREADY:                {"tefALREADY", "The exact transaction was already in this ledger."},
 	tefBAD_ADD_AUTH:           {"tefBAD_ADD_AUTH", "Not authorized to add account."},
 	tefBAD_AUTH:               {"tefBAD_AUTH", "Transaction's public key is not authorized."},
-	tefBAD_CLAIM_ID:           {"tefBAD_CLAIM_ID", "Malformed: Bad claim id."},
-	tefBAD_GEN_AUTH:           {"tefBAD_GEN_AUTH", "Not authorized to claim generator."},
 	tefBAD_LEDGER:             {"tefBAD_LEDGER", "Ledger in unexpected state."},
-	tefCLAIMED:                {"tefCLAIMED", "Can not claim a previously claimed account."},
 	tefCREATED:                {"tefCREATED", "Can't add an already created account."},
-	tefDST_TAG_NEEDED:         {"tefDST_TAG_NEEDED", "Destination tag required."},
 	tefEXCEPTION:              {"tefEXCEPTION", "Unexpected program state."},
-	tefGEN_IN_USE:             {"tefGEN_IN_USE", "Generator already in use."},
 	tefINTERNAL:               {"tefINTERNAL", "Internal error."},
 	tefNO_AUTH_REQUIRED:       {"tefNO_AUTH_REQUIRED", "Auth is not required."},
 	tefPAST_SEQ:               {"tefPAST_SEQ", "This sequence number has already past."},
@@ -267,6 +269,7 @@ var resultNames = map[TransactionResult]struct {
 	temBAD_OFFER:              {"temBAD_OFFER", "Malformed: Bad offer."},
 	temBAD_PATH:               {"temBAD_PATH", "Malformed: Bad path."},
 	temBAD_PATH_LOOP:          {"temBAD_PATH_LOOP", "Malformed: Loop in path."},
+	temBAD_REGKEY:             {"temBAD_REGKEY", "Malformed: Regular key cannot be same as master key."},
 	temBAD_SIGNATURE:          {"temBAD_SIGNATURE", "Malformed: Bad signature."},
 	temBAD_SRC_ACCOUNT:        {"temBAD_SRC_ACCOUNT", "Malformed: Bad source account."},
 	temBAD_TRANSFER_RATE:      {"temBAD_TRANSFER_RATE", "Malformed: Transfer rate must be >= 1.0"},
@@ -286,6 +289,9 @@ var resultNames = map[TransactionResult]struct {
 	temUNKNOWN:                {"temUNKNOWN", "The transactions requires logic not implemented yet."},
 	temDISABLED:               {"temDISABLED", "The transaction requires logic that is currently disabled."},
 	temBAD_TICK_SIZE:          {"temBAD_TICK_SIZE", "Malformed: Tick size out of range."},
+	temINVALID_ACCOUNT_ID:     {"temINVALID_ACCOUNT_ID", "Malformed: A field contains an invalid account ID."},
+	temCANNOT_PREAUTH_SELF:    {"temCANNOT_PREAUTH_SELF", "Malformed: An account may not preauthorize itself."},
+	temINVALID_COUNT:          {"temINVALID_COUNT", "Malformed: Count field outside valid range."},
 	terRETRY:                  {"terRETRY", "Retry transaction."},
 	terFUNDS_SPENT:            {"terFUNDS_SPENT", "Can't set password, password set funds already spent."},
 	terINSUF_FEE_B:            {"terINSUF_FEE_B", "Account balance can't pay fee."},
@@ -300,14 +306,13 @@ var resultNames = map[TransactionResult]struct {
 	tesUNKNOWN_TYPE:           {"terUNKNOW_TYPE", "Tx type is unknow."},
 }
 
-var reverseResults map[string]TransactionResult
-
-func init() {
-	reverseResults = make(map[string]TransactionResult)
+var reverseResults = func() map[string]TransactionResult {
+	reverse := make(map[string]TransactionResult)
 	for result, name := range resultNames {
-		reverseResults[name.Token] = result
+		reverse[name.Token] = result
 	}
-}
+	return reverse
+}()
 
 func (r TransactionResult) String() string {
 	return resultNames[r].Token
@@ -1,20 +1,28 @@
 package data
 
 import (
-	"github.com/goodwood511/ripple_lib/rubblelabs/ripple/crypto"
+	"bytes"
+	"fmt"
+	"sort"
 
-	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/goodwood511/ripple_lib/ripple-sdk/crypto"
 )
 
 // sign with secrete
 func Sign(s Signer, key crypto.Key, sequence *uint32) error {
+	return SignWithSigner(s, crypto.NewKeySigner(key, sequence))
+}
+
+// SignWithSigner signs s with a key which may be held outside the process.
+// The signature is checked against the signer's public key before use.
+func SignWithSigner(s Signer, signer crypto.KeySigner) error {
 	s.InitialiseForSigning()
-	copy(s.GetPublicKey().Bytes(), key.Public(sequence))
+	copy(s.GetPublicKey().Bytes(), signer.Public())
 	hash, msg, err := SigningHash(s)
 	if err != nil {
 		return err
 	}
-	sig, err := crypto.Sign(key.Private(sequence), hash.Bytes(), append(s.SigningPrefix().Bytes(), msg...))
+	sig, err := signWith(signer, hash.Bytes(), append(s.SigningPrefix().Bytes(), msg...))
 	if err != nil {
 		return err
 	}
@@ -27,123 +35,174 @@ func Sign(s Signer, key crypto.Key, sequence *uint32) error {
 	return nil
 }
 
+// signWith guards against a signer returning a signature for another key
+func signWith(signer crypto.KeySigner, hash, msg []byte) ([]byte, error) {
+	sig, err := signer.Sign(hash, msg)
+	if err != nil {
+		return nil, err
+	}
+	ok, err := crypto.Verify(signer.Public(), hash, msg, sig)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf("Signature does not match public key %X", signer.Public())
+	}
+	return sig, nil
+}
+
 /* Sign with privatekey...
-key: raw private key, in 33 bytes
+key: raw private key, 32 bytes for secp256k1 or 33 bytes prefixed with 0xED for Ed25519
 */
 
 func SignWithPrivKey(s Signer, key []byte) error {
-	s.InitialiseForSigning()
-
-	// ✅ 1. 解析私钥（新版）
-	privKey, _ := btcec.PrivKeyFromBytes(key)
-	pubKey := privKey.PubKey()
-
-	// ✅ 2. 设置公钥
-	copy(s.GetPublicKey().Bytes(), pubKey.SerializeCompressed())
-
-	// ✅ 3. 获取待签名 hash + raw msg
-	hash, msg, err := SigningHash(s)
+	k, err := crypto.NewKeyFromPrivate(key)
 	if err != nil {
 		return err
 	}
+	return Sign(s, k, nil)
+}
 
-	// ✅ 4. 签名（调用你项目中的 crypto.Sign 函数）
-	sig, err := crypto.Sign(privKey.Serialize(), hash.Bytes(), append(s.SigningPrefix().Bytes(), msg...))
-	if err != nil {
-		return err
+// Any Transaction can be multisigned via the Signers of its TxBase, as
+// can the older MultiSignTransaction types.
+func signersOf(s Signer) (*[]MultiSignerEntryEx, error) {
+	switch tx := s.(type) {
+	case Transaction:
+		return &tx.GetBase().Signers, nil
+	case MultiSignTransaction:
+		return &tx.GetBase().Signers, nil
+	default:
+		return nil, fmt.Errorf("%s cannot be multisigned", s.GetType())
 	}
+}
 
-	// ✅ 5. 写入签名和 hash
-	*s.GetSignature() = VariableLength(sig)
-
-	hash, _, err = Raw(s)
-	if err != nil {
-		return err
+// A multisigned transaction has an empty SigningPubKey and no TxnSignature
+func initialiseForMultiSigning(s Signer) {
+	s.InitialiseForSigning()
+	clear(s.GetPublicKey().Bytes())
+	if tx, ok := s.(Transaction); ok {
+		tx.GetBase().TxnSignature = nil
 	}
-	copy(s.GetHash().Bytes(), hash.Bytes())
-
-	return nil
 }
 
-// Todo
-func MultiSignInSerial(s Signer, key crypto.Key, sequence *uint32) error {
+// checkKeyAccount checks that the key signs for account, which must be
+// the account of its public key
+func checkKeyAccount(key crypto.Key, sequence *uint32, account Account) error {
+	pub := key.Public(sequence)
+	if !bytes.Equal(crypto.Sha256RipeMD160(pub), account.Bytes()) {
+		return fmt.Errorf("Public key %X does not belong to %s", pub, account)
+	}
 	return nil
-
 }
 
-func MultiSignInParallel(s Signer, key crypto.Key, sequence *uint32) (MultiSignerEntryEx, error) {
-
-	s.InitialiseForSigning()
+func multiSign(s Signer, keySigner crypto.KeySigner) (MultiSignerEntryEx, error) {
 	var signer MultiSignerEntryEx
-
-	copy(s.GetPublicKey().Bytes(), "") //set the SigningPubKey to ""
-	account := key.Id(sequence)
+	initialiseForMultiSigning(s)
+	publicKey := keySigner.Public()
+	account := crypto.Sha256RipeMD160(publicKey)
 	hash, msg, err := MultiSignHash(s, account)
-
-	//for ECDSA crypto,
-	sig, err := crypto.Sign(key.Private(sequence), hash.Bytes(), append(s.SigningPrefix().Bytes(), msg...))
 	if err != nil {
 		return signer, err
 	}
-
+	msg = append(append(HP_TRANSACTION_MULTSIGN.Bytes(), msg...), account...)
+	sig, err := signWith(keySigner, hash.Bytes(), msg)
+	if err != nil {
+		return signer, err
+	}
 	signer.Signer.SigningPubKey = new(PublicKey)
 	signer.Signer.TxnSignature = new(VariableLength)
-
 	*signer.GetSignature() = VariableLength(sig)
-	copy(signer.GetPublicKey().Bytes(), key.Public(sequence)) //利用
-	copy(signer.GetAccount().Bytes(), key.Id(sequence))
-
-	hash, _, err = Raw(s)
+	copy(signer.GetPublicKey().Bytes(), publicKey)
+	copy(signer.GetAccount().Bytes(), account)
+	return signer, nil
+}
 
+// AddSigners inserts signatures into the Signers of s, which rippled
+// requires to be in account order, and updates its hash. It fails if
+// any account has already signed.
+func AddSigners(s Signer, signers ...MultiSignerEntryEx) error {
+	all, err := signersOf(s)
 	if err != nil {
-		return signer, err
+		return err
 	}
-	copy(s.GetHash().Bytes(), hash.Bytes()) //copy hash
-	return signer, nil
+	for _, signer := range signers {
+		account := signer.Signer.Account
+		i := sort.Search(len(*all), func(i int) bool {
+			return !(*all)[i].Signer.Account.Less(account)
+		})
+		if i < len(*all) && (*all)[i].Signer.Account.Equals(account) {
+			return fmt.Errorf("%s has already signed", account)
+		}
+		*all = append(*all, MultiSignerEntryEx{})
+		copy((*all)[i+1:], (*all)[i:])
+		(*all)[i] = signer
+	}
+	hash, _, err := Raw(s)
+	if err != nil {
+		return err
+	}
+	copy(s.GetHash().Bytes(), hash.Bytes())
+	return nil
 }
 
-func MultiSignInParallelWithPrivKey(s Signer, key []byte) (MultiSignerEntryEx, error) {
-	s.InitialiseForSigning()
-	var signer MultiSignerEntryEx
-
-	// ✅ 清空 SigningPubKey
-	clear(s.GetPublicKey().Bytes())
+// MultiSignInSerial signs s as account, one of its multisigners, and adds
+// the signature to its Signers. s may be any Transaction or a
+// MultiSignTransaction. It fails if the key's public key does not
+// belong to account or the account has already signed.
+func MultiSignInSerial(s Signer, key crypto.Key, sequence *uint32, account Account) error {
+	if err := checkKeyAccount(key, sequence, account); err != nil {
+		return err
+	}
+	return MultiSignInSerialWithSigner(s, crypto.NewKeySigner(key, sequence))
+}
 
-	// ✅ 升级为 btcec/v2
-	privKey, _ := btcec.PrivKeyFromBytes(key)
-	pubKey := privKey.PubKey()
+// MultiSignInSerialWithSigner is MultiSignInSerial with a key which may be
+// held outside the process. The signing account is that of the signer's
+// public key, so a regular key cannot be used.
+func MultiSignInSerialWithSigner(s Signer, keySigner crypto.KeySigner) error {
+	if _, err := signersOf(s); err != nil {
+		return err
+	}
+	signer, err := multiSign(s, keySigner)
+	if err != nil {
+		return err
+	}
+	return AddSigners(s, signer)
+}
 
-	// ✅ 获取 Account (公钥哈希)
-	account := crypto.Sha256RipeMD160(pubKey.SerializeCompressed())
+// MultiSignInParallel signs s as account, one of its multisigners, and
+// returns the signature, to be combined with the others using AddSigners.
+// It fails if the key's public key does not belong to account.
+func MultiSignInParallel(s Signer, key crypto.Key, sequence *uint32, account Account) (MultiSignerEntryEx, error) {
+	if err := checkKeyAccount(key, sequence, account); err != nil {
+		return MultiSignerEntryEx{}, err
+	}
+	return MultiSignInParallelWithSigner(s, crypto.NewKeySigner(key, sequence))
+}
 
-	// ✅ 构造签名哈希
-	hash, msg, err := MultiSignHash(s, account)
+// MultiSignInParallelWithSigner is MultiSignInParallel with a key which may
+// be held outside the process
+func MultiSignInParallelWithSigner(s Signer, keySigner crypto.KeySigner) (MultiSignerEntryEx, error) {
+	signer, err := multiSign(s, keySigner)
 	if err != nil {
 		return signer, err
 	}
-
-	// ✅ 进行签名
-	sig, err := crypto.Sign(privKey.Serialize(), hash.Bytes(), append(s.SigningPrefix().Bytes(), msg...))
+	hash, _, err := Raw(s)
 	if err != nil {
 		return signer, err
 	}
+	copy(s.GetHash().Bytes(), hash.Bytes()) //copy hash
+	return signer, nil
+}
 
-	// ✅ 填充 signer 字段
-	signer.Signer.SigningPubKey = new(PublicKey)
-	signer.Signer.TxnSignature = new(VariableLength)
-
-	*signer.GetSignature() = VariableLength(sig)
-	copy(signer.GetPublicKey().Bytes(), pubKey.SerializeCompressed())
-	copy(signer.GetAccount().Bytes(), account)
-
-	// ✅ 计算最终交易 Hash
-	hash, _, err = Raw(s)
+// MultiSignInParallelWithPrivKey is MultiSignInParallel with a raw private
+// key, 32 bytes for secp256k1 or 33 bytes prefixed with 0xED for Ed25519
+func MultiSignInParallelWithPrivKey(s Signer, key []byte, account Account) (MultiSignerEntryEx, error) {
+	k, err := crypto.NewKeyFromPrivate(key)
 	if err != nil {
-		return signer, err
+		return MultiSignerEntryEx{}, err
 	}
-	copy(s.GetHash().Bytes(), hash.Bytes())
-
-	return signer, nil
+	return MultiSignInParallel(s, k, nil, account)
 }
 
 func CheckSignature(s Signer) (bool, error) {
@@ -151,5 +210,153 @@ func CheckSignature(s Signer) (bool, error) {
 	if err != nil {
 		return false, err
 	}
+	msg = append(s.SigningPrefix().Bytes(), msg...)
 	return crypto.Verify(s.GetPublicKey().Bytes(), hash.Bytes(), msg, s.GetSignature().Bytes())
 }
+
+// SignerCheck is the result of checking one entry of a transaction's Signers
+type SignerCheck struct {
+	Account Account
+	Weight  uint16 // From the SignerList, counted only when Err is nil
+	Err     error
+	// Unverifiable is set, with Err, when the signer used a key other than
+	// its master key and its RegularKey was not given
+	Unverifiable bool
+}
+
+// MultiSignCheck is the result of checking a multisigned transaction
+// against the SignerList of its account
+type MultiSignCheck struct {
+	Signers []SignerCheck
+	Weight  uint32 // Total weight of the valid signers
+	Quorum  uint32
+}
+
+func (c *MultiSignCheck) QuorumMet() bool {
+	return c.Quorum > 0 && c.Weight >= c.Quorum
+}
+
+// CheckMultiSignature verifies every signature in the Signers of s and
+// totals the weights of the valid signers listed in list, which must be
+// the SignerList of the transaction's account. Signers who are not in
+// the list, or whose signatures are invalid, are reported but not
+// counted. Signers who signed with a key other than their master key are
+// marked Unverifiable, see CheckMultiSignatureWithRegularKeys. An error is
+// returned if s is not a well formed multisigned transaction.
+func CheckMultiSignature(s Signer, list *SignerList) (*MultiSignCheck, error) {
+	return CheckMultiSignatureWithRegularKeys(s, list, nil)
+}
+
+// CheckMultiSignatureWithRegularKeys is CheckMultiSignature for signers
+// who may sign with their RegularKey. regularKeys holds the RegularKey of
+// the AccountRoot of each such signer.
+func CheckMultiSignatureWithRegularKeys(s Signer, list *SignerList, regularKeys map[Account]RegularKey) (*MultiSignCheck, error) {
+	accounts := make(map[Account]*AccountRoot, len(regularKeys))
+	for account, key := range regularKeys {
+		key := key
+		accounts[account] = &AccountRoot{RegularKey: &key}
+	}
+	return CheckMultiSignatureWithAccounts(s, list, accounts)
+}
+
+// CheckMultiSignatureWithAccounts is CheckMultiSignature given the
+// AccountRoot of each signer, as rippled checks them: a signer may sign
+// with its RegularKey, and may not sign with its master key once
+// lsfDisableMaster is set. Signers without an AccountRoot in accounts are
+// taken to have their master key enabled, and are marked Unverifiable if
+// they signed with another key.
+func CheckMultiSignatureWithAccounts(s Signer, list *SignerList, accounts map[Account]*AccountRoot) (*MultiSignCheck, error) {
+	signers, err := signersOf(s)
+	if err != nil {
+		return nil, err
+	}
+	var account Account
+	switch tx := s.(type) {
+	case Transaction:
+		account = tx.GetBase().Account
+		if sig := tx.GetBase().TxnSignature; sig != nil && len(*sig) > 0 {
+			return nil, fmt.Errorf("Transaction is both single and multisigned")
+		}
+	case MultiSignTransaction:
+		account = tx.GetBase().Account
+	}
+	switch {
+	case len(*signers) == 0:
+		return nil, fmt.Errorf("Transaction is not multisigned")
+	case s.GetPublicKey() != nil && !s.GetPublicKey().IsZero():
+		return nil, fmt.Errorf("Multisigned transaction has a SigningPubKey")
+	case list == nil || list.SignerQuorum == nil:
+		return nil, fmt.Errorf("No SignerQuorum")
+	}
+	if list.LedgerIndex != nil {
+		index, err := GetSignerListIndex(account)
+		if err != nil {
+			return nil, err
+		}
+		if *index != *list.LedgerIndex {
+			return nil, fmt.Errorf("SignerList %s does not belong to %s", list.LedgerIndex, account)
+		}
+	}
+
+	weights := make(map[Account]uint16)
+	for _, entry := range list.SignerEntries {
+		if entry.SignerEntry.Account != nil && entry.SignerEntry.SignerWeight != nil {
+			weights[*entry.SignerEntry.Account] = *entry.SignerEntry.SignerWeight
+		}
+	}
+	check := &MultiSignCheck{Quorum: *list.SignerQuorum}
+	for i, signer := range *signers {
+		if i > 0 && !(*signers)[i-1].Signer.Account.Less(signer.Signer.Account) {
+			return nil, fmt.Errorf("Signers are not in account order")
+		}
+		result := SignerCheck{Account: signer.Signer.Account}
+		result.Weight, result.Unverifiable, result.Err = checkSigner(s, account, signer, weights, accounts)
+		if result.Err == nil {
+			check.Weight += uint32(result.Weight)
+		}
+		check.Signers = append(check.Signers, result)
+	}
+	return check, nil
+}
+
+func checkSigner(s Signer, account Account, signer MultiSignerEntryEx, weights map[Account]uint16, accounts map[Account]*AccountRoot) (uint16, bool, error) {
+	signerAccount := signer.Signer.Account
+	weight, ok := weights[signerAccount]
+	switch {
+	case signerAccount.Equals(account):
+		return 0, false, fmt.Errorf("%s cannot sign for itself", signerAccount)
+	case !ok:
+		return 0, false, fmt.Errorf("%s is not in the SignerList", signerAccount)
+	case signer.GetPublicKey() == nil || signer.GetSignature() == nil:
+		return weight, false, fmt.Errorf("%s has no signature", signerAccount)
+	}
+	root := accounts[signerAccount]
+	if id := crypto.Sha256RipeMD160(signer.GetPublicKey().Bytes()); bytes.Equal(id, signerAccount.Bytes()) {
+		if root != nil && root.Flags != nil && *root.Flags&LsDisableMaster != 0 {
+			return weight, false, fmt.Errorf("%s signed with its master key, which is disabled", signerAccount)
+		}
+	} else {
+		switch {
+		case root == nil:
+			return weight, true, fmt.Errorf("%s did not sign with its master key and its RegularKey is unknown", signerAccount)
+		case root.RegularKey == nil:
+			return weight, false, fmt.Errorf("%s did not sign with its master key and has no RegularKey", signerAccount)
+		case !bytes.Equal(id, root.RegularKey.Bytes()):
+			return weight, false, fmt.Errorf("%s signed with neither its master key nor its RegularKey", signerAccount)
+		}
+	}
+	hash, msg, err := MultiSignHash(s, signerAccount.Bytes())
+	if err != nil {
+		return weight, false, err
+	}
+	msg = append(append(HP_TRANSACTION_MULTSIGN.Bytes(), msg...), signerAccount.Bytes()...)
+	valid, err := crypto.Verify(signer.GetPublicKey().Bytes(), hash.Bytes(), msg, signer.GetSignature().Bytes())
+	switch {
+	case err != nil:
+		return weight, false, err
+	case !valid:
+		return weight, false, fmt.Errorf("%s has an invalid signature", signerAccount)
+	default:
+		return weight, false, nil
+	}
+}
@@ -0,0 +1,236 @@
+package data
+
+import (
+	"testing"
+
+	"github.com/goodwood511/ripple_lib/ripple-sdk/crypto"
+)
+
+func testPayment(t testing.TB, from string) *Payment {
+	account, err := NewAccountFromAddress(from)
+	if err != nil {
+		t.Fatal(err)
+	}
+	destination, err := NewAccountFromAddress("rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe")
+	if err != nil {
+		t.Fatal(err)
+	}
+	amount, err := NewAmount(int64(1000000))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fee, err := NewNativeValue(12)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &Payment{
+		TxBase: TxBase{
+			TransactionType: PAYMENT,
+			Account:         *account,
+			Sequence:        1,
+			Fee:             *fee,
+		},
+		Destination: *destination,
+		Amount:      *amount,
+	}
+}
+
+func testSeed(t *testing.T, s string) *Seed {
+	seed, err := NewSeedFromAddress(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return seed
+}
+
+func TestMultiSignKeyAccount(t *testing.T) {
+	var sequence uint32
+	signer := testSeed(t, "snoPBrXtMeMyMHUVTgbuqAfg1SUTb")
+	other := testSeed(t, "sn259rEFXrQrWyx3Q7XneWcwV6dfL")
+	account := signer.AccountId(ECDSA, &sequence)
+
+	tx := testPayment(t, "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe")
+	if err := MultiSignInSerial(tx, other.Key(ECDSA), &sequence, account); err == nil {
+		t.Error("signed in serial as another account")
+	}
+	if len(tx.Signers) != 0 {
+		t.Errorf("rejected key added signers: %v", tx.Signers)
+	}
+	if _, err := MultiSignInParallel(tx, other.Key(ECDSA), &sequence, account); err == nil {
+		t.Error("signed in parallel as another account")
+	}
+
+	if err := MultiSignInSerial(tx, signer.Key(ECDSA), &sequence, account); err != nil {
+		t.Fatal(err)
+	}
+	if len(tx.Signers) != 1 || !tx.Signers[0].Signer.Account.Equals(account) {
+		t.Errorf("signers are %v", tx.Signers)
+	}
+	entry, err := MultiSignInParallel(tx, signer.Key(ECDSA), &sequence, account)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !entry.Signer.Account.Equals(account) {
+		t.Errorf("signed as %s", entry.Signer.Account)
+	}
+}
+
+// regularKeySign signs s for account with the key of a RegularKey, which
+// MultiSignInParallel refuses to do
+func regularKeySign(t *testing.T, s Signer, key crypto.Key, account Account) MultiSignerEntryEx {
+	hash, msg, err := MultiSignHash(s, account.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg = append(append(HP_TRANSACTION_MULTSIGN.Bytes(), msg...), account.Bytes()...)
+	sig, err := crypto.NewKeySigner(key, nil).Sign(hash.Bytes(), msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var signer MultiSignerEntryEx
+	signer.Signer.Account = account
+	signer.Signer.SigningPubKey = new(PublicKey)
+	copy(signer.Signer.SigningPubKey[:], key.Public(nil))
+	signature := VariableLength(sig)
+	signer.Signer.TxnSignature = &signature
+	return signer
+}
+
+func TestCheckMultiSignatureRegularKey(t *testing.T) {
+	var sequence uint32
+	master := testSeed(t, "snoPBrXtMeMyMHUVTgbuqAfg1SUTb")
+	regular := testSeed(t, "sn259rEFXrQrWyx3Q7XneWcwV6dfL")
+	masterAccount := master.AccountId(ECDSA, &sequence)
+	regularAccount, err := NewAccountFromAddress("rsA2LpzuawewSBQXkiju3YQTMzW13pAAdW")
+	if err != nil {
+		t.Fatal(err)
+	}
+	regularKey := regular.Key(Ed25519)
+
+	tx := testPayment(t, "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe")
+	entry, err := MultiSignInParallel(tx, master.Key(ECDSA), &sequence, masterAccount)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := AddSigners(tx, entry, regularKeySign(t, tx, regularKey, *regularAccount)); err != nil {
+		t.Fatal(err)
+	}
+
+	quorum, weight := uint32(2), uint16(1)
+	list := &SignerList{
+		SignerQuorum: &quorum,
+		SignerEntries: []SignerEntryEx{
+			{SignerEntry{Account: &masterAccount, SignerWeight: &weight}},
+			{SignerEntry{Account: regularAccount, SignerWeight: &weight}},
+		},
+	}
+	signerCheck := func(check *MultiSignCheck, account Account) SignerCheck {
+		for _, signer := range check.Signers {
+			if signer.Account.Equals(account) {
+				return signer
+			}
+		}
+		t.Fatalf("%s is not checked", account)
+		return SignerCheck{}
+	}
+
+	check, err := CheckMultiSignature(tx, list)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result := signerCheck(check, masterAccount); result.Err != nil {
+		t.Errorf("master key signer: %v", result.Err)
+	}
+	if result := signerCheck(check, *regularAccount); result.Err == nil || !result.Unverifiable {
+		t.Errorf("regular key signer without its RegularKey: %+v", result)
+	}
+	if check.QuorumMet() {
+		t.Error("quorum met with an unverifiable signer")
+	}
+
+	var key RegularKey
+	copy(key[:], regularKey.Id(nil))
+	check, err = CheckMultiSignatureWithRegularKeys(tx, list, map[Account]RegularKey{*regularAccount: key})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result := signerCheck(check, *regularAccount); result.Err != nil || result.Unverifiable {
+		t.Errorf("regular key signer: %+v", result)
+	}
+	if !check.QuorumMet() {
+		t.Errorf("weight %d does not meet quorum %d", check.Weight, check.Quorum)
+	}
+
+	// Another account's RegularKey is not this signer's
+	copy(key[:], master.Key(ECDSA).Id(&sequence))
+	check, err = CheckMultiSignatureWithRegularKeys(tx, list, map[Account]RegularKey{*regularAccount: key})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result := signerCheck(check, *regularAccount); result.Err == nil || result.Unverifiable {
+		t.Errorf("signer with the wrong RegularKey: %+v", result)
+	}
+}
+
+func TestCheckMultiSignatureDisabledMaster(t *testing.T) {
+	var sequence uint32
+	master := testSeed(t, "snoPBrXtMeMyMHUVTgbuqAfg1SUTb")
+	regular := testSeed(t, "sn259rEFXrQrWyx3Q7XneWcwV6dfL")
+	masterAccount := master.AccountId(ECDSA, &sequence)
+
+	tx := testPayment(t, "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe")
+	entry, err := MultiSignInParallel(tx, master.Key(ECDSA), &sequence, masterAccount)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := AddSigners(tx, entry); err != nil {
+		t.Fatal(err)
+	}
+	quorum, weight := uint32(1), uint16(1)
+	list := &SignerList{
+		SignerQuorum:  &quorum,
+		SignerEntries: []SignerEntryEx{{SignerEntry{Account: &masterAccount, SignerWeight: &weight}}},
+	}
+
+	var key RegularKey
+	copy(key[:], regular.Key(Ed25519).Id(nil))
+	enabled, disabled := LedgerEntryFlag(0), LsDisableMaster
+	for _, test := range []struct {
+		name  string
+		root  *AccountRoot
+		valid bool
+	}{
+		{"no AccountRoot", nil, true},
+		{"master key enabled", &AccountRoot{Flags: &enabled, RegularKey: &key}, true},
+		{"master key disabled", &AccountRoot{Flags: &disabled, RegularKey: &key}, false},
+	} {
+		accounts := map[Account]*AccountRoot{}
+		if test.root != nil {
+			accounts[masterAccount] = test.root
+		}
+		check, err := CheckMultiSignatureWithAccounts(tx, list, accounts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result := check.Signers[0]; (result.Err == nil) != test.valid || result.Unverifiable {
+			t.Errorf("%s: %+v", test.name, result)
+		}
+		if check.QuorumMet() != test.valid {
+			t.Errorf("%s: quorum met %t", test.name, check.QuorumMet())
+		}
+	}
+
+	// A signer known to have no RegularKey cannot have signed with one
+	other := regularKeySign(t, tx, regular.Key(Ed25519), masterAccount)
+	tx.Signers = nil
+	if err := AddSigners(tx, other); err != nil {
+		t.Fatal(err)
+	}
+	check, err := CheckMultiSignatureWithAccounts(tx, list, map[Account]*AccountRoot{masterAccount: {Flags: &enabled}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result := check.Signers[0]; result.Err == nil || result.Unverifiable {
+		t.Errorf("signer without a RegularKey: %+v", result)
+	}
+}
@@ -7,13 +7,16 @@ type TxBase struct {
 	Account            Account
 	Sequence           uint32
 	Fee                Value
-	AccountTxnID       *Hash256        `json:",omitempty"`
-	SigningPubKey      *PublicKey      `json:",omitempty"`
-	TxnSignature       *VariableLength `json:",omitempty"`
-	Memos              Memos           `json:",omitempty"`
-	PreviousTxnID      *Hash256        `json:",omitempty"`
-	LastLedgerSequence *uint32         `json:",omitempty"`
-	Hash               Hash256         `json:"hash"`
+	AccountTxnID       *Hash256             `json:",omitempty"`
+	SigningPubKey      *PublicKey           `json:",omitempty"`
+	TxnSignature       *VariableLength      `json:",omitempty"`
+	Signers            []MultiSignerEntryEx `json:",omitempty"`
+	Memos              Memos                `json:",omitempty"`
+	PreviousTxnID      *Hash256             `json:",omitempty"`
+	LastLedgerSequence *uint32              `json:",omitempty"`
+	TicketSequence     *uint32              `json:",omitempty"` // Sequence must be 0 when set
+	Hash               Hash256              `json:"hash,omitzero"`
+	Fields             Fields               `json:"-"` // those with no Go field
 }
 
 type Payment struct {
@@ -157,17 +160,149 @@ type CheckCancel struct {
 	CheckID Hash256
 }
 
-type TicketCreate struct {
-	Target     *Account `json:",omitempty"`
-	Expiration *uint32  `json:",omitempty"`
+// AccountDelete enabled by the DeletableAccounts amendment. It sends the
+// account's XRP to Destination and removes the account, and its fee must
+// be at least the owner reserve increment.
+// https://xrpl.org/accountdelete.html
+type AccountDelete struct {
+	TxBase
+	Destination    Account
+	DestinationTag *uint32 `json:",omitempty"`
+}
+
+// DepositPreauth enabled by the DepositPreauth amendment. Exactly one of
+// Authorize or Unauthorize must be set.
+// https://xrpl.org/depositpreauth.html
+type DepositPreauth struct {
+	TxBase
+	Authorize   *Account `json:",omitempty"`
+	Unauthorize *Account `json:",omitempty"`
+}
+
+// NFTokenMint, NFTokenBurn, NFTokenCreateOffer, NFTokenCancelOffer and
+// NFTokenAcceptOffer enabled by the NonFungibleTokensV1_1 amendment
+
+// https://xrpl.org/nftokenmint.html
+// TransferFee is in units of 1/100000, up to 50000 for 50%
+type NFTokenMint struct {
+	TxBase
+	NFTokenTaxon uint32
+	Issuer       *Account        `json:",omitempty"`
+	TransferFee  *uint16         `json:",omitempty"`
+	URI          *VariableLength `json:",omitempty"`
+	Amount       *Amount         `json:",omitempty"`
+	Destination  *Account        `json:",omitempty"`
+	Expiration   *uint32         `json:",omitempty"`
+}
+
+// https://xrpl.org/nftokenburn.html
+type NFTokenBurn struct {
+	TxBase
+	NFTokenID Hash256
+	Owner     *Account `json:",omitempty"`
+}
+
+// https://xrpl.org/nftokencreateoffer.html
+// Owner must be set for a buy offer and omitted for a sell offer
+type NFTokenCreateOffer struct {
+	TxBase
+	NFTokenID   Hash256
+	Amount      Amount
+	Owner       *Account `json:",omitempty"`
+	Destination *Account `json:",omitempty"`
+	Expiration  *uint32  `json:",omitempty"`
+}
+
+// https://xrpl.org/nftokencanceloffer.html
+type NFTokenCancelOffer struct {
+	TxBase
+	NFTokenOffers Vector256
 }
 
-type TicketCancel struct {
-	TicketID Hash256
+// https://xrpl.org/nftokenacceptoffer.html
+// Setting both offers, and optionally NFTokenBrokerFee, is brokered mode
+type NFTokenAcceptOffer struct {
+	TxBase
+	NFTokenSellOffer *Hash256 `json:",omitempty"`
+	NFTokenBuyOffer  *Hash256 `json:",omitempty"`
+	NFTokenBrokerFee *Amount  `json:",omitempty"`
+}
+
+// AMMCreate, AMMDeposit, AMMWithdraw, AMMVote, AMMBid and AMMDelete enabled
+// by the AMM amendment. TradingFee is in units of 1/100000, up to 1000 for 1%
+
+// https://xrpl.org/ammcreate.html
+type AMMCreate struct {
+	TxBase
+	Amount     Amount
+	Amount2    Amount
+	TradingFee uint16
+}
+
+// https://xrpl.org/ammdeposit.html
+// The flags select which of the optional fields are used
+type AMMDeposit struct {
+	TxBase
+	Asset      Issue
+	Asset2     Issue
+	Amount     *Amount `json:",omitempty"`
+	Amount2    *Amount `json:",omitempty"`
+	EPrice     *Amount `json:",omitempty"`
+	LPTokenOut *Amount `json:",omitempty"`
+	TradingFee *uint16 `json:",omitempty"`
+}
+
+// https://xrpl.org/ammwithdraw.html
+// The flags select which of the optional fields are used
+type AMMWithdraw struct {
+	TxBase
+	Asset     Issue
+	Asset2    Issue
+	Amount    *Amount `json:",omitempty"`
+	Amount2   *Amount `json:",omitempty"`
+	EPrice    *Amount `json:",omitempty"`
+	LPTokenIn *Amount `json:",omitempty"`
+}
+
+// https://xrpl.org/ammvote.html
+type AMMVote struct {
+	TxBase
+	Asset      Issue
+	Asset2     Issue
+	TradingFee uint16
+}
+
+// https://xrpl.org/ammbid.html
+type AMMBid struct {
+	TxBase
+	Asset        Issue
+	Asset2       Issue
+	BidMin       *Amount       `json:",omitempty"`
+	BidMax       *Amount       `json:",omitempty"`
+	AuthAccounts []AuthAccount `json:",omitempty"`
+}
+
+// https://xrpl.org/ammdelete.html
+type AMMDelete struct {
+	TxBase
+	Asset  Issue
+	Asset2 Issue
+}
+
+// TicketCreate enabled by the TicketBatch amendment. It creates TicketCount
+// tickets numbered from its Sequence + 1, and uses up that many sequence
+// numbers of its account.
+// https://xrpl.org/ticketcreate.html
+type TicketCreate struct {
+	TxBase
+	TicketCount uint32
 }
 
+// UnknowTx is a transaction read from JSON whose TransactionType is
+// neither known nor a number. Name is the TransactionType.
 type UnknowTx struct {
 	TxBase
+	Name string `json:"-"`
 }
 
 func (t *TxBase) GetBase() *TxBase                    { return t }
@@ -240,7 +375,7 @@ type MultiSignerEntry struct {
 }
 
 type MultiSignerEntryEx struct {
-	Signer MultiSignerEntry `json:"Signer, omitempty"`
+	Signer MultiSignerEntry `json:"Signer"`
 }
 
 type MultiSignTxBase struct {
@@ -256,7 +391,9 @@ type MultiSignTxBase struct {
 	Memos              Memos                `json:",omitempty"`
 	PreviousTxnID      *Hash256             `json:",omitempty"`
 	LastLedgerSequence *uint32              `json:",omitempty"`
-	Hash               Hash256              `json:"hash"`
+	TicketSequence     *uint32              `json:",omitempty"` // Sequence must be 0 when set
+	Hash               Hash256              `json:"hash,omitzero"`
+	Fields             Fields               `json:"-"` // those with no Go field
 }
 
 type MultiSignPayment struct {
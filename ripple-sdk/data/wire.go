@@ -46,6 +46,9 @@ func (a *Amount) Unmarshal(r Reader) error {
 }
 
 func (a *Amount) Marshal(w io.Writer) error {
+	if a.Value == nil {
+		return fmt.Errorf("Amount: missing value")
+	}
 	return binary.Write(w, binary.BigEndian, a.Bytes())
 }
 
@@ -57,6 +60,56 @@ func (c *Currency) Marshal(w io.Writer) error {
 	return binary.Write(w, binary.BigEndian, c.Bytes())
 }
 
+func (i *Issue) Unmarshal(r Reader) error {
+	*i = Issue{}
+	if err := unmarshalSlice(i.Currency[:], r, "Currency"); err != nil {
+		return err
+	}
+	if i.Currency.IsNative() {
+		return nil
+	}
+	if err := unmarshalSlice(i.Issuer[:], r, "Issuer"); err != nil {
+		return err
+	}
+	if i.Issuer != noAccount {
+		return nil
+	}
+	// An MPT: the issuer, noAccount and the issuance's sequence, whose
+	// bytes rippled writes reversed
+	var sequence [4]byte
+	if err := unmarshalSlice(sequence[:], r, "MPTIssuanceID"); err != nil {
+		return err
+	}
+	for j := range sequence {
+		i.MPTIssuanceID[j] = sequence[3-j]
+	}
+	copy(i.MPTIssuanceID[4:], i.Currency[:])
+	i.Currency, i.Issuer = Currency{}, Account{}
+	return nil
+}
+
+func (i *Issue) Marshal(w io.Writer) error {
+	if i.IsMPT() {
+		id := i.MPTIssuanceID
+		return write(w, append(append(id[4:], noAccount[:]...), id[3], id[2], id[1], id[0]))
+	}
+	if err := i.Currency.Marshal(w); err != nil {
+		return err
+	}
+	if i.Currency.IsNative() {
+		return nil
+	}
+	return binary.Write(w, binary.BigEndian, i.Issuer.Bytes())
+}
+
+func (h *Hash96) Unmarshal(r Reader) error {
+	return unmarshalSlice(h[:], r, "Hash96")
+}
+
+func (h *Hash96) Marshal(w io.Writer) error {
+	return binary.Write(w, binary.BigEndian, h.Bytes())
+}
+
 func (h *Hash128) Unmarshal(r Reader) error {
 	return unmarshalSlice(h[:], r, "Hash128")
 }
@@ -73,6 +126,14 @@ func (h *Hash160) Marshal(w io.Writer) error {
 	return binary.Write(w, binary.BigEndian, h.Bytes())
 }
 
+func (h *Hash192) Unmarshal(r Reader) error {
+	return unmarshalSlice(h[:], r, "Hash192")
+}
+
+func (h *Hash192) Marshal(w io.Writer) error {
+	return binary.Write(w, binary.BigEndian, h.Bytes())
+}
+
 func (h *Hash256) Unmarshal(r Reader) error {
 	return unmarshalSlice(h[:], r, "Hash256")
 }
@@ -81,11 +142,33 @@ func (h *Hash256) Marshal(w io.Writer) error {
 	return binary.Write(w, binary.BigEndian, h.Bytes())
 }
 
+func (h *Hash384) Unmarshal(r Reader) error {
+	return unmarshalSlice(h[:], r, "Hash384")
+}
+
+func (h *Hash384) Marshal(w io.Writer) error {
+	return binary.Write(w, binary.BigEndian, h.Bytes())
+}
+
+func (h *Hash512) Unmarshal(r Reader) error {
+	return unmarshalSlice(h[:], r, "Hash512")
+}
+
+func (h *Hash512) Marshal(w io.Writer) error {
+	return binary.Write(w, binary.BigEndian, h.Bytes())
+}
+
 func (v *Vector256) Unmarshal(r Reader) error {
 	length, err := readVariableLength(r)
 	if err != nil {
 		return err
 	}
+	if length%32 != 0 {
+		return fmt.Errorf("Vector256: length %d is not a multiple of 32", length)
+	}
+	if err := checkVariableLength(r, length, "Vector256"); err != nil {
+		return err
+	}
 	count := length / 32
 	*v = make(Vector256, count)
 	for i := 0; i < count; i++ {
@@ -109,6 +192,9 @@ func (v *VariableLength) Unmarshal(r Reader) error {
 	if err != nil {
 		return err
 	}
+	if err := checkVariableLength(r, length, "VariableLength"); err != nil {
+		return err
+	}
 	*v = make(VariableLength, length)
 	return unmarshalSlice(*v, r, "VariableLength")
 }
@@ -159,7 +245,11 @@ func (k *RegularKey) Marshal(w io.Writer) error {
 }
 
 func (p *PathSet) Unmarshal(r Reader) error {
+	s := strictOf(r)
 	for i := 0; ; i++ {
+		if s != nil && s.Limits.MaxPaths > 0 && i >= s.Limits.MaxPaths {
+			return fmt.Errorf("PathSet: more than %d paths", s.Limits.MaxPaths)
+		}
 		*p = append(*p, Path{})
 		for b, err := r.ReadByte(); ; b, err = r.ReadByte() {
 			entry := pathEntry(b)
@@ -172,22 +262,28 @@ func (p *PathSet) Unmarshal(r Reader) error {
 			if entry == PATH_END {
 				return nil
 			}
+			if entry&^(PATH_ACCOUNT|PATH_CURRENCY|PATH_ISSUER) != 0 {
+				return fmt.Errorf("PathSet: bad path element type: %#x", b)
+			}
+			if s != nil && s.Limits.MaxPathLength > 0 && len((*p)[i]) >= s.Limits.MaxPathLength {
+				return fmt.Errorf("PathSet: path with more than %d elements", s.Limits.MaxPathLength)
+			}
 			var pe PathElem
 			if entry&PATH_ACCOUNT > 0 {
 				pe.Account = new(Account)
-				if _, err := r.Read(pe.Account.Bytes()); err != nil {
+				if err := unmarshalSlice(pe.Account.Bytes(), r, "PathSet"); err != nil {
 					return err
 				}
 			}
 			if entry&PATH_CURRENCY > 0 {
 				pe.Currency = new(Currency)
-				if _, err := r.Read(pe.Currency.Bytes()); err != nil {
+				if err := unmarshalSlice(pe.Currency.Bytes(), r, "PathSet"); err != nil {
 					return err
 				}
 			}
 			if entry&PATH_ISSUER > 0 {
 				pe.Issuer = new(Account)
-				if _, err := r.Read(pe.Issuer.Bytes()); err != nil {
+				if err := unmarshalSlice(pe.Issuer.Bytes(), r, "PathSet"); err != nil {
 					return err
 				}
 			}
@@ -0,0 +1,40 @@
+package data
+
+import (
+	"io"
+)
+
+// XChainBridge names the door accounts and issues of both chains of a
+// cross-chain bridge
+type XChainBridge struct {
+	LockingChainDoor  Account
+	LockingChainIssue Issue
+	IssuingChainDoor  Account
+	IssuingChainIssue Issue
+}
+
+func (b *XChainBridge) Unmarshal(r Reader) error {
+	if err := b.LockingChainDoor.Unmarshal(r); err != nil {
+		return err
+	}
+	if err := b.LockingChainIssue.Unmarshal(r); err != nil {
+		return err
+	}
+	if err := b.IssuingChainDoor.Unmarshal(r); err != nil {
+		return err
+	}
+	return b.IssuingChainIssue.Unmarshal(r)
+}
+
+func (b *XChainBridge) Marshal(w io.Writer) error {
+	if err := b.LockingChainDoor.Marshal(w); err != nil {
+		return err
+	}
+	if err := b.LockingChainIssue.Marshal(w); err != nil {
+		return err
+	}
+	if err := b.IssuingChainDoor.Marshal(w); err != nil {
+		return err
+	}
+	return b.IssuingChainIssue.Marshal(w)
+}
@@ -1,6 +1,7 @@
 package websockets
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"sync/atomic"
@@ -23,11 +24,42 @@ type CommandError struct {
 
 type Command struct {
 	*CommandError
-	Id     uint64        `json:"id"`
-	Name   string        `json:"command"`
-	Type   string        `json:"type,omitempty"`
-	Status string        `json:"status,omitempty"`
-	Ready  chan struct{} `json:"-"`
+	Id        uint64        `json:"id"`
+	Name      string        `json:"command"`
+	Type      string        `json:"type,omitempty"`
+	Status    string        `json:"status,omitempty"`
+	Ready     chan struct{} `json:"-"`
+	cancelled int32
+}
+
+// TimeoutError is returned by the Context variants of the Remote's
+// methods when the context is done before a response arrives.
+type TimeoutError struct {
+	Name string // The command which was abandoned
+	Id   uint64
+	Err  error // context.DeadlineExceeded or context.Canceled
+}
+
+func (e *TimeoutError) Error() string {
+	return fmt.Sprintf("%s %d abandoned: %s", e.Name, e.Id, e.Err)
+}
+
+func (e *TimeoutError) Unwrap() error { return e.Err }
+
+// Timeout reports whether the deadline passed, as opposed to the context
+// being cancelled.
+func (e *TimeoutError) Timeout() bool { return e.Err == context.DeadlineExceeded }
+
+// request is satisfied by every command struct via its embedded *Command
+type request interface {
+	Syncer
+	command() *Command
+}
+
+func (c *Command) command() *Command { return c }
+
+func (c *Command) isCancelled() bool {
+	return atomic.LoadInt32(&c.cancelled) == 1
 }
 
 func (c *Command) Done() {
@@ -51,11 +83,13 @@ func (e *CommandError) Error() string {
 	return fmt.Sprintf("%s %d %s", e.Name, e.Code, e.Message)
 }
 
+// Ready is buffered so that a response arriving after the caller
+// has given up never blocks the Remote.
 func newCommand(command string) *Command {
 	return &Command{
 		Id:    atomic.AddUint64(&counter, 1),
 		Name:  command,
-		Ready: make(chan struct{}),
+		Ready: make(chan struct{}, 1),
 	}
 }
 
@@ -272,6 +306,50 @@ type AccountOffersResult struct {
 	Offers         data.AccountOfferSlice `json:"offers"`
 }
 
+// Type filters the objects, e.g. "ticket" or "offer"
+type AccountObjectsCommand struct {
+	*Command
+	Account              data.Account          `json:"account"`
+	Type                 string                `json:"type,omitempty"`
+	DeletionBlockersOnly bool                  `json:"deletion_blockers_only,omitempty"`
+	Limit                uint32                `json:"limit"`
+	LedgerIndex          interface{}           `json:"ledger_index,omitempty"`
+	Marker               interface{}           `json:"marker,omitempty"`
+	Result               *AccountObjectsResult `json:"result,omitempty"`
+}
+
+type AccountObjectsResult struct {
+	LedgerSequence *uint32               `json:"ledger_index"`
+	Account        data.Account          `json:"account"`
+	Marker         interface{}           `json:"marker"`
+	AccountObjects data.LedgerEntrySlice `json:"account_objects"`
+}
+
+type DepositAuthorizedCommand struct {
+	*Command
+	SourceAccount      data.Account             `json:"source_account"`
+	DestinationAccount data.Account             `json:"destination_account"`
+	LedgerIndex        interface{}              `json:"ledger_index,omitempty"`
+	Result             *DepositAuthorizedResult `json:"result,omitempty"`
+}
+
+// DepositAuthorized is whether SourceAccount may send to
+// DestinationAccount, which is always true unless the destination has
+// DepositAuth set
+type DepositAuthorizedResult struct {
+	LedgerSequence     *uint32      `json:"ledger_index"`
+	Validated          bool         `json:"validated"`
+	SourceAccount      data.Account `json:"source_account"`
+	DestinationAccount data.Account `json:"destination_account"`
+	DepositAuthorized  bool         `json:"deposit_authorized"`
+}
+
+type ServerDefinitionsCommand struct {
+	*Command
+	Hash   string            `json:"hash,omitempty"`
+	Result *data.Definitions `json:"result,omitempty"`
+}
+
 type BookOffersCommand struct {
 	*Command
 	LedgerIndex interface{}  `json:"ledger_index,omitempty"`
@@ -287,6 +365,64 @@ type BookOffersResult struct {
 	Offers         []data.OrderBookOffer `json:"offers"`
 }
 
+type AMMInfoCommand struct {
+	*Command
+	Asset       *data.Issue    `json:"asset,omitempty"`
+	Asset2      *data.Issue    `json:"asset2,omitempty"`
+	AMMAccount  *data.Account  `json:"amm_account,omitempty"`
+	LedgerIndex interface{}    `json:"ledger_index,omitempty"`
+	Result      *AMMInfoResult `json:"result,omitempty"`
+}
+
+type AMMInfoResult struct {
+	LedgerSequence *uint32 `json:"ledger_index"`
+	Validated      bool    `json:"validated"`
+	AMM            AMMInfo `json:"amm"`
+}
+
+// AMMInfo describes an AMM and its pool. Amount and Amount2 are the pool
+// balances and LPToken is the LP tokens outstanding.
+type AMMInfo struct {
+	Account      data.Account    `json:"account"`
+	Amount       data.Amount     `json:"amount"`
+	Amount2      data.Amount     `json:"amount2"`
+	AssetFrozen  bool            `json:"asset_frozen,omitempty"`
+	Asset2Frozen bool            `json:"asset2_frozen,omitempty"`
+	LPToken      data.Amount     `json:"lp_token"`
+	TradingFee   uint16          `json:"trading_fee"`
+	VoteSlots    []AMMVoteSlot   `json:"vote_slots,omitempty"`
+	AuctionSlot  *AMMAuctionSlot `json:"auction_slot,omitempty"`
+}
+
+type AMMVoteSlot struct {
+	Account    data.Account `json:"account"`
+	TradingFee uint16       `json:"trading_fee"`
+	VoteWeight uint32       `json:"vote_weight"`
+}
+
+type AMMAuctionSlot struct {
+	Account      data.Account `json:"account"`
+	AuthAccounts []struct {
+		Account data.Account `json:"account"`
+	} `json:"auth_accounts,omitempty"`
+	DiscountedFee uint16      `json:"discounted_fee"`
+	Expiration    string      `json:"expiration"`
+	Price         data.Amount `json:"price"`
+	TimeInterval  uint32      `json:"time_interval"`
+}
+
+// AMMRoot returns the parts of the AMM ledger entry which the data AMM
+// helpers need, for use with Amount and Amount2 as the pools
+func (a *AMMInfo) AMMRoot() *data.AMMRoot {
+	amm := data.LedgerEntryFactory[data.AMM]().(*data.AMMRoot)
+	amm.Account = &a.Account
+	amm.Asset = &data.Issue{Currency: a.Amount.Currency, Issuer: a.Amount.Issuer}
+	amm.Asset2 = &data.Issue{Currency: a.Amount2.Currency, Issuer: a.Amount2.Issuer}
+	amm.TradingFee = &a.TradingFee
+	amm.LPTokenBalance = &a.LPToken
+	return amm
+}
+
 type FeeCommand struct {
 	*Command
 	Result *FeeResult
@@ -341,11 +477,11 @@ type ServerStateResult struct {
 	} `json:"state"`
 }
 type LedgerInfo struct {
-	Age         uint32       `json:"time"`
-	BaseFee     int64        `json:"base_fee_xrp"`
-	hash        data.Hash256 `json:"hash"`
-	ReserveBase int64        `json:"reserve_base_xrp"`
-	ReserveInc  int64        `json:"reserve_inc_xrp"`
+	Age         uint32       `json:"age"`
+	BaseFee     float64      `json:"base_fee_xrp"`
+	Hash        data.Hash256 `json:"hash"`
+	ReserveBase float64      `json:"reserve_base_xrp"`
+	ReserveInc  float64      `json:"reserve_inc_xrp"`
 	Sequence    uint32       `json:"seq"`
 }
 
@@ -360,7 +496,7 @@ type Job struct {
 }
 
 type LoadInfo struct {
-	Jobs    []Job  `json:"job_type, omitempty"`
+	Jobs    []Job  `json:"job_type,omitempty"`
 	Threads uint32 `json:"threads"`
 }
 
@@ -376,34 +512,34 @@ type ServerInfoCommand struct {
 
 type ServerInfoResult struct {
 	Info struct {
-		AmmendBlocked      bool       `json:"ammendment_blocked,omitempty"`
-		BuildVersion       string     `json:"build_version"`
-		CompleteLedgers    string     `json:"complete_ledgers"`
-		IoLatencyMs        uint32     `json:"io_latency_ms"`
-		ClosedLedger       LedgerInfo //`json:"last_close,omitempty"`
-		Load               LoadInfo   `json:"load,omitempty"`
-		LoadBase           uint32     `json:"load_base,omitempty"`
-		LoadFactor         uint32     `json:"load_factor,omitempty"`
-		LoadFactorLocal    uint32     `json:"load_factor_local,omitempty"`
-		LoadFactorNet      uint32     `json:"load_factor_net,omitempty"`
-		LoadFactorCluster  uint32     `json:"load_factor_cluster,omitempty"`
-		LoadFactorFeeEsca  uint32     `json:"load_factor_fee_escalation,omitempty"`
-		LoadFactorFeeQ     uint32     `json:"load_factor_fee_queue,omitempty"`
-		LoadFactorServer   uint32     `json:"load_factor_to_server,omitempty"`
-		Peers              uint32     `json:"peers"`
-		PubKeyNode         string     `json:"pubkey_node"`
-		PubkeyValidator    string     `json:"pubkey_validator,omitempty"`
-		ServerState        string     `json:"server_state"`
-		StateAccount       map[string]StateAccountInfo
-		Uptime             uint32     `json:"uptime"`
-		ValidatedLedger    LedgerInfo //`json:"validated_ledger,omitempty"`
-		ValidationQuorum   uint32     `json:"validation_quorum"`
-		ValidatorListExpir string     `json:"validator_list_expires,omitempty"  `
+		AmmendBlocked      bool                        `json:"ammendment_blocked,omitempty"`
+		BuildVersion       string                      `json:"build_version"`
+		CompleteLedgers    string                      `json:"complete_ledgers"`
+		IoLatencyMs        uint32                      `json:"io_latency_ms"`
+		ClosedLedger       LedgerInfo                  `json:"closed_ledger,omitempty"`
+		Load               LoadInfo                    `json:"load,omitempty"`
+		LoadBase           uint32                      `json:"load_base,omitempty"`
+		LoadFactor         float64                     `json:"load_factor,omitempty"`
+		LoadFactorLocal    float64                     `json:"load_factor_local,omitempty"`
+		LoadFactorNet      float64                     `json:"load_factor_net,omitempty"`
+		LoadFactorCluster  float64                     `json:"load_factor_cluster,omitempty"`
+		LoadFactorFeeEsca  float64                     `json:"load_factor_fee_escalation,omitempty"`
+		LoadFactorFeeQ     float64                     `json:"load_factor_fee_queue,omitempty"`
+		LoadFactorServer   float64                     `json:"load_factor_to_server,omitempty"`
+		Peers              uint32                      `json:"peers"`
+		PubKeyNode         string                      `json:"pubkey_node"`
+		PubkeyValidator    string                      `json:"pubkey_validator,omitempty"`
+		ServerState        string                      `json:"server_state"`
+		StateAccount       map[string]StateAccountInfo `json:"state_accounting,omitempty"`
+		Uptime             uint32                      `json:"uptime"`
+		ValidatedLedger    LedgerInfo                  `json:"validated_ledger,omitempty"`
+		ValidationQuorum   uint32                      `json:"validation_quorum"`
+		ValidatorListExpir string                      `json:"validator_list_expires,omitempty"  `
 	} `json:"info"`
 }
 
 type SubmitMultiSignCommand struct {
 	*Command
 	TxBlob data.MultiSignTransaction `json:"tx_json"`
-	Result *SubmitResult             `		json:"result,omitempty"`
+	Result *SubmitResult             `json:"result,omitempty"`
 }
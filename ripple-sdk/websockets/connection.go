@@ -0,0 +1,134 @@
+package websockets
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"time"
+)
+
+type ConnectionState int
+
+const (
+	Connected    ConnectionState = iota // Connection (re)established
+	Disconnected                        // Connection dropped, reconnect pending
+	Reconnecting                        // A reconnect attempt failed, retrying after backoff
+	Closed                              // Close() was called, no more events follow
+)
+
+var connectionStateNames = [...]string{
+	Connected:    "Connected",
+	Disconnected: "Disconnected",
+	Reconnecting: "Reconnecting",
+	Closed:       "Closed",
+}
+
+func (s ConnectionState) String() string {
+	return connectionStateNames[s]
+}
+
+// ConnectionEvent is emitted on Remote.Events whenever the state of the
+// underlying websocket changes. Events are dropped if the channel is full,
+// so callers that do not care about them need not drain it.
+type ConnectionEvent struct {
+	State    ConnectionState
+	Time     time.Time
+	Attempt  int           // Reconnect attempt number, for Reconnecting
+	Downtime time.Duration // Time spent disconnected, for Connected after a reconnect
+	Err      error         // Reason for the disconnect or failed attempt
+}
+
+// Commands which only read state and so can safely be sent again
+// if the connection drops before their response arrives.
+var retryableCommands = map[string]bool{
+	"account_info":     true,
+	"account_lines":    true,
+	"account_offers":   true,
+	"account_tx":       true,
+	"book_offers":      true,
+	"fee":              true,
+	"ledger":           true,
+	"ledger_data":      true,
+	"ledger_header":    true,
+	"ripple_path_find": true,
+	"server_info":      true,
+	"server_state":     true,
+	"tx":               true,
+}
+
+func (r *Remote) emit(e ConnectionEvent) {
+	e.Time = time.Now()
+	select {
+	case r.Events <- e:
+	default:
+	}
+}
+
+// reconnectBackoff doubles the wait for each failed attempt, with jitter,
+// up to maxReconnectWait.
+func reconnectBackoff(attempt int) time.Duration {
+	wait := maxReconnectWait
+	if attempt < 16 {
+		wait = minReconnectWait << uint(attempt-1)
+	}
+	if wait > maxReconnectWait {
+		wait = maxReconnectWait
+	}
+	return wait/2 + time.Duration(rand.Int63n(int64(wait/2)+1))
+}
+
+func commandId(s Syncer) uint64 {
+	return reflect.ValueOf(s).Elem().FieldByName("Id").Uint()
+}
+
+func commandName(s Syncer) string {
+	return reflect.ValueOf(s).Elem().FieldByName("Name").String()
+}
+
+func isRetryable(s Syncer) bool {
+	return retryableCommands[commandName(s)]
+}
+
+func sortedIds(pending map[uint64]Syncer) []uint64 {
+	ids := make([]uint64, 0, len(pending))
+	for id := range pending {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// recordSubscription remembers the streams and books of a successful
+// subscribe so they can be replayed after a reconnect.
+func (r *Remote) recordSubscription(cmd *SubscribeCommand) {
+	for _, stream := range cmd.Streams {
+		r.streams[stream] = true
+	}
+next:
+	for _, book := range cmd.Books {
+		for _, existing := range r.books {
+			if existing == book {
+				continue next
+			}
+		}
+		r.books = append(r.books, book)
+	}
+}
+
+// resubscribeCommand returns a subscribe covering everything previously
+// subscribed to, or nil if there is nothing to replay.
+func (r *Remote) resubscribeCommand() *SubscribeCommand {
+	if len(r.streams) == 0 && len(r.books) == 0 {
+		return nil
+	}
+	cmd := &SubscribeCommand{
+		Command: newCommand("subscribe"),
+		Books:   r.books,
+		replay:  true,
+	}
+	for stream := range r.streams {
+		cmd.Streams = append(cmd.Streams, stream)
+	}
+	sort.Strings(cmd.Streams)
+	return cmd
+}
@@ -1,7 +1,9 @@
 package websockets
 
 import (
-	"github.com/goodwood511/ripple_lib/rubblelabs/ripple/data"
+	"context"
+
+	"github.com/goodwood511/ripple_lib/ripple-sdk/data"
 )
 
 // https://ripple.com/build/rippled-apis/#path-find
@@ -37,6 +39,11 @@ type SourceCurrency struct {
 }
 
 func (r *Remote) PathFindCreate(src, dest data.Account, amt data.Amount, sendMax *data.Amount, sourceCurrencies *[]SourceCurrency) (*PathFindCreateResult, error) {
+	return r.PathFindCreateContext(context.Background(), src, dest, amt, sendMax, sourceCurrencies)
+}
+
+// PathFindCreateContext is PathFindCreate, giving up when ctx is done
+func (r *Remote) PathFindCreateContext(ctx context.Context, src, dest data.Account, amt data.Amount, sendMax *data.Amount, sourceCurrencies *[]SourceCurrency) (*PathFindCreateResult, error) {
 	cmd := &PathFindCreateCommand{
 		Command:            newCommand("path_find"),
 		Subcommand:         "create",
@@ -46,10 +53,8 @@ func (r *Remote) PathFindCreate(src, dest data.Account, amt data.Amount, sendMax
 		SendMax:            sendMax,
 		SourceCurrencies:   sourceCurrencies,
 	}
-	r.outgoing <- cmd
-	<-cmd.Ready
-	if cmd.CommandError != nil {
-		return nil, cmd.CommandError
+	if err := r.do(ctx, cmd); err != nil {
+		return nil, err
 	}
 	return cmd.Result, nil
 }
@@ -0,0 +1,295 @@
+package websockets
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+)
+
+const (
+	// How often each endpoint in a Pool is health checked.
+	healthCheckPeriod = 10 * time.Second
+
+	// Time allowed for a single health check.
+	healthCheckTimeout = 5 * time.Second
+
+	// An endpoint whose last validated ledger is older than this is unhealthy.
+	maxValidatedLedgerAge = 60
+
+	// An endpoint more than this many ledgers behind the best is unhealthy.
+	maxValidatedLedgerLag = 5
+)
+
+// Server states in which rippled is in sync with the network
+var syncedServerStates = map[string]bool{
+	"full":       true,
+	"proposing":  true,
+	"validating": true,
+}
+
+// Health is the result of the most recent check of a Pool endpoint
+type Health struct {
+	Endpoint        string
+	Healthy         bool
+	ServerState     string
+	ValidatedLedger uint32
+	LedgerAge       uint32 // Seconds since the last validated ledger
+	Latency         time.Duration
+	Checked         time.Time
+	Err             error
+}
+
+type poolMember struct {
+	endpoint string
+	remote   *Remote
+	health   Health
+}
+
+// Pool maintains a Remote to each of several rippled or Clio endpoints,
+// health checks them with server_info and routes requests to the
+// healthiest, failing over to the next when one errors or falls behind.
+type Pool struct {
+	mu      sync.RWMutex
+	members []*poolMember
+	quit    chan struct{}
+	done    chan struct{}
+}
+
+// NewPool connects to every endpoint it can and starts health checking.
+// Endpoints which cannot be reached are retried on each check. An error
+// is returned if no endpoint is healthy to begin with.
+func NewPool(endpoints []string) (*Pool, error) {
+	if len(endpoints) == 0 {
+		return nil, fmt.Errorf("No endpoints")
+	}
+	p := &Pool{
+		quit: make(chan struct{}),
+		done: make(chan struct{}),
+	}
+	for _, endpoint := range endpoints {
+		p.members = append(p.members, &poolMember{
+			endpoint: endpoint,
+			health:   Health{Endpoint: endpoint},
+		})
+	}
+	p.check()
+	if _, err := p.Remote(); err != nil {
+		p.closeRemotes()
+		return nil, err
+	}
+	go p.run()
+	return p, nil
+}
+
+// Close stops health checking and closes every Remote in the pool
+func (p *Pool) Close() {
+	close(p.quit)
+	<-p.done
+	p.closeRemotes()
+}
+
+func (p *Pool) closeRemotes() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, m := range p.members {
+		if m.remote != nil {
+			m.remote.Close()
+			m.remote = nil
+		}
+	}
+}
+
+func (p *Pool) run() {
+	defer close(p.done)
+	ticker := time.NewTicker(healthCheckPeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-p.quit:
+			return
+		case <-ticker.C:
+			p.check()
+		}
+	}
+}
+
+// Health returns the latest health of every endpoint, healthiest first
+func (p *Pool) Health() []Health {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	ranked := p.ranked()
+	health := make([]Health, len(ranked))
+	for i, m := range ranked {
+		health[i] = m.health
+	}
+	return health
+}
+
+// Remote returns the Remote of the healthiest endpoint
+func (p *Pool) Remote() (*Remote, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	for _, m := range p.ranked() {
+		if m.health.Healthy {
+			return m.remote, nil
+		}
+	}
+	return nil, fmt.Errorf("No healthy endpoints")
+}
+
+// Do calls f with the healthiest Remote. If f fails because of the
+// endpoint, rather than because of the request itself, the endpoint is
+// marked unhealthy and f is retried on the next healthiest.
+func (p *Pool) Do(ctx context.Context, f func(*Remote) error) error {
+	var lastErr error
+	tried := make(map[*Remote]bool)
+	for {
+		remote := p.next(tried)
+		if remote == nil {
+			if lastErr == nil {
+				lastErr = fmt.Errorf("No healthy endpoints")
+			}
+			return lastErr
+		}
+		tried[remote] = true
+		err := f(remote)
+		if err == nil || !isEndpointFailure(err) || ctx.Err() != nil {
+			return err
+		}
+		lastErr = err
+		p.markUnhealthy(remote, err)
+	}
+}
+
+func (p *Pool) next(tried map[*Remote]bool) *Remote {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	for _, m := range p.ranked() {
+		if m.health.Healthy && !tried[m.remote] {
+			return m.remote
+		}
+	}
+	return nil
+}
+
+// isEndpointFailure distinguishes errors caused by the endpoint from
+// errors reported by rippled about the request, such as actNotFound,
+// which would be the same on every endpoint.
+func isEndpointFailure(err error) bool {
+	switch e := err.(type) {
+	case *CommandError:
+		return e.Code == -1 // Client Error, i.e. connection closed
+	case *TimeoutError:
+		return true
+	default:
+		return false
+	}
+}
+
+func (p *Pool) markUnhealthy(remote *Remote, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, m := range p.members {
+		if m.remote == remote {
+			glog.Errorf("Pool endpoint %s failed: %s", m.endpoint, err)
+			m.health.Healthy = false
+			m.health.Err = err
+		}
+	}
+}
+
+// ranked returns members ordered healthiest first: healthy before
+// unhealthy, then most recent validated ledger, then lowest latency.
+// Callers must hold p.mu.
+func (p *Pool) ranked() []*poolMember {
+	ranked := make([]*poolMember, len(p.members))
+	copy(ranked, p.members)
+	sort.SliceStable(ranked, func(i, j int) bool {
+		a, b := ranked[i].health, ranked[j].health
+		switch {
+		case a.Healthy != b.Healthy:
+			return a.Healthy
+		case a.ValidatedLedger != b.ValidatedLedger:
+			return a.ValidatedLedger > b.ValidatedLedger
+		default:
+			return a.Latency < b.Latency
+		}
+	})
+	return ranked
+}
+
+// check health checks every member concurrently, connecting any which
+// have no Remote yet, and then marks laggards as unhealthy.
+func (p *Pool) check() {
+	p.mu.RLock()
+	members := make([]poolMember, len(p.members))
+	for i, m := range p.members {
+		members[i] = *m
+	}
+	p.mu.RUnlock()
+
+	var wg sync.WaitGroup
+	for i := range members {
+		wg.Add(1)
+		go func(m *poolMember) {
+			defer wg.Done()
+			if m.remote == nil {
+				remote, err := NewRemote(m.endpoint)
+				if err != nil {
+					m.health = Health{Endpoint: m.endpoint, Checked: time.Now(), Err: err}
+					return
+				}
+				m.remote = remote
+			}
+			m.health = checkHealth(m.endpoint, m.remote)
+		}(&members[i])
+	}
+	wg.Wait()
+
+	var best uint32
+	for _, m := range members {
+		if m.health.Healthy && m.health.ValidatedLedger > best {
+			best = m.health.ValidatedLedger
+		}
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for i, m := range members {
+		if m.health.Healthy && best-m.health.ValidatedLedger > maxValidatedLedgerLag {
+			m.health.Healthy = false
+			m.health.Err = fmt.Errorf("%d ledgers behind", best-m.health.ValidatedLedger)
+		}
+		p.members[i].remote = m.remote
+		p.members[i].health = m.health
+	}
+}
+
+func checkHealth(endpoint string, remote *Remote) Health {
+	health := Health{Endpoint: endpoint, Checked: time.Now()}
+	ctx, cancel := context.WithTimeout(context.Background(), healthCheckTimeout)
+	defer cancel()
+	info, err := remote.ServerInfoContext(ctx)
+	health.Latency = time.Since(health.Checked)
+	if err != nil {
+		health.Err = err
+		return health
+	}
+	health.ServerState = info.Info.ServerState
+	health.ValidatedLedger = info.Info.ValidatedLedger.Sequence
+	health.LedgerAge = info.Info.ValidatedLedger.Age
+	switch {
+	case !syncedServerStates[health.ServerState]:
+		health.Err = fmt.Errorf("Server state: %s", health.ServerState)
+	case health.ValidatedLedger == 0:
+		health.Err = fmt.Errorf("No validated ledger")
+	case health.LedgerAge > maxValidatedLedgerAge:
+		health.Err = fmt.Errorf("Validated ledger is %d seconds old", health.LedgerAge)
+	default:
+		health.Healthy = true
+	}
+	return health
+}
@@ -0,0 +1,170 @@
+package websockets
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/goodwood511/ripple_lib/ripple-sdk/data"
+)
+
+// poolReply answers server_info with the given state and validated ledger,
+// account_info with actNotFound, and everything else as testReply does
+func poolReply(state string, seq, age uint32) func(msg testMessage) interface{} {
+	return func(msg testMessage) interface{} {
+		switch msg.Command {
+		case "server_info":
+			return testResponse(msg.Id, map[string]interface{}{
+				"info": map[string]interface{}{
+					"server_state": state,
+					"validated_ledger": map[string]interface{}{
+						"age": age,
+						"seq": seq,
+					},
+				},
+			})
+		case "account_info":
+			return map[string]interface{}{
+				"id":            msg.Id,
+				"type":          "response",
+				"status":        "error",
+				"error":         "actNotFound",
+				"error_code":    19,
+				"error_message": "Account not found.",
+			}
+		}
+		return testReply(msg)
+	}
+}
+
+func newPoolServer(t *testing.T, state string, seq, age uint32) *testServer {
+	s := newTestServer(t)
+	s.setReply(poolReply(state, seq, age))
+	return s
+}
+
+// used counts the server_info commands sent to s other than health checks
+func (s *testServer) used(checks int) int {
+	return len(s.commands("server_info")) - checks
+}
+
+func poolServerInfo(ctx context.Context, timeout time.Duration) func(*Remote) error {
+	return func(r *Remote) error {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		_, err := r.ServerInfoContext(ctx)
+		return err
+	}
+}
+
+func TestPoolHealth(t *testing.T) {
+	healthy := newPoolServer(t, "full", 1000, 2)
+	stale := newPoolServer(t, "full", 1000, maxValidatedLedgerAge+1)
+	lagging := newPoolServer(t, "full", 1000-maxValidatedLedgerLag-1, 2)
+	syncing := newPoolServer(t, "connected", 1000, 2)
+	failing := newPoolServer(t, "full", 1000, 2)
+	failing.setReject(1 << 20)
+
+	p, err := NewPool([]string{stale.endpoint(), failing.endpoint(), lagging.endpoint(), syncing.endpoint(), healthy.endpoint()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p.Close()
+
+	health := p.Health()
+	if health[0].Endpoint != healthy.endpoint() || !health[0].Healthy {
+		t.Fatalf("Expected the healthy endpoint first: %+v", health[0])
+	}
+	expected := map[string]string{
+		stale.endpoint():   "seconds old",
+		lagging.endpoint(): "6 ledgers behind",
+		syncing.endpoint(): "Server state: connected",
+		failing.endpoint(): "bad handshake",
+	}
+	for _, h := range health[1:] {
+		if h.Healthy || h.Err == nil || !strings.Contains(h.Err.Error(), expected[h.Endpoint]) {
+			t.Errorf("Expected %s to be unhealthy with %q: %+v", h.Endpoint, expected[h.Endpoint], h)
+		}
+	}
+
+	for i := 0; i < 3; i++ {
+		if err := p.Do(context.Background(), poolServerInfo(context.Background(), testWait)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if n := healthy.used(1); n != 3 {
+		t.Errorf("Healthy endpoint used %d times", n)
+	}
+	for _, s := range []*testServer{stale, lagging, syncing} {
+		if n := s.used(1); n != 0 {
+			t.Errorf("Unhealthy endpoint %s used %d times", s.endpoint(), n)
+		}
+	}
+}
+
+func TestPoolFailsOver(t *testing.T) {
+	first := newPoolServer(t, "full", 1001, 2)
+	second := newPoolServer(t, "full", 1000, 2)
+	p, err := NewPool([]string{second.endpoint(), first.endpoint()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p.Close()
+
+	// Errors about the request itself are returned without failing over
+	account, err := data.NewAccountFromAddress("rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = p.Do(context.Background(), func(r *Remote) error {
+		_, err := r.AccountInfo(*account)
+		return err
+	})
+	if cmdErr, ok := err.(*CommandError); !ok || cmdErr.Name != "actNotFound" {
+		t.Fatalf("Expected actNotFound, got %v", err)
+	}
+	if len(first.commands("account_info")) != 1 || len(second.commands("account_info")) != 0 {
+		t.Errorf("Request error caused a failover")
+	}
+
+	// A stalled endpoint times out and the request moves on
+	first.setReply(func(msg testMessage) interface{} { return nil })
+	if err := p.Do(context.Background(), poolServerInfo(context.Background(), 200*time.Millisecond)); err != nil {
+		t.Fatal(err)
+	}
+	if first.used(1) != 1 || second.used(1) != 1 {
+		t.Errorf("Expected one timeout then failover: %d %d", first.used(1), second.used(1))
+	}
+	health := p.Health()
+	if health[0].Endpoint != second.endpoint() || health[1].Healthy {
+		t.Fatalf("Stalled endpoint still healthy: %+v", health)
+	}
+	if _, ok := health[1].Err.(*TimeoutError); !ok {
+		t.Errorf("Expected a TimeoutError, got %v", health[1].Err)
+	}
+
+	// Once every endpoint has failed there is nothing left to try
+	second.setReply(func(msg testMessage) interface{} { return nil })
+	if _, ok := p.Do(context.Background(), poolServerInfo(context.Background(), 200*time.Millisecond)).(*TimeoutError); !ok {
+		t.Errorf("Expected the last TimeoutError")
+	}
+	if err := p.Do(context.Background(), poolServerInfo(context.Background(), testWait)); err == nil || err.Error() != "No healthy endpoints" {
+		t.Errorf("Expected no healthy endpoints, got %v", err)
+	}
+
+	// The caller giving up is not the endpoint's fault
+	q, err := NewPool([]string{newPoolServer(t, "full", 1000, 2).endpoint()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer q.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := q.Do(ctx, poolServerInfo(ctx, testWait)); err == nil {
+		t.Fatal("Expected the cancelled context to be reported")
+	}
+	if h := q.Health()[0]; !h.Healthy {
+		t.Errorf("Endpoint marked unhealthy when the caller gave up: %+v", h)
+	}
+}
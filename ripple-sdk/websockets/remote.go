@@ -2,14 +2,15 @@ package websockets
 
 import (
 	"bytes"
+	"context"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"net"
 	"net/url"
-	"reflect"
 	"sort"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/goodwood511/ripple_lib/ripple-sdk/data"
@@ -30,16 +31,31 @@ const (
 
 	// Time allowed to connect to server.
 	dialTimeout = 5 * time.Second
+
+	// Bounds on the wait between reconnect attempts.
+	minReconnectWait = 500 * time.Millisecond
+	maxReconnectWait = 30 * time.Second
 )
 
 type Remote struct {
-	Incoming chan interface{}
-	outgoing chan Syncer
-	ws       *websocket.Conn
+	Incoming  chan interface{}
+	Events    chan ConnectionEvent
+	outgoing  chan Syncer
+	cancelled chan uint64
+	endpoint  *url.URL
+	ws        *websocket.Conn
+
+	// Subscription state, replayed after a reconnect.
+	// Only touched by the run goroutine.
+	streams map[string]bool
+	books   []OrderBookSubscription
 }
 
 // NewRemote returns a new remote session connected to the specified
-// server endpoint URI. To close the connection, use Close().
+// server endpoint URI. If the connection drops, the Remote reconnects
+// with backoff, replays its subscriptions and retries any idempotent
+// commands that were in flight. Connection state changes are reported
+// on the Events channel. To close the connection, use Close().
 func NewRemote(endpoint string) (*Remote, error) {
 	//glog.Infoln(endpoint)
 	u, err := url.Parse(endpoint)
@@ -49,24 +65,37 @@ func NewRemote(endpoint string) (*Remote, error) {
 	if !strings.Contains(u.Host, ":") {
 		u.Host += ":443" // HTTPS 默认端口
 	}
-	c, err := net.DialTimeout("tcp", u.Host, dialTimeout)
-	if err != nil {
-		return nil, err
-	}
-	ws, _, err := websocket.NewClient(c, u, nil, 8192, 8192)
+	ws, err := dial(u)
 	if err != nil {
 		return nil, err
 	}
 	r := &Remote{
-		Incoming: make(chan interface{}, 8192),
-		outgoing: make(chan Syncer, 100),
-		ws:       ws,
+		Incoming:  make(chan interface{}, 8192),
+		Events:    make(chan ConnectionEvent, 64),
+		outgoing:  make(chan Syncer, 100),
+		cancelled: make(chan uint64, 100),
+		endpoint:  u,
+		ws:        ws,
+		streams:   make(map[string]bool),
 	}
 
 	go r.run()
 	return r, nil
 }
 
+func dial(u *url.URL) (*websocket.Conn, error) {
+	c, err := net.DialTimeout("tcp", u.Host, dialTimeout)
+	if err != nil {
+		return nil, err
+	}
+	ws, _, err := websocket.NewClient(c, u, nil, 8192, 8192)
+	if err != nil {
+		c.Close()
+		return nil, err
+	}
+	return ws, nil
+}
+
 // Close shuts down the Remote session and blocks until all internal
 // goroutines have been cleaned up.
 // Any commands that are pending a response will return with an error.
@@ -79,20 +108,91 @@ func (r *Remote) Close() {
 	}
 }
 
-// run spawns the read/write pumps and then runs until Close() is called.
+// run serves the current connection and reconnects whenever it drops,
+// until Close() is called.
 func (r *Remote) run() {
-	outbound := make(chan interface{})
-	inbound := make(chan []byte)
 	pending := make(map[uint64]Syncer)
 
 	defer func() {
-		close(outbound) // Shuts down the writePump
 		close(r.Incoming)
 
 		// Cancel all pending commands with an error
 		for _, c := range pending {
 			c.Fail("Connection Closed")
 		}
+		r.emit(ConnectionEvent{State: Closed})
+		close(r.Events)
+	}()
+
+	r.emit(ConnectionEvent{State: Connected})
+	for {
+		closed, err := r.serve(r.ws, pending)
+		if closed {
+			return
+		}
+		down := time.Now()
+		r.emit(ConnectionEvent{State: Disconnected, Err: err})
+
+		// Commands which may have reached the server can only be
+		// retried if doing so twice is harmless.
+		for id, c := range pending {
+			if !isRetryable(c) {
+				c.Fail("Connection Closed")
+				delete(pending, id)
+			}
+		}
+
+		if !r.reconnect(pending) {
+			return
+		}
+		r.emit(ConnectionEvent{State: Connected, Downtime: time.Since(down)})
+	}
+}
+
+// reconnect dials the endpoint with exponential backoff until it succeeds,
+// queueing any commands issued in the meantime. It returns false if
+// Close() is called before a connection is made.
+func (r *Remote) reconnect(pending map[uint64]Syncer) bool {
+	for attempt := 1; ; attempt++ {
+		ws, err := dial(r.endpoint)
+		if err == nil {
+			r.ws = ws
+			return true
+		}
+		glog.Errorf("Reconnect attempt %d to %s failed: %s", attempt, r.endpoint, err)
+		r.emit(ConnectionEvent{State: Reconnecting, Attempt: attempt, Err: err})
+
+		timer := time.NewTimer(reconnectBackoff(attempt))
+	wait:
+		for {
+			select {
+			case <-timer.C:
+				break wait
+			case command, ok := <-r.outgoing:
+				if !ok {
+					timer.Stop()
+					return false
+				}
+				r.queue(command, pending)
+			case id := <-r.cancelled:
+				delete(pending, id)
+			}
+		}
+	}
+}
+
+// serve spawns the read/write pumps for a single connection, replays
+// subscriptions and queued commands and then runs until either the
+// connection drops or Close() is called. It reports whether Close()
+// was called, along with the reason the connection dropped.
+func (r *Remote) serve(ws *websocket.Conn, pending map[uint64]Syncer) (bool, error) {
+	outbound := make(chan interface{})
+	inbound := make(chan []byte)
+	writerDone := make(chan struct{})
+	readErr := make(chan error, 1)
+
+	defer func() {
+		close(outbound) // Shuts down the writePump
 
 		// Drain the inbound channel and block until it is closed,
 		// indicating that the readPump has returned.
@@ -102,90 +202,208 @@ func (r *Remote) run() {
 
 	// Spawn read/write goroutines
 	go func() {
-		defer r.ws.Close()
-		r.writePump(outbound)
+		defer close(writerDone)
+		defer ws.Close()
+		r.writePump(ws, outbound)
 	}()
 	go func() {
 		defer close(inbound)
-		r.readPump(inbound)
+		readErr <- r.readPump(ws, inbound)
 	}()
 
+	send := func(message interface{}) bool {
+		select {
+		case outbound <- message:
+			return true
+		case <-writerDone:
+			return false
+		}
+	}
+	dropped := func() error {
+		select {
+		case err := <-readErr:
+			return err
+		default:
+			return fmt.Errorf("Connection closed")
+		}
+	}
+
+	// Replay subscriptions, then anything still waiting for a response.
+	// The subscription is only awaited once the others are sent, so that
+	// it is not sent twice.
+	sub := r.resubscribeCommand()
+	if sub != nil && !send(sub) {
+		return false, dropped()
+	}
+	for _, id := range sortedIds(pending) {
+		// The caller may have given up before the cancellation was seen
+		if req, ok := pending[id].(request); ok && req.command().isCancelled() {
+			delete(pending, id)
+			continue
+		}
+		if !send(pending[id]) {
+			return false, dropped()
+		}
+	}
+	if sub != nil {
+		pending[sub.Id] = sub
+	}
+
 	// Main run loop
-	var response Command
 	for {
 		select {
 		case command, ok := <-r.outgoing:
 			if !ok {
-				return
+				return true, nil
+			}
+			if !r.queue(command, pending) {
+				continue
+			}
+			if !send(command) {
+				return false, dropped()
 			}
-			outbound <- command
-			id := reflect.ValueOf(command).Elem().FieldByName("Id").Uint()
-			pending[id] = command
+
+		case id := <-r.cancelled:
+			delete(pending, id)
 
 		case in, ok := <-inbound:
 			if !ok {
 				glog.Errorln("Connection closed by server")
-				return
+				return false, dropped()
 			}
+			r.handle(in, pending)
+		}
+	}
+}
 
-			if err := json.Unmarshal(in, &response); err != nil {
-				glog.Errorln(err.Error())
-				continue
-			}
-			// Stream message
-			factory, ok := streamMessageFactory[response.Type]
-			if ok {
-				cmd := factory()
-				if err := json.Unmarshal(in, &cmd); err != nil {
-					glog.Errorln(err.Error(), string(in))
-					continue
-				}
-				r.Incoming <- cmd
-				continue
-			}
+// handle dispatches a single message received from the server, either
+// to the Incoming channel or to the command awaiting it.
+func (r *Remote) handle(in []byte, pending map[uint64]Syncer) {
+	var response Command
+	if err := json.Unmarshal(in, &response); err != nil {
+		glog.Errorln(err.Error())
+		return
+	}
+	// Stream message
+	factory, ok := streamMessageFactory[response.Type]
+	if ok {
+		cmd := factory()
+		if err := json.Unmarshal(in, &cmd); err != nil {
+			glog.Errorln(err.Error(), string(in))
+			return
+		}
+		r.Incoming <- cmd
+		return
+	}
 
-			// Command response message
-			cmd, ok := pending[response.Id]
-			if !ok {
-				glog.Errorf("Unexpected message: %+v", response)
-				continue
-			}
-			delete(pending, response.Id)
-			if err := json.Unmarshal(in, &cmd); err != nil {
-				glog.Errorln(err.Error())
-				continue
-			}
-			cmd.Done()
+	// Command response message
+	cmd, ok := pending[response.Id]
+	if !ok {
+		glog.Errorf("Unexpected message: %s", in)
+		return
+	}
+	delete(pending, response.Id)
+	if err := json.Unmarshal(in, &cmd); err != nil {
+		glog.Errorln(err.Error())
+		return
+	}
+	if sub, ok := cmd.(*SubscribeCommand); ok && sub.CommandError == nil && !sub.replay {
+		r.recordSubscription(sub)
+	}
+	cmd.Done()
+}
+
+// queue adds a command to pending unless its caller has already given up
+func (r *Remote) queue(command Syncer, pending map[uint64]Syncer) bool {
+	if req, ok := command.(request); ok && req.command().isCancelled() {
+		return false
+	}
+	pending[commandId(command)] = command
+	return true
+}
+
+// do sends a command and waits for its response
+func (r *Remote) do(ctx context.Context, cmd request) error {
+	if err := r.send(ctx, cmd); err != nil {
+		return err
+	}
+	return r.wait(ctx, cmd)
+}
+
+func (r *Remote) send(ctx context.Context, cmd request) error {
+	select {
+	case r.outgoing <- cmd:
+		return nil
+	case <-ctx.Done():
+		return r.abandon(ctx, cmd)
+	}
+}
+
+// wait blocks until the command's response arrives or ctx is done,
+// returning any error reported by the server
+func (r *Remote) wait(ctx context.Context, cmd request) error {
+	c := cmd.command()
+	select {
+	case <-c.Ready:
+		if c.CommandError != nil {
+			return c.CommandError
 		}
+		return nil
+	case <-ctx.Done():
+		return r.abandon(ctx, cmd)
+	}
+}
+
+func (r *Remote) abandon(ctx context.Context, cmd request) error {
+	r.cancel(cmd)
+	c := cmd.command()
+	return &TimeoutError{Name: c.Name, Id: c.Id, Err: ctx.Err()}
+}
+
+// cancel removes a command from pending. Its Ready channel is buffered,
+// so a response racing with the cancellation is harmlessly discarded.
+func (r *Remote) cancel(cmd request) {
+	c := cmd.command()
+	atomic.StoreInt32(&c.cancelled, 1)
+	select {
+	case r.cancelled <- c.Id:
+	default:
+		// Run loop is backed up, the command will be dropped
+		// from pending when its response arrives instead.
 	}
 }
 
 // Synchronously get a single transaction
 func (r *Remote) Tx(hash data.Hash256) (*TxResult, error) {
+	return r.TxContext(context.Background(), hash)
+}
+
+// TxContext is Tx, giving up when ctx is done
+func (r *Remote) TxContext(ctx context.Context, hash data.Hash256) (*TxResult, error) {
 	cmd := &TxCommand{
 		Command:     newCommand("tx"),
 		Transaction: hash,
 	}
-	r.outgoing <- cmd
-	<-cmd.Ready
-	if cmd.CommandError != nil {
-		return nil, cmd.CommandError
+	if err := r.do(ctx, cmd); err != nil {
+		return nil, err
 	}
 	return cmd.Result, nil
 }
 
-func (r *Remote) accountTx(account data.Account, c chan *data.TransactionWithMetaData, pageSize int, minLedger, maxLedger int64) {
+func (r *Remote) accountTx(ctx context.Context, account data.Account, c chan *data.TransactionWithMetaData, pageSize int, minLedger, maxLedger int64) {
 	defer close(c)
 	cmd := newAccountTxCommand(account, pageSize, nil, minLedger, maxLedger)
 	for ; ; cmd = newAccountTxCommand(account, pageSize, cmd.Result.Marker, minLedger, maxLedger) {
-		r.outgoing <- cmd
-		<-cmd.Ready
-		if cmd.CommandError != nil {
-			glog.Errorln(cmd.Error())
+		if err := r.do(ctx, cmd); err != nil {
+			glog.Errorln(err.Error())
 			return
 		}
 		for _, tx := range cmd.Result.Transactions {
-			c <- tx
+			select {
+			case c <- tx:
+			case <-ctx.Done():
+				return
+			}
 		}
 		if cmd.Result.Marker == nil {
 			return
@@ -202,13 +420,24 @@ func (r *Remote) accountTx(account data.Account, c chan *data.TransactionWithMet
 // Use minLedger -1 for the earliest ledger available.
 // Use maxLedger -1 for the most recent validated ledger.
 func (r *Remote) AccountTx(account data.Account, pageSize int, minLedger, maxLedger int64) chan *data.TransactionWithMetaData {
+	return r.AccountTxContext(context.Background(), account, pageSize, minLedger, maxLedger)
+}
+
+// AccountTxContext is AccountTx, closing the channel early when ctx is done
+func (r *Remote) AccountTxContext(ctx context.Context, account data.Account, pageSize int, minLedger, maxLedger int64) chan *data.TransactionWithMetaData {
 	c := make(chan *data.TransactionWithMetaData)
-	go r.accountTx(account, c, pageSize, minLedger, maxLedger)
+	go r.accountTx(ctx, account, c, pageSize, minLedger, maxLedger)
 	return c
 }
 
 // Synchronously submit a single transaction
 func (r *Remote) Submit(tx data.Transaction) (*SubmitResult, error) {
+	return r.SubmitContext(context.Background(), tx)
+}
+
+// SubmitContext is Submit, giving up when ctx is done. Note that a
+// transaction which has already been sent may still be applied.
+func (r *Remote) SubmitContext(ctx context.Context, tx data.Transaction) (*SubmitResult, error) {
 	_, raw, err := data.Raw(tx)
 	if err != nil {
 		return nil, err
@@ -217,16 +446,20 @@ func (r *Remote) Submit(tx data.Transaction) (*SubmitResult, error) {
 		Command: newCommand("submit"),
 		TxBlob:  fmt.Sprintf("%X", raw),
 	}
-	r.outgoing <- cmd
-	<-cmd.Ready
-	if cmd.CommandError != nil {
-		return nil, cmd.CommandError
+	if err := r.do(ctx, cmd); err != nil {
+		return nil, err
 	}
 	return cmd.Result, nil
 }
 
 // Synchronously submit multiple transactions
 func (r *Remote) SubmitBatch(txs []data.Transaction) ([]*SubmitResult, error) {
+	return r.SubmitBatchContext(context.Background(), txs)
+}
+
+// SubmitBatchContext is SubmitBatch, giving up when ctx is done.
+// Results for transactions which did not complete are nil.
+func (r *Remote) SubmitBatchContext(ctx context.Context, txs []data.Transaction) ([]*SubmitResult, error) {
 	commands := make([]*SubmitCommand, len(txs))
 	results := make([]*SubmitResult, len(txs))
 	for i := range txs {
@@ -234,15 +467,24 @@ func (r *Remote) SubmitBatch(txs []data.Transaction) ([]*SubmitResult, error) {
 		if err != nil {
 			return nil, err
 		}
-		cmd := &SubmitCommand{
+		commands[i] = &SubmitCommand{
 			Command: newCommand("submit"),
 			TxBlob:  fmt.Sprintf("%X", raw),
 		}
-		r.outgoing <- cmd
-		commands[i] = cmd
+		if err := r.send(ctx, commands[i]); err != nil {
+			return results, err
+		}
 	}
 	for i := range commands {
-		<-commands[i].Ready
+		if err := r.wait(ctx, commands[i]); err != nil {
+			if _, ok := err.(*TimeoutError); ok {
+				for _, cmd := range commands[i+1:] {
+					r.cancel(cmd)
+				}
+				return results, err
+			}
+			continue
+		}
 		results[i] = commands[i].Result
 	}
 	return results, nil
@@ -250,27 +492,28 @@ func (r *Remote) SubmitBatch(txs []data.Transaction) ([]*SubmitResult, error) {
 
 // Synchronously gets ledger entries
 func (r *Remote) LedgerData(ledger interface{}, marker *data.Hash256) (*LedgerDataResult, error) {
+	return r.LedgerDataContext(context.Background(), ledger, marker)
+}
+
+// LedgerDataContext is LedgerData, giving up when ctx is done
+func (r *Remote) LedgerDataContext(ctx context.Context, ledger interface{}, marker *data.Hash256) (*LedgerDataResult, error) {
 	cmd := &LedgerDataCommand{
 		Command: newCommand("ledger_data"),
 		Ledger:  ledger,
 		Marker:  marker,
 	}
-	r.outgoing <- cmd
-	<-cmd.Ready
-	if cmd.CommandError != nil {
-		return nil, cmd.CommandError
+	if err := r.do(ctx, cmd); err != nil {
+		return nil, err
 	}
 	return cmd.Result, nil
 }
 
-func (r *Remote) streamLedgerData(ledger interface{}, c chan data.LedgerEntrySlice) {
+func (r *Remote) streamLedgerData(ctx context.Context, ledger interface{}, c chan data.LedgerEntrySlice) {
 	defer close(c)
 	cmd := newBinaryLedgerDataCommand(ledger, nil)
 	for ; ; cmd = newBinaryLedgerDataCommand(ledger, cmd.Result.Marker) {
-		r.outgoing <- cmd
-		<-cmd.Ready
-		if cmd.CommandError != nil {
-			glog.Errorln(cmd.Error())
+		if err := r.do(ctx, cmd); err != nil {
+			glog.Errorln(err.Error())
 			return
 		}
 		les := make(data.LedgerEntrySlice, len(cmd.Result.State))
@@ -282,13 +525,19 @@ func (r *Remote) streamLedgerData(ledger interface{}, c chan data.LedgerEntrySli
 			}
 			les[i], err = data.ReadLedgerEntry(bytes.NewReader(b), data.Hash256{})
 			if err != nil {
-				glog.Errorln(err.Error())
-				glog.Errorln(state.Data)
-				glog.Errorln(state.Index)
-				continue
+				// Keep it as it is rather than leave a gap
+				glog.Errorf("Keeping ledger entry %s unread: %s", state.Index, err)
+				if les[i], err = data.NewUnknowLedger(b); err != nil {
+					glog.Errorln(err.Error())
+					return
+				}
 			}
 		}
-		c <- les
+		select {
+		case c <- les:
+		case <-ctx.Done():
+			return
+		}
 		if cmd.Result.Marker == nil {
 			return
 		}
@@ -297,23 +546,31 @@ func (r *Remote) streamLedgerData(ledger interface{}, c chan data.LedgerEntrySli
 
 // Asynchronously retrieve all data for a ledger using the binary form
 func (r *Remote) StreamLedgerData(ledger interface{}) chan data.LedgerEntrySlice {
+	return r.StreamLedgerDataContext(context.Background(), ledger)
+}
+
+// StreamLedgerDataContext is StreamLedgerData, closing the channel early when ctx is done
+func (r *Remote) StreamLedgerDataContext(ctx context.Context, ledger interface{}) chan data.LedgerEntrySlice {
 	c := make(chan data.LedgerEntrySlice)
-	go r.streamLedgerData(ledger, c)
+	go r.streamLedgerData(ctx, ledger, c)
 	return c
 }
 
 // Synchronously gets a single ledger
 func (r *Remote) Ledger(ledger interface{}, transactions bool) (*LedgerResult, error) {
+	return r.LedgerContext(context.Background(), ledger, transactions)
+}
+
+// LedgerContext is Ledger, giving up when ctx is done
+func (r *Remote) LedgerContext(ctx context.Context, ledger interface{}, transactions bool) (*LedgerResult, error) {
 	cmd := &LedgerCommand{
 		Command:      newCommand("ledger"),
 		LedgerIndex:  ledger,
 		Transactions: transactions,
 		Expand:       true,
 	}
-	r.outgoing <- cmd
-	<-cmd.Ready
-	if cmd.CommandError != nil {
-		return nil, cmd.CommandError
+	if err := r.do(ctx, cmd); err != nil {
+		return nil, err
 	}
 	cmd.Result.Ledger.Transactions.Sort()
 	return cmd.Result, nil
@@ -321,36 +578,47 @@ func (r *Remote) Ledger(ledger interface{}, transactions bool) (*LedgerResult, e
 
 // Synchronously gets a single ledger
 func (r *Remote) LedgerOnlyHash(ledger interface{}, transactions bool) (*LedgerResultOnlyHash, error) {
+	return r.LedgerOnlyHashContext(context.Background(), ledger, transactions)
+}
+
+// LedgerOnlyHashContext is LedgerOnlyHash, giving up when ctx is done
+func (r *Remote) LedgerOnlyHashContext(ctx context.Context, ledger interface{}, transactions bool) (*LedgerResultOnlyHash, error) {
 	cmd := &LedgerCommandOnlyHash{
 		Command:      newCommand("ledger"),
 		LedgerIndex:  ledger,
 		Transactions: transactions,
 		Expand:       false,
 	}
-	r.outgoing <- cmd
-	<-cmd.Ready
-	if cmd.CommandError != nil {
-		return nil, cmd.CommandError
+	if err := r.do(ctx, cmd); err != nil {
+		return nil, err
 	}
 	//cmd.Result.Ledger.Transactions.Sort()
 	return cmd.Result, nil
 }
 
 func (r *Remote) LedgerHeader(ledger interface{}) (*LedgerHeaderResult, error) {
+	return r.LedgerHeaderContext(context.Background(), ledger)
+}
+
+// LedgerHeaderContext is LedgerHeader, giving up when ctx is done
+func (r *Remote) LedgerHeaderContext(ctx context.Context, ledger interface{}) (*LedgerHeaderResult, error) {
 	cmd := &LedgerHeaderCommand{
 		Command: newCommand("ledger_header"),
 		Ledger:  ledger,
 	}
-	r.outgoing <- cmd
-	<-cmd.Ready
-	if cmd.CommandError != nil {
-		return nil, cmd.CommandError
+	if err := r.do(ctx, cmd); err != nil {
+		return nil, err
 	}
 	return cmd.Result, nil
 }
 
 // Synchronously requests paths
 func (r *Remote) RipplePathFind(src, dest data.Account, amount data.Amount, srcCurr *[]data.Currency) (*RipplePathFindResult, error) {
+	return r.RipplePathFindContext(context.Background(), src, dest, amount, srcCurr)
+}
+
+// RipplePathFindContext is RipplePathFind, giving up when ctx is done
+func (r *Remote) RipplePathFindContext(ctx context.Context, src, dest data.Account, amount data.Amount, srcCurr *[]data.Currency) (*RipplePathFindResult, error) {
 	cmd := &RipplePathFindCommand{
 		Command:       newCommand("ripple_path_find"),
 		SrcAccount:    src,
@@ -358,30 +626,36 @@ func (r *Remote) RipplePathFind(src, dest data.Account, amount data.Amount, srcC
 		DestAccount:   dest,
 		DestAmount:    amount,
 	}
-	r.outgoing <- cmd
-	<-cmd.Ready
-	if cmd.CommandError != nil {
-		return nil, cmd.CommandError
+	if err := r.do(ctx, cmd); err != nil {
+		return nil, err
 	}
 	return cmd.Result, nil
 }
 
 // Synchronously requests account info
 func (r *Remote) AccountInfo(a data.Account) (*AccountInfoResult, error) {
+	return r.AccountInfoContext(context.Background(), a)
+}
+
+// AccountInfoContext is AccountInfo, giving up when ctx is done
+func (r *Remote) AccountInfoContext(ctx context.Context, a data.Account) (*AccountInfoResult, error) {
 	cmd := &AccountInfoCommand{
 		Command: newCommand("account_info"),
 		Account: a,
 	}
-	r.outgoing <- cmd
-	<-cmd.Ready
-	if cmd.CommandError != nil {
-		return nil, cmd.CommandError
+	if err := r.do(ctx, cmd); err != nil {
+		return nil, err
 	}
 	return cmd.Result, nil
 }
 
 // Synchronously requests account line info
 func (r *Remote) AccountLines(account data.Account, ledgerIndex interface{}) (*AccountLinesResult, error) {
+	return r.AccountLinesContext(context.Background(), account, ledgerIndex)
+}
+
+// AccountLinesContext is AccountLines, giving up when ctx is done
+func (r *Remote) AccountLinesContext(ctx context.Context, account data.Account, ledgerIndex interface{}) (*AccountLinesResult, error) {
 	var (
 		lines  data.AccountLineSlice
 		marker *data.Hash256
@@ -394,11 +668,10 @@ func (r *Remote) AccountLines(account data.Account, ledgerIndex interface{}) (*A
 			Marker:      marker,
 			LedgerIndex: ledgerIndex,
 		}
-		r.outgoing <- cmd
-		<-cmd.Ready
+		err := r.do(ctx, cmd)
 		switch {
-		case cmd.CommandError != nil:
-			return nil, cmd.CommandError
+		case err != nil:
+			return nil, err
 		case cmd.Result.Marker != nil:
 			lines = append(lines, cmd.Result.Lines...)
 			marker = cmd.Result.Marker
@@ -415,6 +688,11 @@ func (r *Remote) AccountLines(account data.Account, ledgerIndex interface{}) (*A
 
 // Synchronously requests account offers
 func (r *Remote) AccountOffers(account data.Account, ledgerIndex interface{}) (*AccountOffersResult, error) {
+	return r.AccountOffersContext(context.Background(), account, ledgerIndex)
+}
+
+// AccountOffersContext is AccountOffers, giving up when ctx is done
+func (r *Remote) AccountOffersContext(ctx context.Context, account data.Account, ledgerIndex interface{}) (*AccountOffersResult, error) {
 	var (
 		offers data.AccountOfferSlice
 		marker *data.Hash256
@@ -427,11 +705,10 @@ func (r *Remote) AccountOffers(account data.Account, ledgerIndex interface{}) (*
 			Marker:      marker,
 			LedgerIndex: ledgerIndex,
 		}
-		r.outgoing <- cmd
-		<-cmd.Ready
+		err := r.do(ctx, cmd)
 		switch {
-		case cmd.CommandError != nil:
-			return nil, cmd.CommandError
+		case err != nil:
+			return nil, err
 		case cmd.Result.Marker != nil:
 			offers = append(offers, cmd.Result.Offers...)
 			marker = cmd.Result.Marker
@@ -446,7 +723,102 @@ func (r *Remote) AccountOffers(account data.Account, ledgerIndex interface{}) (*
 	}
 }
 
+// Synchronously requests the ledger entries owned by an account, of type
+// typ if it is not empty
+func (r *Remote) AccountObjects(account data.Account, typ string, ledgerIndex interface{}) (*AccountObjectsResult, error) {
+	return r.AccountObjectsContext(context.Background(), account, typ, ledgerIndex)
+}
+
+// AccountObjectsContext is AccountObjects, giving up when ctx is done
+func (r *Remote) AccountObjectsContext(ctx context.Context, account data.Account, typ string, ledgerIndex interface{}) (*AccountObjectsResult, error) {
+	return r.accountObjects(ctx, account, typ, false, ledgerIndex)
+}
+
+// AccountDeletionBlockers requests the ledger entries which stop an
+// account from being deleted
+func (r *Remote) AccountDeletionBlockers(account data.Account, ledgerIndex interface{}) (*AccountObjectsResult, error) {
+	return r.AccountDeletionBlockersContext(context.Background(), account, ledgerIndex)
+}
+
+// AccountDeletionBlockersContext is AccountDeletionBlockers, giving up when
+// ctx is done
+func (r *Remote) AccountDeletionBlockersContext(ctx context.Context, account data.Account, ledgerIndex interface{}) (*AccountObjectsResult, error) {
+	return r.accountObjects(ctx, account, "", true, ledgerIndex)
+}
+
+func (r *Remote) accountObjects(ctx context.Context, account data.Account, typ string, blockersOnly bool, ledgerIndex interface{}) (*AccountObjectsResult, error) {
+	var (
+		objects data.LedgerEntrySlice
+		marker  interface{}
+	)
+	for {
+		cmd := &AccountObjectsCommand{
+			Command:              newCommand("account_objects"),
+			Account:              account,
+			Type:                 typ,
+			DeletionBlockersOnly: blockersOnly,
+			Limit:                400,
+			Marker:               marker,
+			LedgerIndex:          ledgerIndex,
+		}
+		err := r.do(ctx, cmd)
+		switch {
+		case err != nil:
+			return nil, err
+		case cmd.Result.Marker != nil:
+			objects = append(objects, cmd.Result.AccountObjects...)
+			marker = cmd.Result.Marker
+			if cmd.Result.LedgerSequence != nil {
+				ledgerIndex = *cmd.Result.LedgerSequence
+			}
+		default:
+			cmd.Result.AccountObjects = append(objects, cmd.Result.AccountObjects...)
+			return cmd.Result, nil
+		}
+	}
+}
+
+// Synchronously asks whether source may send to destination
+func (r *Remote) DepositAuthorized(source, destination data.Account, ledgerIndex interface{}) (*DepositAuthorizedResult, error) {
+	return r.DepositAuthorizedContext(context.Background(), source, destination, ledgerIndex)
+}
+
+// DepositAuthorizedContext is DepositAuthorized, giving up when ctx is done
+func (r *Remote) DepositAuthorizedContext(ctx context.Context, source, destination data.Account, ledgerIndex interface{}) (*DepositAuthorizedResult, error) {
+	cmd := &DepositAuthorizedCommand{
+		Command:            newCommand("deposit_authorized"),
+		SourceAccount:      source,
+		DestinationAccount: destination,
+		LedgerIndex:        ledgerIndex,
+	}
+	if err := r.do(ctx, cmd); err != nil {
+		return nil, err
+	}
+	return cmd.Result, nil
+}
+
+// Synchronously gets the server's codec definitions for data.SetDefinitions
+func (r *Remote) ServerDefinitions() (*data.Definitions, error) {
+	return r.ServerDefinitionsContext(context.Background())
+}
+
+// ServerDefinitionsContext is ServerDefinitions, giving up when ctx is done
+func (r *Remote) ServerDefinitionsContext(ctx context.Context) (*data.Definitions, error) {
+	cmd := &ServerDefinitionsCommand{
+		Command: newCommand("server_definitions"),
+	}
+	if err := r.do(ctx, cmd); err != nil {
+		return nil, err
+	}
+	return cmd.Result, nil
+}
+
 func (r *Remote) BookOffers(taker data.Account, ledgerIndex interface{}, pays, gets data.Asset) (*BookOffersResult, error) {
+	return r.BookOffersContext(context.Background(), taker, ledgerIndex, pays, gets)
+}
+
+// BookOffersContext is BookOffers, giving up when ctx is done
+func (r *Remote) BookOffersContext(ctx context.Context, taker data.Account, ledgerIndex interface{}, pays, gets data.Asset) (*BookOffersResult, error) {
 	cmd := &BookOffersCommand{
 		Command:     newCommand("book_offers"),
 		LedgerIndex: ledgerIndex,
@@ -455,10 +827,27 @@ func (r *Remote) BookOffers(taker data.Account, ledgerIndex interface{}, pays, g
 		TakerGets:   gets,
 		Limit:       5000, // Marker not implemented....
 	}
-	r.outgoing <- cmd
-	<-cmd.Ready
-	if cmd.CommandError != nil {
-		return nil, cmd.CommandError
+	if err := r.do(ctx, cmd); err != nil {
+		return nil, err
+	}
+	return cmd.Result, nil
+}
+
+// AMMInfo requests the AMM for a pair of assets
+func (r *Remote) AMMInfo(asset, asset2 data.Issue, ledgerIndex interface{}) (*AMMInfoResult, error) {
+	return r.AMMInfoContext(context.Background(), asset, asset2, ledgerIndex)
+}
+
+// AMMInfoContext is AMMInfo, giving up when ctx is done
+func (r *Remote) AMMInfoContext(ctx context.Context, asset, asset2 data.Issue, ledgerIndex interface{}) (*AMMInfoResult, error) {
+	cmd := &AMMInfoCommand{
+		Command:     newCommand("amm_info"),
+		Asset:       &asset,
+		Asset2:      &asset2,
+		LedgerIndex: ledgerIndex,
+	}
+	if err := r.do(ctx, cmd); err != nil {
+		return nil, err
 	}
 	return cmd.Result, nil
 }
@@ -466,6 +855,11 @@ func (r *Remote) BookOffers(taker data.Account, ledgerIndex interface{}, pays, g
 // Synchronously subscribe to streams and receive a confirmation message
 // Streams are recived asynchronously over the Incoming channel
 func (r *Remote) Subscribe(ledger, transactions, transactionsProposed, server bool) (*SubscribeResult, error) {
+	return r.SubscribeContext(context.Background(), ledger, transactions, transactionsProposed, server)
+}
+
+// SubscribeContext is Subscribe, giving up when ctx is done
+func (r *Remote) SubscribeContext(ctx context.Context, ledger, transactions, transactionsProposed, server bool) (*SubscribeResult, error) {
 	streams := []string{}
 	if ledger {
 		streams = append(streams, "ledger")
@@ -483,10 +877,8 @@ func (r *Remote) Subscribe(ledger, transactions, transactionsProposed, server bo
 		Command: newCommand("subscribe"),
 		Streams: streams,
 	}
-	r.outgoing <- cmd
-	<-cmd.Ready
-	if cmd.CommandError != nil {
-		return nil, cmd.CommandError
+	if err := r.do(ctx, cmd); err != nil {
+		return nil, err
 	}
 
 	if ledger && cmd.Result.LedgerStreamMsg == nil {
@@ -506,44 +898,50 @@ type OrderBookSubscription struct {
 }
 
 func (r *Remote) SubscribeOrderBooks(books []OrderBookSubscription) (*SubscribeResult, error) {
+	return r.SubscribeOrderBooksContext(context.Background(), books)
+}
+
+// SubscribeOrderBooksContext is SubscribeOrderBooks, giving up when ctx is done
+func (r *Remote) SubscribeOrderBooksContext(ctx context.Context, books []OrderBookSubscription) (*SubscribeResult, error) {
 	cmd := &SubscribeCommand{
 		Command: newCommand("subscribe"),
 		Streams: []string{"ledger", "server"},
 		Books:   books,
 	}
-	r.outgoing <- cmd
-	<-cmd.Ready
-	if cmd.CommandError != nil {
-		return nil, cmd.CommandError
+	if err := r.do(ctx, cmd); err != nil {
+		return nil, err
 	}
 	return cmd.Result, nil
 }
 
 func (r *Remote) Fee() (*FeeResult, error) {
+	return r.FeeContext(context.Background())
+}
+
+// FeeContext is Fee, giving up when ctx is done
+func (r *Remote) FeeContext(ctx context.Context) (*FeeResult, error) {
 	cmd := &FeeCommand{
 		Command: newCommand("fee"),
 	}
-	r.outgoing <- cmd
-	<-cmd.Ready
-	if cmd.CommandError != nil {
-		return nil, cmd.CommandError
+	if err := r.do(ctx, cmd); err != nil {
+		return nil, err
 	}
 	return cmd.Result, nil
 }
 
 // readPump reads from the websocket and sends to inbound channel.
-// Expects to receive PONGs at specified interval, or logs an error and returns.
-func (r *Remote) readPump(inbound chan<- []byte) {
-	r.ws.SetReadDeadline(time.Now().Add(pongWait))
-	r.ws.SetPongHandler(func(string) error { r.ws.SetReadDeadline(time.Now().Add(pongWait)); return nil })
+// Expects to receive PONGs at specified interval, or logs an error and returns it.
+func (r *Remote) readPump(ws *websocket.Conn, inbound chan<- []byte) error {
+	ws.SetReadDeadline(time.Now().Add(pongWait))
+	ws.SetPongHandler(func(string) error { ws.SetReadDeadline(time.Now().Add(pongWait)); return nil })
 	for {
-		_, message, err := r.ws.ReadMessage()
+		_, message, err := ws.ReadMessage()
 		if err != nil {
 			glog.Errorln(err)
-			return
+			return err
 		}
 		glog.V(2).Infoln(dump(message))
-		r.ws.SetReadDeadline(time.Now().Add(pongWait))
+		ws.SetReadDeadline(time.Now().Add(pongWait))
 		inbound <- message
 	}
 }
@@ -551,7 +949,7 @@ func (r *Remote) readPump(inbound chan<- []byte) {
 // Consumes from the outbound channel and sends them over the websocket.
 // Also sends PING messages at the specified interval.
 // Returns when outbound channel is closed, or an error is encountered.
-func (r *Remote) writePump(outbound <-chan interface{}) {
+func (r *Remote) writePump(ws *websocket.Conn, outbound <-chan interface{}) {
 	ticker := time.NewTicker(pingPeriod)
 	defer ticker.Stop()
 
@@ -561,7 +959,7 @@ func (r *Remote) writePump(outbound <-chan interface{}) {
 		// An outbound message is available to send
 		case message, ok := <-outbound:
 			if !ok {
-				r.ws.WriteMessage(websocket.CloseMessage, []byte{})
+				ws.WriteMessage(websocket.CloseMessage, []byte{})
 				return
 			}
 
@@ -573,14 +971,14 @@ func (r *Remote) writePump(outbound <-chan interface{}) {
 			}
 
 			glog.V(2).Infoln(dump(b))
-			if err := r.ws.WriteMessage(websocket.TextMessage, b); err != nil {
+			if err := ws.WriteMessage(websocket.TextMessage, b); err != nil {
 				glog.Errorln(err)
 				return
 			}
 
 		// Time to send a ping
 		case <-ticker.C:
-			if err := r.ws.WriteMessage(websocket.PingMessage, []byte{}); err != nil {
+			if err := ws.WriteMessage(websocket.PingMessage, []byte{}); err != nil {
 				glog.Errorln(err)
 				return
 			}
@@ -595,37 +993,47 @@ func dump(b []byte) string {
 	return string(out)
 }
 func (r *Remote) ServerState() (*ServerStateResult, error) {
+	return r.ServerStateContext(context.Background())
+}
+
+// ServerStateContext is ServerState, giving up when ctx is done
+func (r *Remote) ServerStateContext(ctx context.Context) (*ServerStateResult, error) {
 	cmd := &ServerStateCommand{
 		Command: newCommand("server_state"),
 	}
-	r.outgoing <- cmd
-	<-cmd.Ready
-	if cmd.CommandError != nil {
-		return nil, cmd.CommandError
+	if err := r.do(ctx, cmd); err != nil {
+		return nil, err
 	}
 	return cmd.Result, nil
 }
 
 func (r *Remote) ServerInfo() (*ServerInfoResult, error) {
+	return r.ServerInfoContext(context.Background())
+}
+
+// ServerInfoContext is ServerInfo, giving up when ctx is done
+func (r *Remote) ServerInfoContext(ctx context.Context) (*ServerInfoResult, error) {
 	cmd := &ServerInfoCommand{
 		Command: newCommand("server_info"),
 	}
-	r.outgoing <- cmd
-	<-cmd.Ready
-	if cmd.CommandError != nil {
-		return nil, cmd.CommandError
+	if err := r.do(ctx, cmd); err != nil {
+		return nil, err
 	}
 	return cmd.Result, nil
 }
+
 func (r *Remote) SubmitMultiSign(tx data.MultiSignTransaction) (*SubmitResult, error) {
+	return r.SubmitMultiSignContext(context.Background(), tx)
+}
+
+// SubmitMultiSignContext is SubmitMultiSign, giving up when ctx is done
+func (r *Remote) SubmitMultiSignContext(ctx context.Context, tx data.MultiSignTransaction) (*SubmitResult, error) {
 	cmd := &SubmitMultiSignCommand{
 		Command: newCommand("submit_multisigned"),
 		TxBlob:  tx,
 	}
-	r.outgoing <- cmd
-	<-cmd.Ready
-	if cmd.CommandError != nil {
-		return nil, cmd.CommandError
+	if err := r.do(ctx, cmd); err != nil {
+		return nil, err
 	}
 	return cmd.Result, nil
 }
@@ -0,0 +1,505 @@
+package websockets
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/goodwood511/ripple_lib/ripple-sdk/data"
+
+	"github.com/gorilla/websocket"
+)
+
+const testWait = 5 * time.Second
+
+// testMessage is a command received by a testServer
+type testMessage struct {
+	Conn    int // Index of the connection it arrived on
+	Id      uint64
+	Command string
+	Streams []string
+}
+
+// testServer is a websocket server standing in for rippled, which
+// records every command it receives and can drop connections at will
+type testServer struct {
+	*httptest.Server
+	t        *testing.T
+	mu       sync.Mutex
+	conns    []*websocket.Conn
+	received []testMessage
+	reject   int // Number of upgrades to refuse before accepting again
+
+	// reply returns the response to a command, or nil to send none
+	reply func(msg testMessage) interface{}
+}
+
+func newTestServer(t *testing.T) *testServer {
+	s := &testServer{t: t, reply: testReply}
+	upgrader := websocket.Upgrader{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		s.mu.Lock()
+		if s.reject > 0 {
+			s.reject--
+			s.mu.Unlock()
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		s.mu.Unlock()
+		ws, err := upgrader.Upgrade(w, req, nil)
+		if err != nil {
+			return
+		}
+		s.mu.Lock()
+		conn := len(s.conns)
+		s.conns = append(s.conns, ws)
+		s.mu.Unlock()
+		s.serve(conn, ws)
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *testServer) serve(conn int, ws *websocket.Conn) {
+	for {
+		_, b, err := ws.ReadMessage()
+		if err != nil {
+			return
+		}
+		var msg testMessage
+		if err := json.Unmarshal(b, &msg); err != nil {
+			s.t.Error(err)
+			return
+		}
+		msg.Conn = conn
+		s.mu.Lock()
+		s.received = append(s.received, msg)
+		reply := s.reply
+		s.mu.Unlock()
+		if response := reply(msg); response != nil {
+			s.send(conn, response)
+		}
+	}
+}
+
+// send writes a message to a connection, ignoring it if it has dropped
+func (s *testServer) send(conn int, message interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.conns[conn].WriteJSON(message)
+}
+
+// drop closes the latest connection without a close handshake, as a
+// network failure would
+func (s *testServer) drop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.conns[len(s.conns)-1].Close()
+}
+
+func (s *testServer) setReply(reply func(msg testMessage) interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.reply = reply
+}
+
+func (s *testServer) setReject(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.reject = n
+}
+
+// commands returns the received commands with the given name
+func (s *testServer) commands(name string) []testMessage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var found []testMessage
+	for _, msg := range s.received {
+		if msg.Command == name {
+			found = append(found, msg)
+		}
+	}
+	return found
+}
+
+// waitFor blocks until n commands with the given name have been received
+func (s *testServer) waitFor(name string, n int) []testMessage {
+	s.t.Helper()
+	deadline := time.Now().Add(testWait)
+	for time.Now().Before(deadline) {
+		if found := s.commands(name); len(found) >= n {
+			return found
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	s.t.Fatalf("Timed out waiting for %d %s commands, got %d", n, name, len(s.commands(name)))
+	return nil
+}
+
+func (s *testServer) endpoint() string {
+	return "ws" + strings.TrimPrefix(s.URL, "http")
+}
+
+func (s *testServer) remote() *Remote {
+	s.t.Helper()
+	r, err := NewRemote(s.endpoint())
+	if err != nil {
+		s.t.Fatal(err)
+	}
+	return r
+}
+
+func testResponse(id uint64, result interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"id":     id,
+		"type":   "response",
+		"status": "success",
+		"result": result,
+	}
+}
+
+// testReply answers the commands used by these tests as rippled would
+func testReply(msg testMessage) interface{} {
+	switch msg.Command {
+	case "subscribe":
+		return testResponse(msg.Id, map[string]interface{}{
+			"fee_base":          10,
+			"fee_ref":           10,
+			"ledger_hash":       "0F0B0E1A5A8E5A1E7E4B1F8C2A6E1F5D8C7B6A5948372615041F2E3D4C5B6A79",
+			"ledger_index":      94000000,
+			"ledger_time":       781000000,
+			"reserve_base":      1000000,
+			"reserve_inc":       200000,
+			"validated_ledgers": "93000000-94000000",
+		})
+	case "server_info":
+		return testResponse(msg.Id, map[string]interface{}{
+			"info": map[string]interface{}{
+				"build_version": "2.2.0",
+				"server_state":  "full",
+			},
+		})
+	case "submit":
+		return testResponse(msg.Id, map[string]interface{}{
+			"engine_result":         "tesSUCCESS",
+			"engine_result_code":    0,
+			"engine_result_message": "The transaction was applied. Only final in a validated ledger.",
+		})
+	}
+	return nil
+}
+
+func testPayment(t *testing.T) *data.Payment {
+	account, err := data.NewAccountFromAddress("rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh")
+	if err != nil {
+		t.Fatal(err)
+	}
+	destination, err := data.NewAccountFromAddress("rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe")
+	if err != nil {
+		t.Fatal(err)
+	}
+	amount, err := data.NewAmount(int64(1000000))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fee, err := data.NewNativeValue(12)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &data.Payment{
+		TxBase: data.TxBase{
+			TransactionType: data.PAYMENT,
+			Account:         *account,
+			Sequence:        1,
+			Fee:             *fee,
+		},
+		Destination: *destination,
+		Amount:      *amount,
+	}
+}
+
+// nextEvent returns the next ConnectionEvent, failing if none arrives
+func nextEvent(t *testing.T, r *Remote) ConnectionEvent {
+	t.Helper()
+	select {
+	case e, ok := <-r.Events:
+		if !ok {
+			t.Fatal("Events closed")
+		}
+		return e
+	case <-time.After(testWait):
+		t.Fatal("Timed out waiting for a connection event")
+	}
+	return ConnectionEvent{}
+}
+
+func TestReconnectReplaysSubscriptionOnce(t *testing.T) {
+	s := newTestServer(t)
+	r := s.remote()
+	defer r.Close()
+
+	result, err := r.Subscribe(true, false, false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.LedgerSequence != 94000000 {
+		t.Errorf("Wrong ledger in subscribe result: %d", result.LedgerSequence)
+	}
+
+	for conn := 1; conn <= 2; conn++ {
+		s.drop()
+		s.waitFor("subscribe", conn+1)
+
+		// The replayed subscribe goes out before anything else, so once
+		// a later command has been answered the replay cannot be sent again.
+		if _, err := r.ServerInfo(); err != nil {
+			t.Fatal(err)
+		}
+		var replayed []testMessage
+		for _, msg := range s.commands("subscribe") {
+			if msg.Conn == conn {
+				replayed = append(replayed, msg)
+			}
+		}
+		if len(replayed) != 1 {
+			t.Fatalf("Subscribe sent %d times on connection %d", len(replayed), conn)
+		}
+		if !reflect.DeepEqual(replayed[0].Streams, []string{"ledger"}) {
+			t.Errorf("Replayed streams %v on connection %d", replayed[0].Streams, conn)
+		}
+	}
+}
+
+func TestReconnectRetriesOnlyIdempotentCommands(t *testing.T) {
+	s := newTestServer(t)
+	r := s.remote()
+	defer r.Close()
+
+	// Leave everything on the first connection unanswered
+	s.setReply(func(msg testMessage) interface{} {
+		if msg.Conn == 0 {
+			return nil
+		}
+		return testReply(msg)
+	})
+
+	tx := testPayment(t)
+	infoErr := make(chan error, 1)
+	submitErr := make(chan error, 1)
+	go func() {
+		_, err := r.ServerInfo()
+		infoErr <- err
+	}()
+	go func() {
+		_, err := r.Submit(tx)
+		submitErr <- err
+	}()
+	s.waitFor("server_info", 1)
+	s.waitFor("submit", 1)
+	s.drop()
+
+	select {
+	case err := <-submitErr:
+		cmdErr, ok := err.(*CommandError)
+		if !ok || cmdErr.Message != "Connection Closed" {
+			t.Errorf("Expected the submit to fail with Connection Closed, got %v", err)
+		}
+	case <-time.After(testWait):
+		t.Fatal("Submit did not fail when the connection dropped")
+	}
+	select {
+	case err := <-infoErr:
+		if err != nil {
+			t.Fatalf("ServerInfo was not retried: %s", err)
+		}
+	case <-time.After(testWait):
+		t.Fatal("ServerInfo was not retried")
+	}
+
+	info := s.commands("server_info")
+	if len(info) != 2 || info[0].Id != info[1].Id || info[1].Conn != 1 {
+		t.Errorf("Expected server_info to be resent once on the new connection: %+v", info)
+	}
+	if submits := s.commands("submit"); len(submits) != 1 {
+		t.Errorf("Submit was sent %d times", len(submits))
+	}
+}
+
+func TestConnectionEvents(t *testing.T) {
+	s := newTestServer(t)
+	r := s.remote()
+
+	if e := nextEvent(t, r); e.State != Connected || e.Downtime != 0 {
+		t.Fatalf("Expected initial Connected, got %+v", e)
+	}
+
+	s.setReject(1)
+	s.drop()
+	if e := nextEvent(t, r); e.State != Disconnected || e.Err == nil {
+		t.Fatalf("Expected Disconnected with a reason, got %+v", e)
+	}
+	if e := nextEvent(t, r); e.State != Reconnecting || e.Attempt != 1 || e.Err == nil {
+		t.Fatalf("Expected a failed first Reconnecting attempt, got %+v", e)
+	}
+	if e := nextEvent(t, r); e.State != Connected || e.Downtime <= 0 {
+		t.Fatalf("Expected Connected with downtime, got %+v", e)
+	}
+	if _, err := r.ServerInfo(); err != nil {
+		t.Fatal(err)
+	}
+
+	r.Close()
+	if e := nextEvent(t, r); e.State != Closed {
+		t.Fatalf("Expected Closed, got %+v", e)
+	}
+	if e, ok := <-r.Events; ok {
+		t.Fatalf("Unexpected event after Closed: %+v", e)
+	}
+}
+
+// waitAbandoned sends a server_info which the server does not answer,
+// returning the error once ctx is done
+func waitAbandoned(t *testing.T, ctx context.Context, r *Remote) *TimeoutError {
+	t.Helper()
+	_, err := r.ServerInfoContext(ctx)
+	timeout, ok := err.(*TimeoutError)
+	if !ok {
+		t.Fatalf("Expected a TimeoutError, got %v", err)
+	}
+	if timeout.Name != "server_info" {
+		t.Errorf("Wrong command abandoned: %s", timeout.Name)
+	}
+	return timeout
+}
+
+func TestContextAbandonsCommand(t *testing.T) {
+	s := newTestServer(t)
+	r := s.remote()
+	defer r.Close()
+
+	// Only answer commands on later connections
+	s.setReply(func(msg testMessage) interface{} {
+		if msg.Conn == 0 {
+			return nil
+		}
+		return testReply(msg)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	expired := waitAbandoned(t, ctx, r)
+	if !expired.Timeout() || !errors.Is(expired, context.DeadlineExceeded) {
+		t.Errorf("Expected an expired deadline: %s", expired)
+	}
+
+	ctx, cancel = context.WithCancel(context.Background())
+	go func() {
+		s.waitFor("server_info", 2)
+		cancel()
+	}()
+	cancelled := waitAbandoned(t, ctx, r)
+	if cancelled.Timeout() || !errors.Is(cancelled, context.Canceled) {
+		t.Errorf("Expected a cancellation: %s", cancelled)
+	}
+
+	sent := s.commands("server_info")
+	if len(sent) != 2 || sent[0].Id != expired.Id || sent[1].Id != cancelled.Id {
+		t.Fatalf("TimeoutErrors do not match the commands sent: %+v", sent)
+	}
+
+	// Neither command is still pending, so neither is retried
+	s.drop()
+	if _, err := r.ServerInfo(); err != nil {
+		t.Fatal(err)
+	}
+	for _, msg := range s.commands("server_info")[2:] {
+		if msg.Id == expired.Id || msg.Id == cancelled.Id {
+			t.Errorf("Abandoned command %d was retried", msg.Id)
+		}
+	}
+}
+
+func TestLateResponseDropped(t *testing.T) {
+	s := newTestServer(t)
+	r := s.remote()
+	defer r.Close()
+
+	// Leave the first command unanswered until it has been abandoned
+	s.setReply(func(msg testMessage) interface{} {
+		if len(s.commands("server_info")) == 1 {
+			return nil
+		}
+		return testReply(msg)
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	late := testMessage{Id: waitAbandoned(t, ctx, r).Id, Command: "server_info"}
+
+	// Answer the abandoned command, twice for good measure
+	s.send(0, testReply(late))
+	s.send(0, testReply(late))
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := r.ServerInfo()
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(testWait):
+		t.Fatal("Remote blocked on a late response")
+	}
+	select {
+	case msg := <-r.Incoming:
+		t.Errorf("Late response delivered to Incoming: %+v", msg)
+	default:
+	}
+}
+
+// A response can still arrive for an abandoned command whose cancellation
+// the run loop has not yet seen. It must be discarded without blocking.
+func TestLateResponseRacingCancellation(t *testing.T) {
+	r := &Remote{
+		Incoming:  make(chan interface{}),
+		cancelled: make(chan uint64), // Nobody reading, as if the run loop were busy
+	}
+	cmd := &ServerInfoCommand{
+		Command: newCommand("server_info"),
+	}
+	pending := map[uint64]Syncer{cmd.Id: cmd}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, ok := r.abandon(ctx, cmd).(*TimeoutError); !ok {
+		t.Fatal("Expected a TimeoutError")
+	}
+
+	response, err := json.Marshal(testReply(testMessage{Id: cmd.Id, Command: "server_info"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	handled := make(chan struct{})
+	go func() {
+		r.handle(response, pending)
+		r.handle(response, pending)
+		close(handled)
+	}()
+	select {
+	case <-handled:
+	case <-time.After(testWait):
+		t.Fatal("Late response blocked the Remote")
+	}
+	if len(pending) != 0 {
+		t.Errorf("Abandoned command still pending: %v", pending)
+	}
+}
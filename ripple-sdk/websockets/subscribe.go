@@ -3,7 +3,7 @@ package websockets
 import (
 	"encoding/json"
 
-	"github.com/goodwood511/ripple_lib/rubblelabs/ripple/data"
+	"github.com/goodwood511/ripple_lib/ripple-sdk/data"
 )
 
 // Fields from subscribed ledger stream messages
@@ -61,6 +61,7 @@ type SubscribeCommand struct {
 	Streams []string                `json:"streams"`
 	Books   []OrderBookSubscription `json:"books,omitempty"`
 	Result  *SubscribeResult        `json:"result,omitempty"`
+	replay  bool
 }
 
 type SubscribeResult struct {
@@ -0,0 +1,420 @@
+// Package keystore keeps seeds and private keys encrypted with a
+// passphrase, in a versioned JSON file. A key is only ever decrypted into
+// an UnlockedKey, which signs as a crypto.KeySigner and so can be given to
+// the data and ripple signing helpers without its secret leaving here.
+package keystore
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/goodwood511/ripple_lib/ripple-sdk/crypto"
+	"github.com/goodwood511/ripple_lib/ripple-sdk/data"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/scrypt"
+)
+
+// Version is the version of the file format which this package writes
+const Version = 1
+
+// The kinds of secret a key holds
+const (
+	KindSeed       = "seed"
+	KindPrivateKey = "private_key"
+)
+
+const cipherAES256GCM = "aes-256-gcm"
+
+// KDF derives the encryption key from a passphrase. Salt is random for
+// each key and is filled in when a key is added.
+type KDF struct {
+	Name    string `json:"name"`
+	Salt    string `json:"salt,omitempty"`
+	N       int    `json:"n,omitempty"`
+	R       int    `json:"r,omitempty"`
+	P       int    `json:"p,omitempty"`
+	Time    uint32 `json:"time,omitempty"`
+	Memory  uint32 `json:"memory,omitempty"` // in KiB
+	Threads uint8  `json:"threads,omitempty"`
+}
+
+var (
+	// Scrypt is scrypt with the parameters of most wallets
+	Scrypt = KDF{Name: "scrypt", N: 1 << 18, R: 8, P: 1}
+	// Argon2id is argon2id with the parameters of RFC 9106's second choice
+	Argon2id = KDF{Name: "argon2id", Time: 3, Memory: 64 * 1024, Threads: 4}
+)
+
+// Limits on the KDF parameters of a key, so that a key file can not make
+// unlocking it take more than a gigabyte of memory or minutes of time
+const (
+	maxScryptN      = 1 << 20
+	maxScryptR      = 32
+	maxScryptP      = 16
+	maxScryptMemory = 1 << 30 // in bytes, 128 * N * R
+	maxArgon2Time   = 64
+	maxArgon2Memory = 1 << 20 // in KiB
+)
+
+// check rejects parameters outside the limits before any work is done
+func (k *KDF) check() error {
+	switch k.Name {
+	case "scrypt":
+		if k.N < 2 || k.N > maxScryptN || k.R < 1 || k.R > maxScryptR || k.P < 1 || k.P > maxScryptP || 128*k.N*k.R > maxScryptMemory {
+			return fmt.Errorf("Bad scrypt parameters: N %d, r %d, p %d", k.N, k.R, k.P)
+		}
+	case "argon2id":
+		if k.Time == 0 || k.Time > maxArgon2Time || k.Memory == 0 || k.Memory > maxArgon2Memory || k.Threads == 0 {
+			return fmt.Errorf("Bad argon2id parameters: time %d, memory %d KiB, threads %d", k.Time, k.Memory, k.Threads)
+		}
+	default:
+		return fmt.Errorf("Unknown KDF: %s", k.Name)
+	}
+	return nil
+}
+
+func (k *KDF) derive(passphrase []byte) ([]byte, error) {
+	salt, err := hex.DecodeString(k.Salt)
+	if err != nil || len(salt) < 16 {
+		return nil, fmt.Errorf("Bad salt: %s", k.Salt)
+	}
+	if err := k.check(); err != nil {
+		return nil, err
+	}
+	if k.Name == "scrypt" {
+		return scrypt.Key(passphrase, salt, k.N, k.R, k.P, 32)
+	}
+	return argon2.IDKey(passphrase, salt, k.Time, k.Memory, k.Threads, 32), nil
+}
+
+// Key is an encrypted seed or private key. The other fields are not
+// secret, but are authenticated with the secret, so none can be changed
+// without the passphrase.
+type Key struct {
+	Label      string       `json:"label"`
+	Address    string       `json:"address"`
+	PublicKey  string       `json:"public_key"`
+	Kind       string       `json:"kind"`
+	KeyType    data.KeyType `json:"key_type"`
+	KDF        KDF          `json:"kdf"`
+	Cipher     string       `json:"cipher"`
+	Nonce      string       `json:"nonce"`
+	Ciphertext string       `json:"ciphertext"`
+}
+
+// additionalData is what is authenticated along with the secret
+func (k *Key) additionalData() []byte {
+	kdf, _ := json.Marshal(k.KDF)
+	b, _ := json.Marshal([]interface{}{Version, k.Label, k.Address, k.PublicKey, k.Kind, k.KeyType, string(kdf), k.Cipher})
+	return b
+}
+
+func (k *Key) aead(passphrase []byte) (cipher.AEAD, error) {
+	if k.Cipher != cipherAES256GCM {
+		return nil, fmt.Errorf("Unknown cipher: %s", k.Cipher)
+	}
+	key, err := k.KDF.derive(passphrase)
+	if err != nil {
+		return nil, err
+	}
+	defer wipe(key)
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// Unlock decrypts the key with its passphrase
+func (k *Key) Unlock(passphrase []byte) (*UnlockedKey, error) {
+	aead, err := k.aead(passphrase)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := hex.DecodeString(k.Nonce)
+	if err != nil || len(nonce) != aead.NonceSize() {
+		return nil, fmt.Errorf("Bad nonce for key: %s", k.Label)
+	}
+	ciphertext, err := hex.DecodeString(k.Ciphertext)
+	if err != nil {
+		return nil, fmt.Errorf("Bad ciphertext for key: %s", k.Label)
+	}
+	secret, err := aead.Open(nil, nonce, ciphertext, k.additionalData())
+	if err != nil {
+		return nil, fmt.Errorf("Wrong passphrase or altered key: %s", k.Label)
+	}
+	defer wipe(secret)
+	u, err := newUnlockedKey(k.Kind, k.KeyType, secret)
+	if err != nil {
+		return nil, err
+	}
+	if u.Address() != k.Address {
+		return nil, fmt.Errorf("Key %s is for %s, not %s", k.Label, u.Address(), k.Address)
+	}
+	return u, nil
+}
+
+// UnlockedKey is a decrypted key. It keeps the secret only as the
+// crypto.Key which signs with it.
+type UnlockedKey struct {
+	key      crypto.Key
+	sequence *uint32
+}
+
+var _ crypto.KeySigner = (*UnlockedKey)(nil)
+
+func newUnlockedKey(kind string, keyType data.KeyType, secret []byte) (*UnlockedKey, error) {
+	switch kind {
+	case KindSeed:
+		var seed data.Seed
+		if len(secret) != len(seed) {
+			return nil, fmt.Errorf("Seed is wrong size: %d", len(secret))
+		}
+		copy(seed[:], secret)
+		defer wipe(seed[:])
+		switch keyType {
+		case data.ECDSA:
+			// The first account of the seed's family, as rippled derives it
+			var sequence uint32
+			return &UnlockedKey{key: seed.Key(keyType), sequence: &sequence}, nil
+		case data.Ed25519:
+			return &UnlockedKey{key: seed.Key(keyType)}, nil
+		default:
+			return nil, fmt.Errorf("Unknown key type %v", keyType)
+		}
+	case KindPrivateKey:
+		key, err := crypto.NewKeyFromPrivate(secret)
+		if err != nil {
+			return nil, err
+		}
+		return &UnlockedKey{key: key}, nil
+	default:
+		return nil, fmt.Errorf("Unknown kind of key: %s", kind)
+	}
+}
+
+func (u *UnlockedKey) Public() []byte {
+	if u.key == nil {
+		return nil
+	}
+	return u.key.Public(u.sequence)
+}
+
+func (u *UnlockedKey) Sign(hash, msg []byte) ([]byte, error) {
+	if u.key == nil {
+		return nil, fmt.Errorf("Key is locked")
+	}
+	return crypto.Sign(u.key.Private(u.sequence), hash, msg)
+}
+
+// Address is the account the key signs for
+func (u *UnlockedKey) Address() string {
+	if u.key == nil {
+		return ""
+	}
+	account, err := crypto.AccountId(u.key, u.sequence)
+	if err != nil {
+		return ""
+	}
+	return account.String()
+}
+
+// Lock zeroes the key, after which it can no longer sign
+func (u *UnlockedKey) Lock() {
+	switch k := u.key.(type) {
+	case nil:
+	case interface{ PrivKey() *btcec.PrivateKey }:
+		k.PrivKey().Zero()
+	default:
+		// Ed25519 keys return their own private key
+		wipe(k.Private(nil))
+	}
+	u.key, u.sequence = nil, nil
+}
+
+// Keystore is a set of keys, each with a unique label
+type Keystore struct {
+	Version int    `json:"version"`
+	Keys    []*Key `json:"keys"`
+}
+
+func New() *Keystore {
+	return &Keystore{Version: Version}
+}
+
+// Load reads a keystore written by Save
+func Load(r io.Reader) (*Keystore, error) {
+	var ks Keystore
+	if err := json.NewDecoder(r).Decode(&ks); err != nil {
+		return nil, err
+	}
+	if ks.Version != Version {
+		return nil, fmt.Errorf("Unsupported keystore version: %d", ks.Version)
+	}
+	labels := make(map[string]bool)
+	for _, k := range ks.Keys {
+		if labels[k.Label] {
+			return nil, fmt.Errorf("Duplicate key label: %s", k.Label)
+		}
+		labels[k.Label] = true
+	}
+	return &ks, nil
+}
+
+func LoadFile(path string) (*Keystore, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return Load(f)
+}
+
+func (ks *Keystore) Save(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(ks)
+}
+
+// SaveFile writes the keystore to path, readable only by its owner,
+// replacing any file there only once it is complete
+func (ks *Keystore) SaveFile(path string) error {
+	tmp := path + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	if err := ks.Save(f); err != nil {
+		f.Close()
+		os.Remove(tmp)
+		return err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// Get returns the key with the given label
+func (ks *Keystore) Get(label string) (*Key, error) {
+	for _, k := range ks.Keys {
+		if k.Label == label {
+			return k, nil
+		}
+	}
+	return nil, fmt.Errorf("No key: %s", label)
+}
+
+// Unlock decrypts the key with the given label
+func (ks *Keystore) Unlock(label string, passphrase []byte) (*UnlockedKey, error) {
+	k, err := ks.Get(label)
+	if err != nil {
+		return nil, err
+	}
+	return k.Unlock(passphrase)
+}
+
+// Labels are the labels of the keys, sorted
+func (ks *Keystore) Labels() []string {
+	labels := make([]string, 0, len(ks.Keys))
+	for _, k := range ks.Keys {
+		labels = append(labels, k.Label)
+	}
+	sort.Strings(labels)
+	return labels
+}
+
+// Remove removes the key with the given label
+func (ks *Keystore) Remove(label string) error {
+	for i, k := range ks.Keys {
+		if k.Label == label {
+			ks.Keys = append(ks.Keys[:i], ks.Keys[i+1:]...)
+			return nil
+		}
+	}
+	return fmt.Errorf("No key: %s", label)
+}
+
+// AddSeed encrypts a family seed. An ECDSA seed signs for the first
+// account of its family, as SignSingleSignTransaction does.
+func (ks *Keystore) AddSeed(label string, seed data.Seed, keyType data.KeyType, passphrase []byte, kdf KDF) (*Key, error) {
+	return ks.add(label, KindSeed, keyType, seed[:], passphrase, kdf)
+}
+
+// AddPrivateKey encrypts a raw private key, 32 bytes for secp256k1 or 33
+// bytes prefixed with 0xED for Ed25519, as returned by rippleaddr.NewAddress
+func (ks *Keystore) AddPrivateKey(label string, private []byte, passphrase []byte, kdf KDF) (*Key, error) {
+	keyType := data.ECDSA
+	if len(private) == 33 && private[0] == 0xED {
+		keyType = data.Ed25519
+	}
+	return ks.add(label, KindPrivateKey, keyType, private, passphrase, kdf)
+}
+
+// Generate encrypts a new random seed, so that the secret is never seen
+// outside the keystore
+func (ks *Keystore) Generate(label string, keyType data.KeyType, passphrase []byte, kdf KDF) (*Key, error) {
+	var seed data.Seed
+	defer wipe(seed[:])
+	if _, err := rand.Read(seed[:]); err != nil {
+		return nil, err
+	}
+	return ks.AddSeed(label, seed, keyType, passphrase, kdf)
+}
+
+func (ks *Keystore) add(label, kind string, keyType data.KeyType, secret, passphrase []byte, kdf KDF) (*Key, error) {
+	if _, err := ks.Get(label); err == nil {
+		return nil, fmt.Errorf("Duplicate key label: %s", label)
+	}
+	if len(passphrase) == 0 {
+		return nil, fmt.Errorf("Empty passphrase")
+	}
+	u, err := newUnlockedKey(kind, keyType, secret)
+	if err != nil {
+		return nil, err
+	}
+	defer u.Lock()
+
+	salt := make([]byte, 32)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	kdf.Salt = hex.EncodeToString(salt)
+	k := &Key{
+		Label:     label,
+		Address:   u.Address(),
+		PublicKey: fmt.Sprintf("%X", u.Public()),
+		Kind:      kind,
+		KeyType:   keyType,
+		KDF:       kdf,
+		Cipher:    cipherAES256GCM,
+	}
+	aead, err := k.aead(passphrase)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	k.Nonce = hex.EncodeToString(nonce)
+	k.Ciphertext = hex.EncodeToString(aead.Seal(nil, nonce, secret, k.additionalData()))
+	ks.Keys = append(ks.Keys, k)
+	return k, nil
+}
+
+// wipe zeroes a secret once it is no longer needed
+func wipe(b []byte) {
+	for i := range b {
+		b[i] = 0
+	}
+}
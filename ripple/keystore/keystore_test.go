@@ -0,0 +1,183 @@
+package keystore
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/goodwood511/ripple_lib/ripple-sdk/data"
+	"github.com/goodwood511/ripple_lib/ripple/rippleaddr"
+)
+
+// Cheap parameters, so that the tests are quick
+var (
+	testScrypt   = KDF{Name: "scrypt", N: 1 << 10, R: 8, P: 1}
+	testArgon2id = KDF{Name: "argon2id", Time: 1, Memory: 64, Threads: 1}
+)
+
+func testKeystore(t *testing.T) *Keystore {
+	seed, err := data.NewSeedFromAddress("snoPBrXtMeMyMHUVTgbuqAfg1SUTb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ks := New()
+	if _, err := ks.AddSeed("root", *seed, data.ECDSA, []byte("correct horse"), testScrypt); err != nil {
+		t.Fatal(err)
+	}
+	private, _, _, err := rippleaddr.NewAddressOfType(data.Ed25519)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ks.AddPrivateKey("ed25519", private, []byte("battery staple"), testArgon2id); err != nil {
+		t.Fatal(err)
+	}
+	return ks
+}
+
+func TestKeystore(t *testing.T) {
+	ks := testKeystore(t)
+	if _, err := ks.Generate("root", data.ECDSA, []byte("passphrase"), testScrypt); err == nil {
+		t.Error("added a duplicate label")
+	}
+
+	var b bytes.Buffer
+	if err := ks.Save(&b); err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := Load(&b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if labels := loaded.Labels(); len(labels) != 2 || labels[0] != "ed25519" || labels[1] != "root" {
+		t.Errorf("labels are %v", labels)
+	}
+
+	for _, test := range []struct {
+		label, passphrase string
+	}{
+		{"root", "correct horse"},
+		{"ed25519", "battery staple"},
+	} {
+		key, err := loaded.Unlock(test.label, []byte(test.passphrase))
+		if err != nil {
+			t.Fatalf("%s: %v", test.label, err)
+		}
+		k, _ := loaded.Get(test.label)
+		if key.Address() != k.Address {
+			t.Errorf("%s: address is %s, want %s", test.label, key.Address(), k.Address)
+		}
+
+		account, err := data.NewAccountFromAddress(key.Address())
+		if err != nil {
+			t.Fatal(err)
+		}
+		amount, err := data.NewAmount(int64(1000000))
+		if err != nil {
+			t.Fatal(err)
+		}
+		tx := &data.Payment{
+			TxBase: data.TxBase{
+				TransactionType: data.PAYMENT,
+				Account:         *account,
+				Sequence:        1,
+			},
+			Destination: *account,
+			Amount:      *amount,
+		}
+		if err := data.SignWithSigner(tx, key); err != nil {
+			t.Fatal(err)
+		}
+		if ok, err := data.CheckSignature(tx); err != nil || !ok {
+			t.Errorf("%s: signature is not valid: %v", test.label, err)
+		}
+
+		key.Lock()
+		if _, err := key.Sign(make([]byte, 32), nil); err == nil {
+			t.Errorf("%s: signed once locked", test.label)
+		}
+	}
+
+	root, _ := loaded.Get("root")
+	if root.Address != "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh" {
+		t.Errorf("root address is %s", root.Address)
+	}
+}
+
+func TestKeystoreWrongPassphrase(t *testing.T) {
+	ks := testKeystore(t)
+	for _, label := range ks.Labels() {
+		if _, err := ks.Unlock(label, []byte("wrong")); err == nil {
+			t.Errorf("%s: unlocked with the wrong passphrase", label)
+		}
+	}
+}
+
+func TestKeystoreTampered(t *testing.T) {
+	for _, tamper := range []struct {
+		name string
+		f    func(k *Key)
+	}{
+		{"ciphertext", func(k *Key) {
+			c := []byte(k.Ciphertext)
+			if c[0] == '0' {
+				c[0] = '1'
+			} else {
+				c[0] = '0'
+			}
+			k.Ciphertext = string(c)
+		}},
+		{"truncated ciphertext", func(k *Key) { k.Ciphertext = k.Ciphertext[:len(k.Ciphertext)-2] }},
+		{"nonce", func(k *Key) { k.Nonce = k.Ciphertext[:len(k.Nonce)] }},
+		{"address", func(k *Key) { k.Address = "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe" }},
+		{"label", func(k *Key) { k.Label = "other" }},
+		{"kdf", func(k *Key) { k.KDF.N *= 2 }},
+	} {
+		ks := testKeystore(t)
+		k, err := ks.Get("root")
+		if err != nil {
+			t.Fatal(err)
+		}
+		tamper.f(k)
+		if _, err := k.Unlock([]byte("correct horse")); err == nil {
+			t.Errorf("unlocked with the %s altered", tamper.name)
+		}
+	}
+}
+
+func TestKeystoreLoad(t *testing.T) {
+	for _, bad := range []string{
+		`{"version":2,"keys":[]}`,
+		`{"version":1,"keys":[{"label":"a"},{"label":"a"}]}`,
+		`{"version":1`,
+	} {
+		if _, err := Load(bytes.NewBufferString(bad)); err == nil {
+			t.Errorf("%s: expected an error", bad)
+		}
+	}
+}
+
+func TestKDFLimits(t *testing.T) {
+	for _, kdf := range []KDF{Scrypt, Argon2id, testScrypt, testArgon2id} {
+		if err := kdf.check(); err != nil {
+			t.Errorf("%+v: %v", kdf, err)
+		}
+	}
+
+	// A key file asking for more than the limits is refused before any
+	// work is done, which would otherwise take far too long here
+	for _, kdf := range []KDF{
+		{Name: "scrypt", N: 1 << 21, R: 8, P: 1},
+		{Name: "scrypt", N: 1 << 20, R: 16, P: 1},
+		{Name: "scrypt", N: 1 << 10, R: 1 << 20, P: 1},
+		{Name: "scrypt", N: 1 << 10, R: 8, P: 1 << 20},
+		{Name: "scrypt", N: 1 << 10, R: 0, P: 1},
+		{Name: "argon2id", Time: 1 << 20, Memory: 64, Threads: 1},
+		{Name: "argon2id", Time: 1, Memory: 1<<20 + 1, Threads: 1},
+		{Name: "argon2id", Time: 1, Memory: 0, Threads: 1},
+		{Name: "pbkdf2"},
+	} {
+		kdf.Salt = "000102030405060708090a0b0c0d0e0f"
+		if _, err := kdf.derive([]byte("correct horse")); err == nil {
+			t.Errorf("%+v: derived a key", kdf)
+		}
+	}
+}
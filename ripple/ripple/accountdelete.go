@@ -0,0 +1,208 @@
+package ripple
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/goodwood511/ripple_lib/ripple-sdk/data"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	accountDeleteSequenceGap = 255  // ledgers after the account's sequence before it can be deleted, as rippled's tecTOO_SOON
+	accountDeleteMaxOwned    = 1000 // most objects AccountDelete removes along with the account
+)
+
+/*
+AccountDeleteCheck ...
+Whether an account can be deleted. Reasons is empty when it can.
+Fee: the owner reserve increment in drops, which AccountDelete burns
+*/
+type AccountDeleteCheck struct {
+	Sequence    uint32
+	LedgerIndex uint32
+	OwnerCount  uint32
+	Blockers    []string // types of the ledger entries stopping the deletion
+	Balance     string   // in XRP
+	Fee         string
+	Reasons     []string
+}
+
+/*
+Eligible ...
+Whether nothing stops the account from being deleted
+*/
+func (c *AccountDeleteCheck) Eligible() bool {
+	return len(c.Reasons) == 0
+}
+
+/*
+CheckAccountDelete ...
+Check whether an account can be deleted now
+*/
+func (r *Ripple) CheckAccountDelete(addr string) (*AccountDeleteCheck, error) {
+
+	a, err := data.NewAccountFromAddress(addr)
+	if err != nil {
+		logrus.Errorf("Fail to covert address to account, err is %v", err)
+		return nil, err
+	}
+
+	info, err := r.Client.AccountInfo(*a)
+	if err != nil {
+		logrus.Errorf("Fail to get account %v's info, err is  %v", a, err)
+		return nil, err
+	}
+
+	blockers, err := r.Client.AccountDeletionBlockers(*a, "validated")
+	if err != nil {
+		logrus.Errorf("Fail to get account %v's deletion blockers, err is %v", a, err)
+		return nil, err
+	}
+
+	server, err := r.Client.ServerInfo()
+	if err != nil {
+		logrus.Errorf("Fail to get server info, err is %v", err)
+		return nil, err
+	}
+
+	reserveInc := server.Info.ValidatedLedger.ReserveInc
+	if reserveInc <= 0 {
+		return nil, fmt.Errorf("Owner reserve increment %v is illegal", reserveInc)
+	}
+
+	drops := int64(math.Round(reserveInc * 1e6))
+	fee, err := data.NewNativeValue(drops)
+	if err != nil {
+		return nil, err
+	}
+
+	check := &AccountDeleteCheck{
+		Sequence:    *info.AccountData.Sequence,
+		LedgerIndex: info.LedgerSequence,
+		Fee:         strconv.FormatInt(drops, 10),
+	}
+	if info.AccountData.OwnerCount != nil {
+		check.OwnerCount = *info.AccountData.OwnerCount
+	}
+	if info.AccountData.Balance != nil {
+		check.Balance = info.AccountData.Balance.String()
+	}
+	for _, le := range blockers.AccountObjects {
+		check.Blockers = append(check.Blockers, le.GetType())
+	}
+
+	if check.Sequence+accountDeleteSequenceGap > check.LedgerIndex {
+		check.Reasons = append(check.Reasons, fmt.Sprintf("Sequence %v is within %v of ledger %v", check.Sequence, accountDeleteSequenceGap, check.LedgerIndex))
+	}
+	if len(check.Blockers) > 0 {
+		check.Reasons = append(check.Reasons, fmt.Sprintf("Account owns %v", check.Blockers))
+	}
+	if check.OwnerCount > accountDeleteMaxOwned {
+		check.Reasons = append(check.Reasons, fmt.Sprintf("Owner count %v is over %v", check.OwnerCount, accountDeleteMaxOwned))
+	}
+	if info.AccountData.Balance == nil || info.AccountData.Balance.Less(*fee) {
+		check.Reasons = append(check.Reasons, fmt.Sprintf("Balance %v is less than the fee %v", check.Balance, fee))
+	}
+
+	return check, nil
+}
+
+/*
+CreateAccountDelete ...
+Create a signle signed AccountDelete, sending the account's XRP to to
+to: a classic address or an X-address
+fee: in drops, at least the owner reserve increment
+tag: destination tag, nil to use that of an X-address
+*/
+func (r *Ripple) CreateAccountDelete(from, to, fee string, seq uint32, tag *uint32) (*data.AccountDelete, error) {
+
+	var p data.AccountDelete
+
+	dfee, err := strconv.ParseInt(fee, 10, 64)
+	if err != nil {
+		logrus.Errorf("Fail to covert fee string to int64  err is %v", err)
+		return nil, err
+	}
+
+	if dfee <= 0 {
+		return nil, fmt.Errorf("fee %v is illegal", fee)
+	}
+
+	accountFrom, err := data.NewAccountFromAddress(from)
+	if err != nil {
+		logrus.Errorf("Fail to covert address %v to account, err is %v", from, err)
+		return nil, err
+	}
+
+	accountTo, tag, err := r.destination(to, tag)
+	if err != nil {
+		return nil, err
+	}
+
+	if accountFrom.Equals(*accountTo) {
+		return nil, fmt.Errorf("%v can not be deleted to itself", from)
+	}
+
+	p.Sequence = seq
+	p.Destination = *accountTo
+	p.DestinationTag = tag
+
+	base := p.GetBase()
+	base.TransactionType = data.ACCOUNT_DELETE
+	base.Account = *accountFrom
+	b, err := data.NewNativeValue(dfee)
+	if err != nil {
+		logrus.Errorf("Fee %v is illegal, err is %v", fee, err)
+		return nil, err
+	}
+	base.Fee = *b
+	return &p, nil
+}
+
+/*
+PrepareAccountDelete ...
+Check that from can be deleted and that to can receive its XRP, then create
+an AccountDelete paying the owner reserve increment as its fee
+to: a classic address or an X-address
+*/
+func (r *Ripple) PrepareAccountDelete(from, to string, tag *uint32) (*data.AccountDelete, *AccountDeleteCheck, error) {
+
+	check, err := r.CheckAccountDelete(from)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !check.Eligible() {
+		return nil, check, fmt.Errorf("Account %v can not be deleted: %v", from, check.Reasons)
+	}
+
+	accountTo, tag, err := r.destination(to, tag)
+	if err != nil {
+		return nil, check, err
+	}
+
+	dest, err := r.Client.AccountInfo(*accountTo)
+	if err != nil {
+		logrus.Errorf("Fail to get destination %v's info, err is  %v", to, err)
+		return nil, check, err
+	}
+	if tag == nil && dest.AccountData.Flags != nil && *dest.AccountData.Flags&data.LsRequireDestTag != 0 {
+		return nil, check, fmt.Errorf("Destination %v requires a destination tag", to)
+	}
+
+	authorized, err := r.IsDepositAuthorized(from, accountTo.String())
+	if err != nil {
+		return nil, check, err
+	}
+	if !authorized {
+		return nil, check, fmt.Errorf("%v is not authorized to send to %v", from, to)
+	}
+
+	tx, err := r.CreateAccountDelete(from, to, check.Fee, check.Sequence, tag)
+	if err != nil {
+		return nil, check, err
+	}
+	return tx, check, nil
+}
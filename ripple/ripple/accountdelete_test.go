@@ -0,0 +1,211 @@
+package ripple
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/goodwood511/ripple_lib/ripple-sdk/data"
+	"github.com/goodwood511/ripple_lib/ripple-sdk/websockets"
+)
+
+const (
+	testDeleteFrom = "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh"
+	testDeleteTo   = "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe"
+)
+
+// stubAccount is an account as the stub rippled reports it
+type stubAccount struct {
+	Sequence   uint32
+	Balance    string // in drops
+	OwnerCount uint32
+	Flags      data.LedgerEntryFlag
+}
+
+// stubAccountDelete answers the commands CheckAccountDelete and
+// PrepareAccountDelete send, for accounts in the current ledger
+func stubAccountDelete(t *testing.T, ledger uint32, accounts map[string]stubAccount, blockers []map[string]interface{}, reserveInc float64, authorized bool) *Ripple {
+	return stubRipple(t, map[string]stubHandler{
+		"account_info": func(req map[string]interface{}) interface{} {
+			account, ok := accounts[req["account"].(string)]
+			if !ok {
+				return &websockets.CommandError{Name: "actNotFound", Code: 19, Message: "Account not found."}
+			}
+			return map[string]interface{}{
+				"account_data": map[string]interface{}{
+					"Account":         req["account"],
+					"Balance":         account.Balance,
+					"Flags":           account.Flags,
+					"LedgerEntryType": "AccountRoot",
+					"OwnerCount":      account.OwnerCount,
+					"Sequence":        account.Sequence,
+					"index":           "13F1A95D7AAB7108D5CE7EEAF504B2894B8C674E6D68499076441C4837282BF8",
+				},
+				"ledger_current_index": ledger,
+			}
+		},
+		"account_objects": func(req map[string]interface{}) interface{} {
+			if req["deletion_blockers_only"] != true {
+				t.Errorf("Expected only deletion blockers to be requested: %v", req)
+			}
+			objects := []interface{}{}
+			for _, blocker := range blockers {
+				objects = append(objects, blocker)
+			}
+			return map[string]interface{}{
+				"account":         req["account"],
+				"account_objects": objects,
+				"ledger_index":    ledger - 1,
+				"validated":       true,
+			}
+		},
+		"server_info": func(req map[string]interface{}) interface{} {
+			return map[string]interface{}{
+				"info": map[string]interface{}{
+					"server_state": "full",
+					"validated_ledger": map[string]interface{}{
+						"age":              1,
+						"base_fee_xrp":     0.00001,
+						"hash":             "4C99E5F63C0D0B1C2B7B2D7D6A8F1E3B2A9C8D7E6F5A4B3C2D1E0F9A8B7C6D5E",
+						"reserve_base_xrp": 1,
+						"reserve_inc_xrp":  reserveInc,
+						"seq":              ledger - 1,
+					},
+				},
+			}
+		},
+		"deposit_authorized": func(req map[string]interface{}) interface{} {
+			return map[string]interface{}{
+				"deposit_authorized":  authorized,
+				"destination_account": req["destination_account"],
+				"source_account":      req["source_account"],
+				"ledger_index":        ledger - 1,
+				"validated":           true,
+			}
+		},
+	})
+}
+
+var testEscrow = map[string]interface{}{
+	"Account":           testDeleteFrom,
+	"Amount":            "10000",
+	"Destination":       testDeleteTo,
+	"Flags":             0,
+	"LedgerEntryType":   "Escrow",
+	"OwnerNode":         "0",
+	"PreviousTxnID":     "0AC7B3C3A8D22A0FA8E5A1B6E8F7A0E3B6C1D2E3F4A5B6C7D8E9F0A1B2C3D4E5",
+	"PreviousTxnLgrSeq": 1000,
+	"index":             "DC5F3851D8A1AB622F957761E5963BC5BD439D5C24AC6AD7AC4523F0640244AC",
+}
+
+func TestCheckAccountDelete(t *testing.T) {
+	for _, test := range []struct {
+		name       string
+		ledger     uint32
+		account    stubAccount
+		blockers   []map[string]interface{}
+		reserveInc float64
+		fee        string
+		reasons    []string
+	}{
+		// rippled refuses with tecTOO_SOON while Sequence + 255 > ledger
+		{"eligible", 1255, stubAccount{Sequence: 1000, Balance: "50000000"}, nil, 0.2, "200000", nil},
+		{"too soon", 1254, stubAccount{Sequence: 1000, Balance: "50000000"}, nil, 0.2, "200000", []string{"Sequence 1000 is within 255 of ledger 1254"}},
+		{"reserve in whole XRP", 90000000, stubAccount{Sequence: 1000, Balance: "50000000"}, nil, 2, "2000000", nil},
+		{"blocked", 90000000, stubAccount{Sequence: 1000, Balance: "50000000", OwnerCount: 1}, []map[string]interface{}{testEscrow}, 0.2, "200000", []string{"Account owns [Escrow]"}},
+		{"owns too much", 90000000, stubAccount{Sequence: 1000, Balance: "50000000", OwnerCount: 1001}, nil, 0.2, "200000", []string{"Owner count 1001 is over 1000"}},
+		{"balance under the fee", 90000000, stubAccount{Sequence: 1000, Balance: "199999"}, nil, 0.2, "200000", []string{"Balance 0.199999 is less than the fee 0.2"}},
+		{"everything", 1000, stubAccount{Sequence: 1000, Balance: "0", OwnerCount: 1001}, []map[string]interface{}{testEscrow}, 0.2, "200000", []string{"Sequence", "Escrow", "Owner count", "Balance"}},
+	} {
+		r := stubAccountDelete(t, test.ledger, map[string]stubAccount{testDeleteFrom: test.account}, test.blockers, test.reserveInc, true)
+		check, err := r.CheckAccountDelete(testDeleteFrom)
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		if check.Fee != test.fee {
+			t.Errorf("%s: fee %s, want %s", test.name, check.Fee, test.fee)
+		}
+		if check.Eligible() != (len(test.reasons) == 0) {
+			t.Errorf("%s: eligible %t with reasons %v", test.name, check.Eligible(), check.Reasons)
+		}
+		if len(check.Reasons) != len(test.reasons) {
+			t.Errorf("%s: reasons %v, want %v", test.name, check.Reasons, test.reasons)
+			continue
+		}
+		for i := range test.reasons {
+			if !strings.Contains(check.Reasons[i], test.reasons[i]) {
+				t.Errorf("%s: reason %q, want %q", test.name, check.Reasons[i], test.reasons[i])
+			}
+		}
+	}
+
+	r := stubAccountDelete(t, 90000000, map[string]stubAccount{testDeleteFrom: {Sequence: 1000, Balance: "50000000"}}, nil, 0, true)
+	if _, err := r.CheckAccountDelete(testDeleteFrom); err == nil {
+		t.Error("Accepted a reserve increment of 0")
+	}
+}
+
+func TestPrepareAccountDelete(t *testing.T) {
+	account, err := data.NewAccountFromAddress(testDeleteTo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tag, other := uint32(12345), uint32(1)
+	xaddr := account.XAddress(&tag, false)
+
+	from := stubAccount{Sequence: 1000, Balance: "50000000"}
+	open := stubAccount{Sequence: 1, Balance: "20000000"}
+	tagged := stubAccount{Sequence: 1, Balance: "20000000", Flags: data.LsRequireDestTag}
+
+	for _, test := range []struct {
+		name       string
+		to         string
+		tag        *uint32
+		dest       stubAccount
+		authorized bool
+		wantTag    *uint32
+		err        string
+	}{
+		{"classic address", testDeleteTo, nil, open, true, nil, ""},
+		{"classic address with a tag", testDeleteTo, &other, open, true, &other, ""},
+		{"X-address", xaddr, nil, open, true, &tag, ""},
+		{"X-address with its own tag", xaddr, &tag, open, true, &tag, ""},
+		{"X-address with another tag", xaddr, &other, open, true, nil, "tag"},
+		{"test network X-address", account.XAddress(&tag, true), nil, open, true, nil, "network"},
+		{"tag required", testDeleteTo, nil, tagged, true, nil, "requires a destination tag"},
+		{"tag required, given by X-address", xaddr, nil, tagged, true, &tag, ""},
+		{"not authorized", testDeleteTo, nil, open, false, nil, "not authorized"},
+	} {
+		r := stubAccountDelete(t, 90000000, map[string]stubAccount{testDeleteFrom: from, testDeleteTo: test.dest}, nil, 0.2, test.authorized)
+		tx, check, err := r.PrepareAccountDelete(testDeleteFrom, test.to, test.tag)
+		if test.err != "" {
+			if err == nil || !strings.Contains(err.Error(), test.err) {
+				t.Errorf("%s: expected an error containing %q, got %v", test.name, test.err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		if !tx.Destination.Equals(*account) {
+			t.Errorf("%s: destination %s", test.name, tx.Destination)
+		}
+		if (tx.DestinationTag == nil) != (test.wantTag == nil) || (test.wantTag != nil && *tx.DestinationTag != *test.wantTag) {
+			t.Errorf("%s: destination tag %v, want %v", test.name, tx.DestinationTag, test.wantTag)
+		}
+		if tx.Sequence != check.Sequence || tx.Sequence != 1000 {
+			t.Errorf("%s: sequence %d", test.name, tx.Sequence)
+		}
+		if tx.Fee.String() != "0.2" {
+			t.Errorf("%s: fee %s, want the owner reserve increment", test.name, tx.Fee)
+		}
+	}
+
+	// An account which can not be deleted is reported along with why
+	r := stubAccountDelete(t, 1254, map[string]stubAccount{testDeleteFrom: from, testDeleteTo: open}, nil, 0.2, true)
+	_, check, err := r.PrepareAccountDelete(testDeleteFrom, testDeleteTo, nil)
+	if err == nil || check == nil || check.Eligible() {
+		t.Errorf("Ineligible account accepted: %v, %+v", err, check)
+	}
+}
@@ -0,0 +1,59 @@
+package ripple
+
+import (
+	"github.com/goodwood511/ripple_lib/ripple-sdk/data"
+	"github.com/goodwood511/ripple_lib/ripple/keystore"
+
+	"github.com/sirupsen/logrus"
+)
+
+/*
+SignSingleSignTransactionWithKeystore ...
+Sign a signle signed transaction with the key labelled label in ks, which is
+unlocked with passphrase only for the signing
+*/
+func (r *Ripple) SignSingleSignTransactionWithKeystore(s data.Signer, ks *keystore.Keystore, label string, passphrase []byte) error {
+
+	key, err := ks.Unlock(label, passphrase)
+	if err != nil {
+		logrus.Errorf("Fail to unlock key %v, err is %v", label, err)
+		return err
+	}
+	defer key.Lock()
+
+	return r.SignSingleSignTransactionWithSigner(s, key)
+}
+
+/*
+SignMultiSignTransactionInSerialWithKeystore ...
+Sign a Multi signed transaction in serial with the key labelled label in ks,
+which is unlocked with passphrase only for the signing
+*/
+func (r *Ripple) SignMultiSignTransactionInSerialWithKeystore(s data.Signer, ks *keystore.Keystore, label string, passphrase []byte) error {
+
+	key, err := ks.Unlock(label, passphrase)
+	if err != nil {
+		logrus.Errorf("Fail to unlock key %v, err is %v", label, err)
+		return err
+	}
+	defer key.Lock()
+
+	return r.SignMultiSignTransactionInSerialWithSigner(s, key)
+}
+
+/*
+SignMultiSignTransactionInParallelWithKeystore ...
+Sign a Multi signed transaction in parallel with the key labelled label in
+ks, which is unlocked with passphrase only for the signing
+*/
+func (r *Ripple) SignMultiSignTransactionInParallelWithKeystore(s data.Signer, ks *keystore.Keystore, label string, passphrase []byte) (data.MultiSignerEntryEx, error) {
+
+	key, err := ks.Unlock(label, passphrase)
+	if err != nil {
+		logrus.Errorf("Fail to unlock key %v, err is %v", label, err)
+		return data.MultiSignerEntryEx{}, err
+	}
+	defer key.Lock()
+
+	return r.SignMultiSignTransactionInParallelWithSigner(s, key)
+}
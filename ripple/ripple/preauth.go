@@ -0,0 +1,120 @@
+package ripple
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/goodwood511/ripple_lib/ripple-sdk/data"
+
+	"github.com/sirupsen/logrus"
+)
+
+/*
+CreateDepositPreauth ...
+Create a signle signed DepositPreauth, which lets counterparty send to from
+while from has DepositAuth set. With authorize false it takes that back.
+fee: in drops
+*/
+func (r *Ripple) CreateDepositPreauth(from, counterparty, fee string, authorize bool, seq uint32) (*data.DepositPreauth, error) {
+
+	var p data.DepositPreauth
+
+	dfee, err := strconv.ParseInt(fee, 10, 64)
+	if err != nil {
+		logrus.Errorf("Fail to covert fee string to int64  err is %v", err)
+		return nil, err
+	}
+
+	if dfee <= 0 {
+		return nil, fmt.Errorf("fee %v is illegal", fee)
+	}
+
+	accountFrom, err := data.NewAccountFromAddress(from)
+	if err != nil {
+		logrus.Errorf("Fail to covert address %v to account, err is %v", from, err)
+		return nil, err
+	}
+
+	accountCounterparty, err := data.NewAccountFromAddress(counterparty)
+	if err != nil {
+		logrus.Errorf("Fail to covert address %v to account, err is %v", counterparty, err)
+		return nil, err
+	}
+
+	if accountFrom.Equals(*accountCounterparty) {
+		return nil, fmt.Errorf("%v can not preauthorize itself", from)
+	}
+
+	p.Sequence = seq
+	if authorize {
+		p.Authorize = accountCounterparty
+	} else {
+		p.Unauthorize = accountCounterparty
+	}
+
+	base := p.GetBase()
+	base.TransactionType = data.PREAUTHORIZE
+	base.Account = *accountFrom
+	b, err := data.NewNativeValue(dfee)
+	if err != nil {
+		logrus.Errorf("Fee %v is illegal, err is %v", fee, err)
+		return nil, err
+	}
+	base.Fee = *b
+	return &p, nil
+}
+
+/*
+GetDepositPreauths ...
+Get the addresses an account has preauthorized to send to it, in the
+validated ledger
+*/
+func (r *Ripple) GetDepositPreauths(addr string) ([]string, error) {
+
+	a, err := data.NewAccountFromAddress(addr)
+	if err != nil {
+		logrus.Errorf("Fail to covert address to account, err is %v", err)
+		return nil, err
+	}
+
+	result, err := r.Client.AccountObjects(*a, "deposit_preauth", "validated")
+	if err != nil {
+		logrus.Errorf("Fail to get account %v's preauths, err is %v", addr, err)
+		return nil, err
+	}
+
+	var addrs []string
+	for _, le := range result.AccountObjects {
+		if preauth, ok := le.(*data.DepositPreauthEntry); ok && preauth.Authorize != nil {
+			addrs = append(addrs, preauth.Authorize.String())
+		}
+	}
+	return addrs, nil
+}
+
+/*
+IsDepositAuthorized ...
+Check in the validated ledger whether from may send to to, so a payment
+does not fail with tecNO_PERMISSION after its fee is charged
+*/
+func (r *Ripple) IsDepositAuthorized(from, to string) (bool, error) {
+
+	accountFrom, err := data.NewAccountFromAddress(from)
+	if err != nil {
+		logrus.Errorf("Fail to covert address %v to account, err is %v", from, err)
+		return false, err
+	}
+
+	accountTo, err := data.NewAccountFromAddress(to)
+	if err != nil {
+		logrus.Errorf("Fail to covert address %v to account, err is %v", to, err)
+		return false, err
+	}
+
+	result, err := r.Client.DepositAuthorized(*accountFrom, *accountTo, "validated")
+	if err != nil {
+		logrus.Errorf("Fail to check deposit authorization from %v to %v, err is %v", from, to, err)
+		return false, err
+	}
+	return result.DepositAuthorized, nil
+}
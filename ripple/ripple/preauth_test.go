@@ -0,0 +1,112 @@
+package ripple
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+const (
+	testPreauthFrom         = "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh"
+	testPreauthCounterparty = "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe"
+)
+
+func TestCreateDepositPreauth(t *testing.T) {
+	r, err := NewOfflineRipple()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, authorize := range []bool{true, false} {
+		p, err := r.CreateDepositPreauth(testPreauthFrom, testPreauthCounterparty, "12", authorize, 7)
+		if err != nil {
+			t.Fatal(err)
+		}
+		set, unset := p.Authorize, p.Unauthorize
+		if !authorize {
+			set, unset = unset, set
+		}
+		if set == nil || set.String() != testPreauthCounterparty || unset != nil {
+			t.Errorf("Authorize %t: Authorize %v, Unauthorize %v", authorize, p.Authorize, p.Unauthorize)
+		}
+		if p.GetTransactionType().String() != "DepositPreauth" || p.Sequence != 7 || p.Account.String() != testPreauthFrom {
+			t.Errorf("Authorize %t: wrong transaction %+v", authorize, p)
+		}
+	}
+
+	for _, c := range []struct {
+		from, counterparty, fee string
+	}{
+		{testPreauthFrom, testPreauthFrom, "12"},
+		{testPreauthFrom, testPreauthCounterparty, "0"},
+		{testPreauthFrom, testPreauthCounterparty, "twelve"},
+		{testPreauthFrom, "rnotanaddress", "12"},
+	} {
+		if _, err := r.CreateDepositPreauth(c.from, c.counterparty, c.fee, true, 7); err == nil {
+			t.Errorf("Accepted %+v", c)
+		}
+	}
+}
+
+func TestGetDepositPreauths(t *testing.T) {
+	const other = "rLUEXYuLiQptky37CqLcm9USQpPiz5rkpD"
+	r := stubRipple(t, map[string]stubHandler{
+		"account_objects": func(req map[string]interface{}) interface{} {
+			if req["type"] != "deposit_preauth" || req["ledger_index"] != "validated" {
+				t.Errorf("Wrong request: %v", req)
+			}
+			objects := []interface{}{}
+			for i, authorized := range []string{testPreauthCounterparty, other} {
+				objects = append(objects, map[string]interface{}{
+					"Account":           req["account"],
+					"Authorize":         authorized,
+					"Flags":             0,
+					"LedgerEntryType":   "DepositPreauth",
+					"OwnerNode":         "0",
+					"PreviousTxnID":     "0AC7B3C3A8D22A0FA8E5A1B6E8F7A0E3B6C1D2E3F4A5B6C7D8E9F0A1B2C3D4E5",
+					"PreviousTxnLgrSeq": 90000000,
+					"index":             fmt.Sprintf("%064X", i+1),
+				})
+			}
+			return map[string]interface{}{
+				"account":         req["account"],
+				"account_objects": objects,
+				"ledger_index":    90000010,
+				"validated":       true,
+			}
+		},
+	})
+	addrs, err := r.GetDepositPreauths(testPreauthFrom)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(addrs, []string{testPreauthCounterparty, other}) {
+		t.Errorf("Wrong preauths: %v", addrs)
+	}
+}
+
+func TestIsDepositAuthorized(t *testing.T) {
+	for _, authorized := range []bool{true, false} {
+		authorized := authorized
+		r := stubRipple(t, map[string]stubHandler{
+			"deposit_authorized": func(req map[string]interface{}) interface{} {
+				if req["source_account"] != testPreauthCounterparty || req["destination_account"] != testPreauthFrom || req["ledger_index"] != "validated" {
+					t.Errorf("Wrong request: %v", req)
+				}
+				return map[string]interface{}{
+					"deposit_authorized":  authorized,
+					"destination_account": req["destination_account"],
+					"source_account":      req["source_account"],
+					"ledger_index":        90000010,
+					"validated":           true,
+				}
+			},
+		})
+		got, err := r.IsDepositAuthorized(testPreauthCounterparty, testPreauthFrom)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != authorized {
+			t.Errorf("Authorized %t, want %t", got, authorized)
+		}
+	}
+}
@@ -26,6 +26,9 @@ const (
 
 type Ripple struct {
 	Client *websockets.Remote
+	// Network is the network of the X-addresses which are accepted, those
+	// of test networks start with T and are only accepted for TestNetwork
+	Network Network
 }
 
 type SignerInfo struct {
@@ -46,7 +49,8 @@ func NewRipple(host string) (*Ripple, error) {
 	}
 
 	return &Ripple{
-		Client: client,
+		Client:  client,
+		Network: MainNetwork,
 	}, nil
 }
 
@@ -57,7 +61,8 @@ It's expected to be used in wallet server, RC server, Sign server,
 */
 func NewOfflineRipple() (*Ripple, error) {
 	return &Ripple{
-		Client: nil,
+		Client:  nil,
+		Network: MainNetwork,
 	}, nil
 }
 
@@ -88,8 +93,8 @@ func (r *Ripple) GetBalance(addr string) (string, error) {
 	}
 
 	if !result.AccountData.Balance.IsNative() {
-		logrus.Errorf("Account %v's asset is not XRP", a, err)
-		return "", err
+		logrus.Errorf("Account %v's asset is not XRP", a)
+		return "", fmt.Errorf("Account %v's asset is not XRP", a)
 	}
 
 	v, err := result.AccountData.Balance.Native()
@@ -168,6 +173,20 @@ func (r *Ripple) GetTx(hash data.Hash256) (*websockets.TxResult, error) {
 	return r.Client.Tx(hash)
 }
 
+/*
+LoadServerDefinitions ...
+Add the server's transaction, ledger entry and field types to the codec, so
+that types this package has no Go type for can be read and written back
+*/
+func (r *Ripple) LoadServerDefinitions() error {
+	d, err := r.Client.ServerDefinitions()
+	if err != nil {
+		logrus.Errorf("Fail to get server definitions, err is %v", err)
+		return err
+	}
+	return data.SetDefinitions(d)
+}
+
 /*
 GetBlockByNumber ...
 Get blockdata by number
@@ -250,12 +269,45 @@ func getHashFromSubmitResult(result *websockets.SubmitResult) (*data.Hash256, er
 	return &txid, nil
 }
 
+/*
+destination ...
+The account and destination tag to send to. to may be an X-address of
+r's network, whose tag is used unless tag is given, in which case they must
+be the same
+*/
+func (r *Ripple) destination(to string, tag *uint32) (*data.Account, *uint32, error) {
+	if !crypto.IsXAddress(to) {
+		account, err := data.NewAccountFromAddress(to)
+		if err != nil {
+			logrus.Errorf("Fail to covert address %v to account, err is %v", to, err)
+			return nil, nil, err
+		}
+		return account, tag, nil
+	}
+	account, xtag, test, err := data.NewAccountFromXAddress(to)
+	if err != nil {
+		logrus.Errorf("Fail to covert address %v to account, err is %v", to, err)
+		return nil, nil, err
+	}
+	if test != (r.Network == TestNetwork) {
+		return nil, nil, fmt.Errorf("X-address %v is not for this network", to)
+	}
+	if xtag == nil {
+		return account, tag, nil
+	}
+	if tag != nil && *tag != *xtag {
+		return nil, nil, fmt.Errorf("Destination tag %v conflicts with tag %v of %v", *tag, *xtag, to)
+	}
+	return account, xtag, nil
+}
+
 /*
 CreateSingleSignPayment ...
 Create a signle signed payment
+to: a classic address or an X-address
 amount: in drops
 fee: in drops.
-tag: destination tag
+tag: destination tag, nil to use that of an X-address
 */
 func (r *Ripple) CreateSingleSignPayment(from, to, amount, fee, memo string, seq uint32, tag *uint32) (*data.Payment, error) {
 
@@ -283,9 +335,8 @@ func (r *Ripple) CreateSingleSignPayment(from, to, amount, fee, memo string, seq
 		return nil, err
 	}
 
-	accountTo, err := data.NewAccountFromAddress(to)
+	accountTo, tag, err := r.destination(to, tag)
 	if err != nil {
-		logrus.Errorf("Fail to covert address %v to account, err is %v", to, err)
 		return nil, err
 	}
 
@@ -354,23 +405,44 @@ func (r *Ripple) CreateDisableMasterKey(from, fee string, seq uint32) (*data.Acc
 }
 
 /*
-SignSingleSignTransaction ...
-Sign a signle signed transaction with secrete
+seedKey ...
+The key of a secrete and the sequence to sign with it, the first account of
+a family seed or nil for an "sEd..." seed, whose key is Ed25519
 */
-func (r *Ripple) SignSingleSignTransaction(s data.Signer, screte string) error {
+func seedKey(screte string) (crypto.Key, *uint32, error) {
+	b, ed25519, err := crypto.DecodeSeed(screte)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	var seed data.Seed
+	copy(seed[:], b)
+	if ed25519 {
+		return seed.Key(data.Ed25519), nil, nil
+	}
 	var sequence uint32
+	return seed.Key(data.ECDSA), &sequence, nil
+}
 
-	seed.UnmarshalText([]byte(screte))
-	key := seed.Key(data.ECDSA)
-	return data.Sign(s, key, &sequence)
+/*
+SignSingleSignTransaction ...
+Sign a signle signed transaction with secrete, a family seed or an "sEd..."
+seed
+*/
+func (r *Ripple) SignSingleSignTransaction(s data.Signer, screte string) error {
+
+	key, sequence, err := seedKey(screte)
+	if err != nil {
+		logrus.Errorf("Fail to decode secrete, err is %v", err)
+		return err
+	}
+	return data.Sign(s, key, sequence)
 }
 
 /*
 SignSingleSignTransactionWithPrivKey ...
 Sign a signle transaction with privekey string
-privkey: a huam readable privatekey "pxxxx"
+privkey: a huam readable privatekey "pxxxx", secp256k1 or Ed25519
 */
 func (r *Ripple) SignSingleSignTransactionWithPrivKey(s data.Signer, privkey string) error {
 	if !rippleaddr.CheckRipplePrivKey(privkey) {
@@ -385,6 +457,15 @@ func (r *Ripple) SignSingleSignTransactionWithPrivKey(s data.Signer, privkey str
 	return data.SignWithPrivKey(s, b[1:len(b)-4])
 }
 
+/*
+SignSingleSignTransactionWithSigner ...
+Sign a signle transaction with a signer whose key may be held outside the
+process, e.g. in an HSM or by a ripplegateway.Gateway
+*/
+func (r *Ripple) SignSingleSignTransactionWithSigner(s data.Signer, signer crypto.KeySigner) error {
+	return data.SignWithSigner(s, signer)
+}
+
 /*
 BroadcastSignleSignTransaction ...
 push a single signed transaction to blockchain
@@ -409,10 +490,12 @@ func (r *Ripple) BroadcastSignleSignTransaction(tx data.Transaction) (*data.Hash
 /*
 CreateMultiSignPayment ...
 Create a Multi signed transaction
+to: a classic address or an X-address
 amount: in drops
 fee: in drops
+tag: destination tag, nil to use that of an X-address
 */
-func (r *Ripple) CreateMultiSignPayment(from, to, amount, fee, memo string, tag, seq uint32) (*data.MultiSignPayment, error) {
+func (r *Ripple) CreateMultiSignPayment(from, to, amount, fee, memo string, tag *uint32, seq uint32) (*data.MultiSignPayment, error) {
 
 	var p data.MultiSignPayment
 	damount, err := strconv.ParseInt(amount, 10, 64)
@@ -437,15 +520,14 @@ func (r *Ripple) CreateMultiSignPayment(from, to, amount, fee, memo string, tag,
 		return nil, err
 	}
 
-	account_to, err := data.NewAccountFromAddress(to)
+	account_to, tag, err := r.destination(to, tag)
 	if err != nil {
-		logrus.Errorf("Fail to covert address %v to account, err is %v", to, err)
 		return nil, err
 	}
 
 	p.Sequence = seq
 	p.Destination = (*account_to)
-	p.DestinationTag = &tag
+	p.DestinationTag = tag
 	a, err := data.NewAmount(int64(damount))
 	if err != nil {
 		logrus.Errorf("Amount %v is illegal, err is %v", amount, err)
@@ -478,74 +560,146 @@ func (r *Ripple) CreateMultiSignPayment(from, to, amount, fee, memo string, tag,
 
 /*
 SignMultiSignTransactionInSerial ...
-Sign a Multi signed transaction in serial with secrete
+Sign a Multi signed transaction in serial with secrete, adding the
+signature to the transaction's Signers. Each signer signs in turn
+and the transaction is broadcast once there are enough.
+signer: the address of the signer, whose master key secrete must be, a
+family seed or an "sEd..." seed
 */
-func (r *Ripple) SignMultiSignTransactionInSerial(s data.Signer, screte string) error {
-	return nil
+func (r *Ripple) SignMultiSignTransactionInSerial(s data.Signer, screte, signer string) error {
+
+	key, sequence, err := seedKey(screte)
+	if err != nil {
+		logrus.Errorf("Fail to decode secrete, err is %v", err)
+		return err
+	}
+	account, err := data.NewAccountFromAddress(signer)
+	if err != nil {
+		logrus.Errorf("Fail to covert signer address %v, err is %v", signer, err)
+		return err
+	}
+	return data.MultiSignInSerial(s, key, sequence, *account)
 }
 
 /*
 SignMultiSignTransactionInParallel ...
 Sign a Multi signed transaction in parallel with secrete
+signer: the address of the signer, whose master key secrete must be, a
+family seed or an "sEd..." seed
 */
-func (r *Ripple) SignMultiSignTransactionInParallel(s data.Signer, screte string) (data.MultiSignerEntryEx, error) {
+func (r *Ripple) SignMultiSignTransactionInParallel(s data.Signer, screte, signer string) (data.MultiSignerEntryEx, error) {
 
-	var seed data.Seed
-	var sequence uint32
-
-	seed.UnmarshalText([]byte(screte))
-	key := seed.Key(data.ECDSA)
-
-	return data.MultiSignInParallel(s, key, &sequence)
+	key, sequence, err := seedKey(screte)
+	if err != nil {
+		logrus.Errorf("Fail to decode secrete, err is %v", err)
+		return data.MultiSignerEntryEx{}, err
+	}
+	account, err := data.NewAccountFromAddress(signer)
+	if err != nil {
+		logrus.Errorf("Fail to covert signer address %v, err is %v", signer, err)
+		return data.MultiSignerEntryEx{}, err
+	}
+	return data.MultiSignInParallel(s, key, sequence, *account)
 }
 
 /*
 SignMultiSignTransactionInParallelWithPrivKey ...
 Sign a Multi signed transaction in parallel with private key
-private key:  a huam readable privatekey "pxxxx"
+private key:  a huam readable privatekey "pxxxx", secp256k1 or Ed25519
+signer: the address of the signer, whose master key privkey must be
 */
-func (r *Ripple) SignMultiSignTransactionInParallelWithPrivKey(s data.Signer, privkey string) (data.MultiSignerEntryEx, error) {
+func (r *Ripple) SignMultiSignTransactionInParallelWithPrivKey(s data.Signer, privkey, signer string) (data.MultiSignerEntryEx, error) {
 
-	var signer data.MultiSignerEntryEx
+	var entry data.MultiSignerEntryEx
 	if !rippleaddr.CheckRipplePrivKey(privkey) {
-		return signer, fmt.Errorf("invalide privkey string %v", privkey)
+		return entry, fmt.Errorf("invalide privkey string %v", privkey)
 	}
 
 	b, err := crypto.Base58Decode(privkey, crypto.ALPHABET)
 	if err != nil {
-		return signer, err
+		return entry, err
+	}
+	account, err := data.NewAccountFromAddress(signer)
+	if err != nil {
+		logrus.Errorf("Fail to covert signer address %v, err is %v", signer, err)
+		return entry, err
 	}
-	return data.MultiSignInParallelWithPrivKey(s, b[1:len(b)-4])
+	return data.MultiSignInParallelWithPrivKey(s, b[1:len(b)-4], *account)
 }
 
-func sortSignerEntryEx(s []data.MultiSignerEntryEx) {
-
-	for i := 0; i < len(s)-1; i++ {
-		for j := i + 1; j < len(s); j++ {
-			if s[i].Signer.Account.Compare(s[j].Signer.Account) > 0 {
-				s[i], s[j] = s[j], s[i]
-			}
-		}
+/*
+SignMultiSignTransactionInSerialWithSigner ...
+Sign a Multi signed transaction in serial with a signer whose key may be
+held outside the process. The signer's key must be its account's master key.
+*/
+func (r *Ripple) SignMultiSignTransactionInSerialWithSigner(s data.Signer, signer crypto.KeySigner) error {
+	return data.MultiSignInSerialWithSigner(s, signer)
+}
 
-	}
+/*
+SignMultiSignTransactionInParallelWithSigner ...
+Sign a Multi signed transaction in parallel with a signer whose key may be
+held outside the process. The signer's key must be its account's master key.
+*/
+func (r *Ripple) SignMultiSignTransactionInParallelWithSigner(s data.Signer, signer crypto.KeySigner) (data.MultiSignerEntryEx, error) {
+	return data.MultiSignInParallelWithSigner(s, signer)
 }
 
 /*
 MergeMultiSignSignatures ...
 Merge signatures togther for a MultiSign transaction
+tx: any transaction, or a MultiSignTransaction
 signers: signers
 */
-func (r *Ripple) MergeMultiSignSignatures(tx data.MultiSignTransaction, signers []data.MultiSignerEntryEx) error {
+func (r *Ripple) MergeMultiSignSignatures(tx data.Signer, signers []data.MultiSignerEntryEx) error {
 
 	// if len(signers) != NUM_OF_MULTISIGNER {
 	// 	return fmt.Errorf("signer num does not equalt to %v", NUM_OF_MULTISIGNER)
 	// }
 
-	sortSignerEntryEx(signers)
-	for _, s := range signers {
-		tx.GetBase().Signers = append(tx.GetBase().Signers, s)
+	return data.AddSigners(tx, signers...)
+}
+
+/*
+CheckMultiSignQuorum ...
+Verify every signature of a multisigned transaction against the SignerList
+of its account, return an error if any signature is forged or the quorum is
+not met, so that incomplete transactions are never broadcast
+regularKeys: the RegularKey of each signer which signs with one, may be nil
+*/
+func (r *Ripple) CheckMultiSignQuorum(tx data.Signer, list *data.SignerList, regularKeys map[data.Account]data.RegularKey) (*data.MultiSignCheck, error) {
+
+	return checkQuorum(data.CheckMultiSignatureWithRegularKeys(tx, list, regularKeys))
+}
+
+/*
+CheckMultiSignQuorumWithAccounts ...
+CheckMultiSignQuorum given the AccountRoot of each signer, so that signers
+which have disabled their master key may not sign with it
+accounts: the AccountRoot of each signer, as account_info returns it
+*/
+func (r *Ripple) CheckMultiSignQuorumWithAccounts(tx data.Signer, list *data.SignerList, accounts map[data.Account]*data.AccountRoot) (*data.MultiSignCheck, error) {
+
+	return checkQuorum(data.CheckMultiSignatureWithAccounts(tx, list, accounts))
+}
+
+func checkQuorum(check *data.MultiSignCheck, err error) (*data.MultiSignCheck, error) {
+	if err != nil {
+		logrus.Errorf("Fail to check multisigned transaction, err is %v", err)
+		return nil, err
+	}
+
+	for _, signer := range check.Signers {
+		if signer.Err != nil {
+			logrus.Errorf("Signer %v is invalid, err is %v", signer.Account, signer.Err)
+			return check, signer.Err
+		}
+	}
+
+	if !check.QuorumMet() {
+		return check, fmt.Errorf("Signer weight %v does not meet quorum %v", check.Weight, check.Quorum)
 	}
-	return nil
+	return check, nil
 }
 
 /*
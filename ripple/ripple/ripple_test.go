@@ -0,0 +1,175 @@
+package ripple
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/goodwood511/ripple_lib/ripple-sdk/data"
+	"github.com/goodwood511/ripple_lib/ripple-sdk/websockets"
+
+	"github.com/gorilla/websocket"
+)
+
+// stubHandler returns the result of a command given its fields, or a
+// *websockets.CommandError to fail it
+type stubHandler func(req map[string]interface{}) interface{}
+
+// stubRipple returns a Ripple connected to a websocket server standing in
+// for rippled, which answers each command with its handler
+func stubRipple(t *testing.T, handlers map[string]stubHandler) *Ripple {
+	var upgrader websocket.Upgrader
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		ws, err := upgrader.Upgrade(w, req, nil)
+		if err != nil {
+			return
+		}
+		defer ws.Close()
+		for {
+			var cmd map[string]interface{}
+			if err := ws.ReadJSON(&cmd); err != nil {
+				return
+			}
+			response := map[string]interface{}{
+				"id":     cmd["id"],
+				"type":   "response",
+				"status": "success",
+			}
+			handler, ok := handlers[cmd["command"].(string)]
+			var result interface{} = &websockets.CommandError{Name: "unknownCmd", Code: 32, Message: "Unknown method."}
+			if ok {
+				result = handler(cmd)
+			} else {
+				t.Errorf("Unexpected command: %v", cmd)
+			}
+			if cmdErr, ok := result.(*websockets.CommandError); ok {
+				response["status"] = "error"
+				response["error"] = cmdErr.Name
+				response["error_code"] = cmdErr.Code
+				response["error_message"] = cmdErr.Message
+			} else {
+				response["result"] = result
+			}
+			if err := ws.WriteJSON(response); err != nil {
+				return
+			}
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	r, err := NewRipple("ws" + strings.TrimPrefix(server.URL, "http"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(r.Close)
+	return r
+}
+
+func TestCreateMultiSignPaymentDestination(t *testing.T) {
+	const (
+		from = "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh"
+		to   = "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe"
+	)
+	account, err := data.NewAccountFromAddress(to)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tag, other := uint32(12345), uint32(1)
+	main, test := account.XAddress(&tag, false), account.XAddress(&tag, true)
+
+	r, err := NewOfflineRipple()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range []struct {
+		to   string
+		tag  *uint32
+		want *uint32
+	}{
+		{to, nil, nil},
+		{to, &other, &other},
+		{main, nil, &tag},
+		{main, &tag, &tag},
+		{account.XAddress(nil, false), &other, &other},
+	} {
+		p, err := r.CreateMultiSignPayment(from, c.to, "1000", "12", "", c.tag, 1)
+		if err != nil {
+			t.Errorf("%s: %v", c.to, err)
+			continue
+		}
+		if !p.Destination.Equals(*account) {
+			t.Errorf("%s: destination is %s", c.to, p.Destination)
+		}
+		if (p.DestinationTag == nil) != (c.want == nil) || (c.want != nil && *p.DestinationTag != *c.want) {
+			t.Errorf("%s: destination tag is %v, want %v", c.to, p.DestinationTag, c.want)
+		}
+	}
+
+	if _, err := r.CreateMultiSignPayment(from, main, "1000", "12", "", &other, 1); err == nil {
+		t.Error("tag conflicting with the X-address's was accepted")
+	}
+	if _, err := r.CreateMultiSignPayment(from, test, "1000", "12", "", nil, 1); err == nil {
+		t.Error("test network X-address was accepted for the main network")
+	}
+
+	r.Network = TestNetwork
+	if _, err := r.CreateMultiSignPayment(from, main, "1000", "12", "", nil, 1); err == nil {
+		t.Error("main network X-address was accepted for a test network")
+	}
+	p, err := r.CreateMultiSignPayment(from, test, "1000", "12", "", nil, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.DestinationTag == nil || *p.DestinationTag != tag {
+		t.Errorf("destination tag is %v", p.DestinationTag)
+	}
+}
+
+func TestSignWithEd25519Seed(t *testing.T) {
+	// ripple-keypairs' Ed25519 fixture
+	const (
+		seed    = "sEdSKaCy2JT7JaM7v95H9SxkhP9wS2r"
+		address = "rLUEXYuLiQptky37CqLcm9USQpPiz5rkpD"
+		pubKey  = "ED01FA53FA5A7E77798F882ECE20B1ABC00BB358A9E55A202D0D0676BD0CE37A63"
+	)
+	r, err := NewOfflineRipple()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := r.CreateSingleSignPayment(address, "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe", "1000", "12", "", 1, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := r.SignSingleSignTransaction(p, seed); err != nil {
+		t.Fatal(err)
+	}
+	if got := p.GetPublicKey().String(); got != pubKey {
+		t.Errorf("SigningPubKey is %s, want %s", got, pubKey)
+	}
+	if ok, err := data.CheckSignature(p); err != nil || !ok {
+		t.Errorf("signature is not valid: %v", err)
+	}
+	if err := r.SignSingleSignTransaction(p, "sEdSKaCy2JT7JaM7v95H9SxkhP9wS2s"); err == nil {
+		t.Error("signed with a bad seed")
+	}
+
+	m, err := r.CreateMultiSignPayment("rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh", "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe", "1000", "12", "", nil, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	entry, err := r.SignMultiSignTransactionInParallel(m, seed, address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if entry.Signer.Account.String() != address || entry.Signer.SigningPubKey.String() != pubKey {
+		t.Errorf("signed as %s with %s", entry.Signer.Account, entry.Signer.SigningPubKey)
+	}
+	if err := r.SignMultiSignTransactionInSerial(m, seed, address); err != nil {
+		t.Fatal(err)
+	}
+	if len(m.Signers) != 1 || m.Signers[0].Signer.Account.String() != address {
+		t.Errorf("signers are %v", m.Signers)
+	}
+}
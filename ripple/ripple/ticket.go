@@ -0,0 +1,271 @@
+package ripple
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/goodwood511/ripple_lib/ripple-sdk/crypto"
+	"github.com/goodwood511/ripple_lib/ripple-sdk/data"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	maxTickets          = 250 // most tickets an account can hold
+	ticketLedgerTimeout = 20  // ledgers a TicketCreate from Fill has to validate
+)
+
+/*
+CreateTicketCreate ...
+Create a signle signed TicketCreate, creating count tickets numbered
+from seq+1 to seq+count. The account's next sequence is then seq+count+1.
+fee: in drops
+*/
+func (r *Ripple) CreateTicketCreate(from, fee string, count, seq uint32) (*data.TicketCreate, error) {
+
+	var p data.TicketCreate
+
+	if count == 0 || count > maxTickets {
+		return nil, fmt.Errorf("Ticket count %v is illegal", count)
+	}
+
+	dfee, err := strconv.ParseInt(fee, 10, 64)
+	if err != nil {
+		logrus.Errorf("Fail to covert fee string to int64  err is %v", err)
+		return nil, err
+	}
+
+	if dfee <= 0 {
+		return nil, fmt.Errorf("fee %v is illegal", fee)
+	}
+
+	accountFrom, err := data.NewAccountFromAddress(from)
+	if err != nil {
+		logrus.Errorf("Fail to covert address %v to account, err is %v", from, err)
+		return nil, err
+	}
+
+	p.Sequence = seq
+	p.TicketCount = count
+
+	base := p.GetBase()
+	base.TransactionType = data.TICKET_CREATE
+	base.Account = *accountFrom
+	b, err := data.NewNativeValue(dfee)
+	if err != nil {
+		logrus.Errorf("Fee %v is illegal, err is %v", fee, err)
+		return nil, err
+	}
+	base.Fee = *b
+	return &p, nil
+}
+
+/*
+TicketPool ...
+Hands out an account's tickets, so that many transactions can be signed
+up front and submitted in any order. A ticket is handed out once, unless
+it is given back with Release because its transaction will not be
+submitted.
+*/
+type TicketPool struct {
+	r       *Ripple
+	address string
+	account data.Account
+
+	mu      sync.Mutex
+	tickets []uint32            // available, in order
+	out     map[uint32]struct{} // handed out and not yet seen to be used
+}
+
+/*
+NewTicketPool ...
+Create an empty ticket pool for an account. Use Load to add the tickets
+it already holds and Fill to create more.
+*/
+func (r *Ripple) NewTicketPool(addr string) (*TicketPool, error) {
+	a, err := data.NewAccountFromAddress(addr)
+	if err != nil {
+		logrus.Errorf("Fail to covert address %v to account, err is %v", addr, err)
+		return nil, err
+	}
+	return &TicketPool{
+		r:       r,
+		address: addr,
+		account: *a,
+		out:     make(map[uint32]struct{}),
+	}, nil
+}
+
+/*
+Load ...
+Replace the available tickets with those the account holds in the
+validated ledger, leaving out any which have been handed out
+*/
+func (p *TicketPool) Load() error {
+	result, err := p.r.Client.AccountObjects(p.account, "ticket", "validated")
+	if err != nil {
+		logrus.Errorf("Fail to get account %v's tickets, err is %v", p.address, err)
+		return err
+	}
+
+	held := make(map[uint32]struct{})
+	for _, le := range result.AccountObjects {
+		if ticket, ok := le.(*data.Ticket); ok && ticket.TicketSequence != nil {
+			held[*ticket.TicketSequence] = struct{}{}
+		}
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.tickets = p.tickets[:0]
+	for seq := range held {
+		if _, ok := p.out[seq]; !ok {
+			p.tickets = append(p.tickets, seq)
+		}
+	}
+	// Tickets no longer held have been used
+	for seq := range p.out {
+		if _, ok := held[seq]; !ok {
+			delete(p.out, seq)
+		}
+	}
+	sort.Slice(p.tickets, func(i, j int) bool { return p.tickets[i] < p.tickets[j] })
+	return nil
+}
+
+/*
+Fill ...
+Create count tickets with a TicketCreate signed by signer, and add them to
+the pool once it is validated
+fee: in drops
+*/
+func (p *TicketPool) Fill(signer crypto.KeySigner, count uint32, fee string) (*data.Hash256, error) {
+	seq, err := p.r.GetSequence(p.address)
+	if err != nil {
+		return nil, err
+	}
+	height, err := p.r.GetBlockHeight()
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := p.r.CreateTicketCreate(p.address, fee, count, seq)
+	if err != nil {
+		return nil, err
+	}
+	lastLedger := height + ticketLedgerTimeout
+	tx.LastLedgerSequence = &lastLedger
+
+	if err := p.r.SignSingleSignTransactionWithSigner(tx, signer); err != nil {
+		logrus.Errorf("Fail to sign TicketCreate, err is %v", err)
+		return nil, err
+	}
+	hash, err := p.r.BroadcastSignleSignTransaction(tx)
+	if err != nil {
+		logrus.Errorf("Fail to broadcast TicketCreate, err is %v", err)
+		return nil, err
+	}
+
+	if err := p.r.waitValidated(*hash, lastLedger); err != nil {
+		return hash, err
+	}
+
+	tickets := make([]uint32, count)
+	for i := range tickets {
+		tickets[i] = seq + 1 + uint32(i)
+	}
+	p.Release(tickets...)
+	return hash, nil
+}
+
+// waitValidated polls until the transaction is in a validated ledger, or
+// the validated ledger has passed lastLedger without it
+func (r *Ripple) waitValidated(hash data.Hash256, lastLedger uint32) error {
+	for {
+		height, err := r.GetBlockHeight()
+		if err != nil {
+			return err
+		}
+		// Look the transaction up after the height, so that it cannot be
+		// validated by lastLedger in between and then given up on
+		result, err := r.Client.Tx(hash)
+		if err == nil && result.Validated {
+			if !result.MetaData.TransactionResult.Success() {
+				return fmt.Errorf("Transaction %v failed: %v", hash, result.MetaData.TransactionResult)
+			}
+			return nil
+		}
+		if height > lastLedger {
+			return fmt.Errorf("Transaction %v was not validated by ledger %v", hash, lastLedger)
+		}
+		time.Sleep(time.Second)
+	}
+}
+
+/*
+Take ...
+Hand out the lowest available ticket
+*/
+func (p *TicketPool) Take() (uint32, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if len(p.tickets) == 0 {
+		return 0, fmt.Errorf("No tickets left for %v", p.address)
+	}
+	seq := p.tickets[0]
+	p.tickets = p.tickets[1:]
+	p.out[seq] = struct{}{}
+	return seq, nil
+}
+
+/*
+Use ...
+Take a ticket for tx, which must be from the pool's account, setting its
+TicketSequence and a Sequence of 0. The ticket is returned so that it can
+be released if tx is not submitted.
+*/
+func (p *TicketPool) Use(tx data.Transaction) (uint32, error) {
+	base := tx.GetBase()
+	if !base.Account.Equals(p.account) {
+		return 0, fmt.Errorf("Transaction is from %v, not %v", base.Account, p.address)
+	}
+	seq, err := p.Take()
+	if err != nil {
+		return 0, err
+	}
+	base.Sequence = 0
+	base.TicketSequence = &seq
+	return seq, nil
+}
+
+/*
+Release ...
+Return tickets to the pool
+*/
+func (p *TicketPool) Release(tickets ...uint32) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, seq := range tickets {
+		delete(p.out, seq)
+		i := sort.Search(len(p.tickets), func(i int) bool { return p.tickets[i] >= seq })
+		if i < len(p.tickets) && p.tickets[i] == seq {
+			continue
+		}
+		p.tickets = append(p.tickets, 0)
+		copy(p.tickets[i+1:], p.tickets[i:])
+		p.tickets[i] = seq
+	}
+}
+
+/*
+Len ...
+The number of tickets available
+*/
+func (p *TicketPool) Len() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.tickets)
+}
@@ -0,0 +1,247 @@
+package ripple
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/goodwood511/ripple_lib/ripple-sdk/data"
+	"github.com/goodwood511/ripple_lib/ripple-sdk/websockets"
+)
+
+const testTicketAccount = "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh"
+
+func testTicketPool(t *testing.T, r *Ripple) *TicketPool {
+	p, err := r.NewTicketPool(testTicketAccount)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+func testTicketPayment(t *testing.T, from string) *data.Payment {
+	r, err := NewOfflineRipple()
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := r.CreateSingleSignPayment(from, "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe", "1000", "12", "", 7, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+func TestTicketPoolTakeRelease(t *testing.T) {
+	r, err := NewOfflineRipple()
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := testTicketPool(t, r)
+	if _, err := p.Take(); err == nil {
+		t.Fatal("Took a ticket from an empty pool")
+	}
+
+	// Released in any order, and more than once, tickets are kept sorted
+	p.Release(9, 3, 5, 3, 12)
+	if !reflect.DeepEqual(p.tickets, []uint32{3, 5, 9, 12}) {
+		t.Fatalf("Tickets out of order: %v", p.tickets)
+	}
+	for _, want := range []uint32{3, 5} {
+		if seq, err := p.Take(); err != nil || seq != want {
+			t.Fatalf("Took %d, %v, want %d", seq, err, want)
+		}
+	}
+
+	// A released ticket goes back in its place and is handed out next
+	p.Release(5)
+	p.Release(10)
+	if !reflect.DeepEqual(p.tickets, []uint32{5, 9, 10, 12}) {
+		t.Fatalf("Tickets out of order after release: %v", p.tickets)
+	}
+	if _, ok := p.out[5]; ok {
+		t.Error("Released ticket is still out")
+	}
+	if _, ok := p.out[3]; !ok {
+		t.Error("Taken ticket is not out")
+	}
+
+	tx := testTicketPayment(t, testTicketAccount)
+	if seq, err := p.Use(tx); err != nil || seq != 5 {
+		t.Fatalf("Used %d, %v, want 5", seq, err)
+	}
+	if tx.Sequence != 0 || tx.TicketSequence == nil || *tx.TicketSequence != 5 {
+		t.Errorf("Ticket not set: Sequence %d, TicketSequence %v", tx.Sequence, tx.TicketSequence)
+	}
+	if _, err := p.Use(testTicketPayment(t, "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe")); err == nil {
+		t.Error("Used a ticket for another account's transaction")
+	}
+	if p.Len() != 3 {
+		t.Errorf("Expected 3 tickets left, got %d", p.Len())
+	}
+}
+
+// stubTickets answers account_objects with the tickets the account holds
+type stubTickets struct {
+	mu   sync.Mutex
+	held []uint32
+}
+
+func (s *stubTickets) set(held ...uint32) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.held = held
+}
+
+func (s *stubTickets) accountObjects(req map[string]interface{}) interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	objects := []interface{}{}
+	for _, seq := range s.held {
+		objects = append(objects, map[string]interface{}{
+			"Account":           req["account"],
+			"Flags":             0,
+			"LedgerEntryType":   "Ticket",
+			"OwnerNode":         "0",
+			"PreviousTxnID":     "0AC7B3C3A8D22A0FA8E5A1B6E8F7A0E3B6C1D2E3F4A5B6C7D8E9F0A1B2C3D4E5",
+			"PreviousTxnLgrSeq": 90000000,
+			"TicketSequence":    seq,
+			"index":             fmt.Sprintf("%064X", seq),
+		})
+	}
+	return map[string]interface{}{
+		"account":         req["account"],
+		"account_objects": objects,
+		"ledger_index":    90000010,
+		"validated":       true,
+	}
+}
+
+func TestTicketPoolLoad(t *testing.T) {
+	var tickets stubTickets
+	r := stubRipple(t, map[string]stubHandler{"account_objects": tickets.accountObjects})
+	p := testTicketPool(t, r)
+
+	load := func(held []uint32, available []uint32, out ...uint32) {
+		t.Helper()
+		tickets.set(held...)
+		if err := p.Load(); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(p.tickets, available) {
+			t.Errorf("Held %v: available %v, want %v", held, p.tickets, available)
+		}
+		if len(p.out) != len(out) {
+			t.Errorf("Held %v: out %v, want %v", held, p.out, out)
+		}
+		for _, seq := range out {
+			if _, ok := p.out[seq]; !ok {
+				t.Errorf("Held %v: %d should still be out", held, seq)
+			}
+		}
+	}
+
+	load([]uint32{12, 10, 11}, []uint32{10, 11, 12})
+	if seq, err := p.Take(); err != nil || seq != 10 {
+		t.Fatalf("Took %d, %v", seq, err)
+	}
+
+	// A ticket handed out is not handed out again while it is still held
+	load([]uint32{10, 11, 12}, []uint32{11, 12}, 10)
+
+	// Once it is no longer held it has been used, and is forgotten, as
+	// are any other tickets used elsewhere
+	load([]uint32{12, 13}, []uint32{12, 13})
+
+	if _, err := p.Take(); err != nil {
+		t.Fatal(err)
+	}
+	load(nil, []uint32{})
+	if _, err := p.Take(); err == nil {
+		t.Error("Took a ticket after they were all used")
+	}
+}
+
+// stubLedger stands in for the validated ledger. Each server_state reports
+// the next of heights, and the transaction is validated from ledger
+// validatedIn, with result.
+type stubLedger struct {
+	mu          sync.Mutex
+	heights     []uint32
+	height      uint32
+	validatedIn uint32
+	result      string
+}
+
+func (s *stubLedger) serverState(req map[string]interface{}) interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.heights) > 0 {
+		s.height, s.heights = s.heights[0], s.heights[1:]
+	}
+	return map[string]interface{}{
+		"state": map[string]interface{}{
+			"validated_ledger": map[string]interface{}{"seq": s.height},
+		},
+	}
+}
+
+func (s *stubLedger) tx(req map[string]interface{}) interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.validatedIn == 0 || s.height < s.validatedIn {
+		return &websockets.CommandError{Name: "txnNotFound", Code: 29, Message: "Transaction not found."}
+	}
+	return map[string]interface{}{
+		"Account":         testTicketAccount,
+		"Fee":             "12",
+		"Flags":           0,
+		"Sequence":        20,
+		"TicketCount":     5,
+		"TransactionType": "TicketCreate",
+		"hash":            req["transaction"],
+		"ledger_index":    s.validatedIn,
+		"meta": map[string]interface{}{
+			"AffectedNodes":     []interface{}{},
+			"TransactionIndex":  0,
+			"TransactionResult": s.result,
+		},
+		"validated": true,
+	}
+}
+
+func TestWaitValidated(t *testing.T) {
+	const lastLedger = 1000
+	hash, err := data.NewHash256("82230B9D489370504B39BC2CE46216176CAC9E752E5C1774A8CBEC9FBB819208")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range []struct {
+		name        string
+		heights     []uint32
+		validatedIn uint32
+		result      string
+		err         string
+	}{
+		// The validated ledger moves past lastLedger between polls, having
+		// validated the transaction in lastLedger itself
+		{"validated in the last ledger", []uint32{lastLedger + 2}, lastLedger, "tesSUCCESS", ""},
+		{"validated after a poll", []uint32{lastLedger - 3, lastLedger - 1}, lastLedger - 1, "tesSUCCESS", ""},
+		{"failed", []uint32{lastLedger - 3}, lastLedger - 3, "tecUNFUNDED_PAYMENT", "failed"},
+		{"not validated", []uint32{lastLedger - 1, lastLedger + 1}, 0, "", "not validated"},
+	} {
+		ledger := &stubLedger{height: lastLedger - 4, heights: test.heights, validatedIn: test.validatedIn, result: test.result}
+		r := stubRipple(t, map[string]stubHandler{
+			"server_state": ledger.serverState,
+			"tx":           ledger.tx,
+		})
+		err := r.waitValidated(*hash, lastLedger)
+		switch {
+		case test.err == "" && err != nil:
+			t.Errorf("%s: %v", test.name, err)
+		case test.err != "" && (err == nil || !strings.Contains(err.Error(), test.err)):
+			t.Errorf("%s: expected an error containing %q, got %v", test.name, test.err, err)
+		}
+	}
+}
@@ -2,9 +2,9 @@ package rippleaddr
 
 import (
 	"fmt"
-	"github.com/goodwood511/ripple_lib/ripple-sdk/crypto"
 
-	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/goodwood511/ripple_lib/ripple-sdk/crypto"
+	"github.com/goodwood511/ripple_lib/ripple-sdk/data"
 )
 
 func RippleGenerateKey(s string) (crypto.Key, error) {
@@ -22,35 +22,176 @@ func RippleGenerateKey(s string) (crypto.Key, error) {
 	return key, nil
 }
 
+// CheckRippleSeed accepts family seeds and rippled's "sEd..." seeds of
+// Ed25519 keys
 func CheckRippleSeed(seed string) bool {
-	hash, err := crypto.NewRippleHashCheck(seed, crypto.RIPPLE_FAMILY_SEED)
+	_, _, err := crypto.DecodeSeed(seed)
+	return err == nil
+}
+
+/*
+	Convert a ripple seed to the RFC1751 words which rippled's wallet_propose
+	gives as master_key
+
+seed: human readable string, ex "snoPBrXtMeMyMHUVTgbuqAfg1SUTb"
+*/
+func RippleSeedToRFC1751(seed string) (string, error) {
+	if !CheckRippleSeed(seed) {
+		return "", fmt.Errorf("Not a Ripple seed")
+	}
+
+	s, err := data.NewSeedFromAddress(seed)
 	if err != nil {
-		//fmt.Printf("CheckRippleAddr, err is %v\n", err)
-		return false
+		return "", err
 	}
 
-	if hash.String() == seed {
-		return true
+	return s.RFC1751(), nil
+}
+
+/*
+	Convert RFC1751 words, such as the master_key of rippled's wallet_propose,
+	to a ripple seed
+
+words: ex "I IRE BOND BOW TRIO LAID SEAT GOAL HEN IBIS IBIS DARE", in any case
+*/
+func RFC1751ToRippleSeed(words string) (string, error) {
+	s, err := data.NewSeedFromRFC1751(words)
+	if err != nil {
+		return "", err
 	}
-	return false
+
+	return s.String(), nil
 }
 
 /*
-	Generate keys and addr from ripple seed
+	Split a ripple seed into count shares, any threshold of which recover it
+	with RippleSharesToSeed
 
 seed: human readable string, ex "sh7pek1W31vHCshtWo6hhksmCg7DG"
 */
-func RippleSeedToKeysAndAddr(seed string, sequence *uint32) (privKey string, pubKey string, addr string, err error) {
+func RippleSeedToShares(seed string, threshold, count int) ([]string, error) {
 	if !CheckRippleSeed(seed) {
-		return "", "", "", err
+		return nil, fmt.Errorf("Not a Ripple seed")
 	}
 
-	b, err := crypto.Base58Decode(seed, crypto.ALPHABET)
+	s, err := data.NewSeedFromAddress(seed)
 	if err != nil {
-		return "", "", "", err
+		return nil, err
+	}
+
+	return s.Split(threshold, count)
+}
+
+// Recover a ripple seed from at least the threshold of its shares
+func RippleSharesToSeed(shares []string) (string, error) {
+	s, err := data.NewSeedFromShares(shares)
+	if err != nil {
+		return "", err
+	}
+
+	return s.String(), nil
+}
+
+/*
+	Split a ripple private key into count shares, any threshold of which
+	recover it with RippleSharesToPrivKey
+
+privKey: a huam readable privatekey "pxxxx", secp256k1 or Ed25519
+*/
+func RipplePrivKeyToShares(privKey string, threshold, count int) ([]string, error) {
+	if !CheckRipplePrivKey(privKey) {
+		return nil, fmt.Errorf("Not a Ripple private key")
 	}
 
-	key, err := crypto.NewECDSAKey(b[1 : len(b)-4])
+	b, err := crypto.Base58Decode(privKey, crypto.ALPHABET)
+	if err != nil {
+		return nil, err
+	}
+
+	shares, err := crypto.SplitSecret(b[1:len(b)-4], threshold, count)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]string, len(shares))
+	for i, share := range shares {
+		out[i] = share.String()
+	}
+	return out, nil
+}
+
+// Recover a ripple private key from at least the threshold of its shares
+func RippleSharesToPrivKey(shares []string) (string, error) {
+	hashes := make([]crypto.Hash, len(shares))
+	for i, share := range shares {
+		hash, err := crypto.NewSecretShare(share)
+		if err != nil {
+			return "", err
+		}
+		hashes[i] = hash
+	}
+
+	secret, err := crypto.CombineShares(hashes)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := crypto.NewKeyFromPrivate(secret); err != nil {
+		return "", fmt.Errorf("Shares are not of a private key")
+	}
+
+	priv, err := crypto.NewAccountPrivateKey(secret)
+	if err != nil {
+		return "", err
+	}
+	return priv.String(), nil
+}
+
+/*
+	Generate keys and addr from ripple seed
+
+seed: human readable string, ex "sh7pek1W31vHCshtWo6hhksmCg7DG", or an
+"sEd..." seed, whose key is Ed25519
+*/
+func RippleSeedToKeysAndAddr(seed string, sequence *uint32) (privKey string, pubKey string, addr string, err error) {
+	_, ed25519, err := crypto.DecodeSeed(seed)
+	if err != nil {
+		return "", "", "", fmt.Errorf("Not a Ripple seed")
+	}
+
+	if ed25519 {
+		return RippleSeedToKeysAndAddrOfType(seed, data.Ed25519, sequence)
+	}
+	return RippleSeedToKeysAndAddrOfType(seed, data.ECDSA, sequence)
+}
+
+/*
+	Generate keys and addr of the given key type from ripple seed
+
+Ed25519 keys have no account families, so sequence is ignored for them.
+An "sEd..." seed is only for an Ed25519 key.
+*/
+func RippleSeedToKeysAndAddrOfType(seed string, keyType data.KeyType, sequence *uint32) (privKey string, pubKey string, addr string, err error) {
+	b, ed25519, err := crypto.DecodeSeed(seed)
+	if err != nil {
+		return "", "", "", fmt.Errorf("Not a Ripple seed")
+	}
+
+	if ed25519 && keyType != data.Ed25519 {
+		return "", "", "", fmt.Errorf("Seed is for an Ed25519 key")
+	}
+
+	var key crypto.Key
+	switch keyType {
+	case data.Ed25519:
+		key, err = crypto.NewEd25519Key(b)
+		sequence = nil
+	default:
+		key, err = crypto.NewECDSAKey(b)
+	}
+	if err != nil {
+		return "", "", "", err
+	}
 
 	priv, err := crypto.AccountPrivateKey(key, sequence)
 	if err != nil {
@@ -70,6 +211,77 @@ func RippleSeedToKeysAndAddr(seed string, sequence *uint32) (privKey string, pub
 	return priv.String(), pub.String(), a.String(), nil
 }
 
+// RippleGenerateMnemonic returns a random BIP39 mnemonic of 12 words for
+// 128 bits up to 24 words for 256 bits
+func RippleGenerateMnemonic(bits int) (string, error) {
+	entropy, err := crypto.NewEntropy(bits)
+	if err != nil {
+		return "", err
+	}
+	return crypto.NewMnemonic(entropy)
+}
+
+func CheckRippleMnemonic(mnemonic string) bool {
+	return crypto.IsMnemonicValid(mnemonic)
+}
+
+/*
+	Derive the secp256k1 key at a BIP32 path from a BIP39 mnemonic
+
+passphrase: the BIP39 passphrase, empty for none
+path: ex "m/44'/144'/0'/0/0", see crypto.RippleBIP44Path
+
+The key must be used with a nil sequence
+*/
+func RippleMnemonicToKey(mnemonic, passphrase, path string) (crypto.Key, error) {
+	seed, err := crypto.MnemonicToSeed(mnemonic, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	master, err := crypto.NewMasterKey(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	child, err := master.DerivePath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return child.Key(), nil
+}
+
+/*
+	Generate keys and addr from a BIP39 mnemonic at m/44'/144'/account'/0/index,
+	as wallets such as Ledger and Xumm do
+
+passphrase: the BIP39 passphrase, empty for none
+*/
+func RippleMnemonicToKeysAndAddr(mnemonic, passphrase string, account, index uint32) (privKey string, pubKey string, addr string, err error) {
+	key, err := RippleMnemonicToKey(mnemonic, passphrase, crypto.RippleBIP44Path(account, index))
+	if err != nil {
+		return "", "", "", err
+	}
+
+	priv, err := crypto.AccountPrivateKey(key, nil)
+	if err != nil {
+		return "", "", "", fmt.Errorf("Fail to generate new private key %v", err)
+	}
+
+	pub, err := crypto.AccountPublicKey(key, nil)
+	if err != nil {
+		return "", "", "", fmt.Errorf("Fail to generate new public key %v", err)
+	}
+
+	a, err := crypto.AccountId(key, nil)
+	if err != nil {
+		return "", "", "", fmt.Errorf("Fail to generate new addr %v", err)
+	}
+
+	return priv.String(), pub.String(), a.String(), nil
+}
+
 // Generate keys and addr from arbitrary string
 func RippleGenerateKeysAndAddr(s string, sequence *uint32) (privKey string, pubKey string, addr string, err error) {
 
@@ -113,6 +325,48 @@ func CheckRippleAddr(addr string) bool {
 	return false
 }
 
+// CheckXAddr is whether addr is an X-address, for any network
+func CheckXAddr(addr string) bool {
+	if !crypto.IsXAddress(addr) {
+		return false
+	}
+	_, _, _, err := crypto.DecodeXAddress(addr)
+	return err == nil
+}
+
+/*
+	Encode a classic address and destination tag as an X-address
+
+tag: nil for none
+test: true for the test networks, whose X-addresses start with T
+*/
+func RippleAddrToXAddr(addr string, tag *uint32, test bool) (string, error) {
+	if !CheckRippleAddr(addr) {
+		return "", fmt.Errorf("Not a Ripple address")
+	}
+
+	a, err := data.NewAccountFromAddress(addr)
+	if err != nil {
+		return "", err
+	}
+
+	return crypto.EncodeXAddress(a[:], tag, test)
+}
+
+/*
+	Decode an X-address to its classic address and destination tag
+
+tag: nil when the X-address has none
+test: whether the X-address is for a test network
+*/
+func XAddrToRippleAddr(xaddr string) (addr string, tag *uint32, test bool, err error) {
+	a, tag, test, err := data.NewAccountFromXAddress(xaddr)
+	if err != nil {
+		return "", nil, false, err
+	}
+	return a.String(), tag, test, nil
+}
+
 func CheckRipplePubKey(pubKey string) bool {
 	hash, err := crypto.NewRippleHashCheck(pubKey, crypto.RIPPLE_ACCOUNT_PUBLIC)
 	if err != nil {
@@ -161,6 +415,7 @@ func RipplePubKeyToAddr(pubKey string) (string, error) {
 	return a.String(), nil
 }
 
+// The private key may be secp256k1 or Ed25519
 func RipplePrivKeyToPub(privKey string) (string, error) {
 	if !CheckRipplePrivKey(privKey) {
 		return "", fmt.Errorf("Not a Ripple private key")
@@ -171,11 +426,12 @@ func RipplePrivKeyToPub(privKey string) (string, error) {
 		return "", err
 	}
 
-	// ✅ 使用 btcec/v2 新API
-	_, pub := btcec.PrivKeyFromBytes(b[1 : len(b)-4])
+	key, err := crypto.NewKeyFromPrivate(b[1 : len(b)-4])
+	if err != nil {
+		return "", err
+	}
 
-	// 假设 NewAccountPublicKey 支持压缩公钥输入
-	pubKey, err := crypto.NewAccountPublicKey(pub.SerializeCompressed())
+	pubKey, err := crypto.AccountPublicKey(key, nil)
 	if err != nil {
 		return "", err
 	}
@@ -195,19 +451,43 @@ func CheckRipplePrivKeyPub(privKey string, pubKey string) (bool, error) {
 		return false, nil
 	}
 }
-func NewAddress() ([]byte, string, string, error) {
-	ecdsaKey, err := crypto.NewECDSAKey(nil)
-	if err != nil {
-		return nil, "", "", err
-	}
 
-	// 添加 Serialize() 方法后，能正确取出 []byte
-	key := ecdsaKey.Serialize()
+// NewAddress generates a random secp256k1 key, returning the raw private
+// key, the address and the public key
+func NewAddress() ([]byte, string, string, error) {
+	return NewAddressOfType(data.ECDSA)
+}
 
-	// 新版 btcec/v2 调用方式
-	k, _ := btcec.PrivKeyFromBytes(key)
+// NewAddressOfType generates a random key of the given type. The raw
+// private key is 32 bytes for secp256k1 or 33 bytes prefixed with 0xED
+// for Ed25519.
+func NewAddressOfType(keyType data.KeyType) ([]byte, string, string, error) {
+	var (
+		key     crypto.Key
+		private []byte
+	)
+	switch keyType {
+	case data.ECDSA:
+		ecdsaKey, err := crypto.NewECDSAKey(nil)
+		if err != nil {
+			return nil, "", "", err
+		}
+		key, private = ecdsaKey, ecdsaKey.Serialize()
+	case data.Ed25519:
+		ed25519Key, err := crypto.NewEd25519Key(nil)
+		if err != nil {
+			return nil, "", "", err
+		}
+		priv, err := crypto.AccountPrivateKey(ed25519Key, nil)
+		if err != nil {
+			return nil, "", "", err
+		}
+		key, private = ed25519Key, priv.Payload()
+	default:
+		return nil, "", "", fmt.Errorf("Unknown key type %v", keyType)
+	}
 
-	pubKey, err := crypto.NewAccountPublicKey(k.PubKey().SerializeCompressed())
+	pubKey, err := crypto.AccountPublicKey(key, nil)
 	if err != nil {
 		return nil, "", "", err
 	}
@@ -217,5 +497,5 @@ func NewAddress() ([]byte, string, string, error) {
 		return nil, "", "", err
 	}
 
-	return key, addr, pubKey.String(), nil
+	return private, addr, pubKey.String(), nil
 }
@@ -0,0 +1,146 @@
+package rippleaddr
+
+import (
+	"testing"
+
+	"github.com/goodwood511/ripple_lib/ripple-sdk/crypto"
+	"github.com/goodwood511/ripple_lib/ripple-sdk/data"
+)
+
+func TestEd25519Seed(t *testing.T) {
+	// ripple-keypairs' Ed25519 fixture, whose entropy is 0x01 to 0x10
+	const (
+		seed       = "sEdSKaCy2JT7JaM7v95H9SxkhP9wS2r"
+		familySeed = "sp5fghtJtpUorTwvof1NpDXAzNwf5"
+		address    = "rLUEXYuLiQptky37CqLcm9USQpPiz5rkpD"
+	)
+	if !CheckRippleSeed(seed) || !CheckRippleSeed(familySeed) {
+		t.Fatal("seeds are not valid")
+	}
+
+	priv, pub, addr, err := RippleSeedToKeysAndAddr(seed, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if addr != address {
+		t.Errorf("address is %s, want %s", addr, address)
+	}
+
+	// The family seed of the same entropy gives the same key when asked
+	// for Ed25519
+	var sequence uint32
+	priv2, pub2, addr2, err := RippleSeedToKeysAndAddrOfType(familySeed, data.Ed25519, &sequence)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if priv2 != priv || pub2 != pub || addr2 != addr {
+		t.Errorf("family seed gives %s %s %s, want %s %s %s", priv2, pub2, addr2, priv, pub, addr)
+	}
+	if _, _, addr, _ := RippleSeedToKeysAndAddr(familySeed, nil); addr == address {
+		t.Error("family seed is not secp256k1 by default")
+	}
+
+	if _, _, _, err := RippleSeedToKeysAndAddrOfType(seed, data.ECDSA, nil); err == nil {
+		t.Error("sEd seed gave a secp256k1 key")
+	}
+}
+
+func TestRippleMnemonicToKeysAndAddr(t *testing.T) {
+	// The account which xrpl.js derives at m/44'/144'/0'/0/0
+	const mnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+	if !CheckRippleMnemonic(mnemonic) {
+		t.Fatal("mnemonic is not valid")
+	}
+	_, _, addr, err := RippleMnemonicToKeysAndAddr(mnemonic, "", 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if addr != "rHsMGQEkVNJmpGWs8XUBoTBiAAbwxZN5v3" {
+		t.Errorf("address is %s", addr)
+	}
+	if _, _, other, _ := RippleMnemonicToKeysAndAddr(mnemonic, "TREZOR", 0, 0); other == addr {
+		t.Error("passphrase is not used")
+	}
+}
+
+func TestRippleSeedRFC1751(t *testing.T) {
+	// rippled's wallet_propose of "masterpassphrase"
+	const (
+		seed  = "snoPBrXtMeMyMHUVTgbuqAfg1SUTb"
+		words = "I IRE BOND BOW TRIO LAID SEAT GOAL HEN IBIS IBIS DARE"
+	)
+	got, err := RippleSeedToRFC1751(seed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != words {
+		t.Errorf("words are %q, want %q", got, words)
+	}
+	for _, s := range []string{words, "i ire bond bow trio laid seat goal hen ibis ibis dare"} {
+		got, err := RFC1751ToRippleSeed(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != seed {
+			t.Errorf("%q: seed is %s, want %s", s, got, seed)
+		}
+	}
+	if _, err := RFC1751ToRippleSeed("TIDE ITCH SLOW REIN RULE MOT"); err == nil {
+		t.Error("six words gave a seed")
+	}
+}
+
+func TestRippleSeedShares(t *testing.T) {
+	const seed = "snoPBrXtMeMyMHUVTgbuqAfg1SUTb"
+	shares, err := RippleSeedToShares(seed, 3, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, share := range shares {
+		if share[0] != 's' {
+			t.Errorf("share %s does not look like a seed", share)
+		}
+	}
+	recovered, err := RippleSharesToSeed([]string{shares[4], shares[1], shares[2]})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if recovered != seed {
+		t.Errorf("recovered %s, want %s", recovered, seed)
+	}
+	var sequence uint32
+	_, _, addr, err := RippleSeedToKeysAndAddr(recovered, &sequence)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if addr != "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh" {
+		t.Errorf("recovered seed's address is %s", addr)
+	}
+	if _, err := RippleSharesToSeed(shares[:2]); err == nil {
+		t.Error("recovered a seed from two shares")
+	}
+	// A share pasted in with a version byte of no known kind
+	bad := crypto.Base58Encode(append([]byte{0xF0}, make([]byte, 24)...), crypto.ALPHABET)
+	if _, err := RippleSharesToSeed([]string{bad, shares[0], shares[1]}); err == nil {
+		t.Error("recovered a seed from a share of an unknown version")
+	}
+
+	privKey, _, _, err := RippleSeedToKeysAndAddr(seed, &sequence)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyShares, err := RipplePrivKeyToShares(privKey, 3, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	recoveredKey, err := RippleSharesToPrivKey(keyShares[2:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if recoveredKey != privKey {
+		t.Errorf("recovered %s, want %s", recoveredKey, privKey)
+	}
+	if _, err := RippleSharesToSeed(keyShares[:3]); err == nil {
+		t.Error("private key shares gave a seed")
+	}
+}
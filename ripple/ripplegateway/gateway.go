@@ -13,6 +13,7 @@ import (
 const (
 	NewAddressURL    = "%s/v1/account/new"
 	SignAndPushTxURL = "%s/v1/account/signTx"
+	SignMsgURL       = "%s/v1/account/signMsg"
 )
 
 type Gateway struct {
@@ -47,6 +48,18 @@ type SignTxResp struct {
 	Extension map[string]string `json:"extension"`
 }
 
+// SignMsgReq : msg is hex encoded, see Signer.Sign
+type SignMsgReq struct {
+	Address string `json:"address"`
+	Msg     string `json:"msg"`
+}
+
+// SignMsgResp : signature is hex encoded
+type SignMsgResp struct {
+	Base      BaseResp `json:"base"`
+	Signature string   `json:"signature"`
+}
+
 func NewRippleGateway(url string) *Gateway {
 	return &Gateway{
 		URL: url,
@@ -0,0 +1,100 @@
+package ripplegateway
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/goodwood511/ripple_lib/ripple-sdk/crypto"
+	"github.com/goodwood511/ripple_lib/ripple-sdk/data"
+	"github.com/sirupsen/logrus"
+)
+
+// Signer signs with a key held by the gateway, so it can be passed to the
+// data and ripple signing helpers without the key leaving the gateway.
+// The gateway must serve SignMsgURL.
+//
+// The gateway is sent the whole message to sign, never only its hash, so
+// that it can decode the transaction and refuse those which its policy
+// does not allow. The message is a transaction's signing blob: the 'STX'
+// prefix and the transaction, or the 'SMT' prefix, the transaction and
+// the signer's account for multisigning. The gateway signs the message
+// with an Ed25519 key, or its SHA-512Half with a secp256k1 key, as rippled
+// does, and must not sign anything else.
+type Signer struct {
+	gateway *Gateway
+	address string
+	pubkey  []byte
+}
+
+var _ crypto.KeySigner = (*Signer)(nil)
+
+// Signer returns a signer for an address and public key as returned by
+// NewAddress
+func (receiver *Gateway) Signer(address, pubkey string) (*Signer, error) {
+	hash, err := crypto.NewRippleHashCheck(pubkey, crypto.RIPPLE_ACCOUNT_PUBLIC)
+	if err != nil {
+		return nil, err
+	}
+	account, err := crypto.NewAccountId(crypto.Sha256RipeMD160(hash.Payload()))
+	if err != nil {
+		return nil, err
+	}
+	if account.String() != address {
+		return nil, fmt.Errorf("public key %s does not belong to %s", pubkey, address)
+	}
+	return &Signer{
+		gateway: receiver,
+		address: address,
+		pubkey:  hash.Payload(),
+	}, nil
+}
+
+func (s *Signer) Public() []byte {
+	return s.pubkey
+}
+
+// Sign sends msg, which must be a transaction's signing blob whose
+// SHA-512Half is hash, to the gateway and checks the signature it returns
+func (s *Signer) Sign(hash, msg []byte) ([]byte, error) {
+	if !bytes.HasPrefix(msg, data.HP_TRANSACTION_SIGN.Bytes()) && !bytes.HasPrefix(msg, data.HP_TRANSACTION_MULTSIGN.Bytes()) {
+		return nil, fmt.Errorf("message is not a transaction to sign")
+	}
+	if !bytes.Equal(crypto.Sha512Half(msg), hash) {
+		return nil, fmt.Errorf("hash is not of the message")
+	}
+
+	var resp SignMsgResp
+
+	reqInfo := SignMsgReq{
+		Address: s.address,
+		Msg:     hex.EncodeToString(msg),
+	}
+
+	url := fmt.Sprintf(SignMsgURL, s.gateway.URL)
+
+	err := sendPostRequest(url, nil, reqInfo, &resp)
+	if err != nil {
+		logrus.Errorf("SignMsg send request error: %v", err.Error())
+		return nil, err
+	}
+
+	if resp.Base.ReturnCode != 0 {
+		err := fmt.Errorf("error chain response(%v): %v", resp.Base.ReturnCode, resp.Base.ReturnMsg)
+		logrus.Errorf("signMsg return error: %v", err.Error())
+		return nil, err
+	}
+
+	sig, err := hex.DecodeString(resp.Signature)
+	if err != nil {
+		return nil, err
+	}
+	valid, err := crypto.Verify(s.pubkey, hash, msg, sig)
+	if err != nil {
+		return nil, err
+	}
+	if !valid {
+		return nil, fmt.Errorf("gateway signature does not match public key of %s", s.address)
+	}
+	return sig, nil
+}
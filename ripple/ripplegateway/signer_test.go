@@ -0,0 +1,105 @@
+package ripplegateway
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/goodwood511/ripple_lib/ripple-sdk/crypto"
+	"github.com/goodwood511/ripple_lib/ripple-sdk/data"
+)
+
+// testGateway serves SignMsgURL for the account key of seed, signing only
+// transactions, as a gateway must
+func testGateway(t *testing.T, seed string) (*Gateway, string, string) {
+	hash, err := crypto.NewRippleHashCheck(seed, crypto.RIPPLE_FAMILY_SEED)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := crypto.NewECDSAKey(hash.Payload())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var sequence uint32
+	address, err := crypto.AccountId(key, &sequence)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubkey, err := crypto.AccountPublicKey(key, &sequence)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/account/signMsg" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		var req SignMsgReq
+		var resp SignMsgResp
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			resp.Base = BaseResp{1, "bad request"}
+		} else if msg, err := hex.DecodeString(req.Msg); err != nil || !bytes.HasPrefix(msg, data.HP_TRANSACTION_SIGN.Bytes()) {
+			resp.Base = BaseResp{2, "not a transaction"}
+		} else if req.Address != address.String() {
+			resp.Base = BaseResp{3, "unknown address"}
+		} else if sig, err := crypto.Sign(key.Private(&sequence), crypto.Sha512Half(msg), msg); err != nil {
+			resp.Base = BaseResp{4, err.Error()}
+		} else {
+			resp.Signature = hex.EncodeToString(sig)
+		}
+		json.NewEncoder(w).Encode(resp)
+	}))
+	t.Cleanup(server.Close)
+	return NewRippleGateway(server.URL), address.String(), pubkey.String()
+}
+
+func TestSigner(t *testing.T) {
+	gateway, address, pubkey := testGateway(t, "snoPBrXtMeMyMHUVTgbuqAfg1SUTb")
+	if _, err := gateway.Signer("rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe", pubkey); err == nil {
+		t.Error("signer for another address")
+	}
+	signer, err := gateway.Signer(address, pubkey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	account, err := data.NewAccountFromAddress(address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	amount, err := data.NewAmount(int64(1000000))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx := &data.Payment{
+		TxBase: data.TxBase{
+			TransactionType: data.PAYMENT,
+			Account:         *account,
+			Sequence:        1,
+		},
+		Destination: *account,
+		Amount:      *amount,
+	}
+	if err := data.SignWithSigner(tx, signer); err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := data.CheckSignature(tx); err != nil || !ok {
+		t.Errorf("signature is not valid: %v", err)
+	}
+
+	// Neither a bare hash nor a hash of another message is sent
+	msg := append(data.HP_TRANSACTION_SIGN.Bytes(), 1, 2, 3)
+	if _, err := signer.Sign(crypto.Sha512Half(msg), nil); err == nil {
+		t.Error("signed a hash without its message")
+	}
+	if _, err := signer.Sign(make([]byte, 32), msg); err == nil {
+		t.Error("signed a hash of another message")
+	}
+	if _, err := signer.Sign(crypto.Sha512Half([]byte("hello")), []byte("hello")); err == nil {
+		t.Error("signed a message which is not a transaction")
+	}
+}
@@ -27,7 +27,7 @@ func (c *Client) GetSendableAmount(address string) (decimal.Decimal, error) {
 	}
 
 	var res accountInfoFull
-	_, err := c.client.R().SetBody(req).SetResult(&res).Post(c.rpcURL)
+	err := c.post(req, &res)
 	if err != nil {
 		return decimal.Zero, err
 	}
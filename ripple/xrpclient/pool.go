@@ -0,0 +1,222 @@
+package xrpclient
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	healthCheckPeriod     = 10 * time.Second
+	maxValidatedLedgerAge = 60 // seconds
+	maxValidatedLedgerLag = 5  // ledgers behind the best endpoint
+
+	// Time allowed for a single endpoint's server_info
+	healthCheckTimeout = 5 * time.Second
+)
+
+var syncedServerStates = map[string]bool{
+	"full":       true,
+	"proposing":  true,
+	"validating": true,
+}
+
+// Health is the result of the most recent check of an endpoint
+type Health struct {
+	URL             string
+	Healthy         bool
+	ServerState     string
+	ValidatedLedger uint32
+	LedgerAge       uint32
+	Latency         time.Duration
+	Checked         time.Time
+	Err             error
+}
+
+type endpointPool struct {
+	mu     sync.RWMutex
+	health []Health
+	quit   chan struct{}
+	once   sync.Once
+}
+
+// Endpoints start out healthy so that a Client without health
+// checking uses them until they fail.
+func newEndpointPool(urls []string) *endpointPool {
+	p := &endpointPool{quit: make(chan struct{})}
+	for _, url := range urls {
+		p.health = append(p.health, Health{URL: url, Healthy: true})
+	}
+	return p
+}
+
+func (p *endpointPool) close() {
+	p.once.Do(func() { close(p.quit) })
+}
+
+// candidates returns every endpoint URL, healthiest first. Unhealthy
+// endpoints are included as a last resort.
+func (p *endpointPool) candidates() []string {
+	p.mu.RLock()
+	ranked := make([]Health, len(p.health))
+	copy(ranked, p.health)
+	p.mu.RUnlock()
+	sort.SliceStable(ranked, func(i, j int) bool {
+		a, b := ranked[i], ranked[j]
+		switch {
+		case a.Healthy != b.Healthy:
+			return a.Healthy
+		case a.ValidatedLedger != b.ValidatedLedger:
+			return a.ValidatedLedger > b.ValidatedLedger
+		default:
+			return a.Latency < b.Latency
+		}
+	})
+	urls := make([]string, len(ranked))
+	for i := range ranked {
+		urls[i] = ranked[i].URL
+	}
+	return urls
+}
+
+func (p *endpointPool) markUnhealthy(url string, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for i := range p.health {
+		if p.health[i].URL == url {
+			p.health[i].Healthy = false
+			p.health[i].Err = err
+		}
+	}
+}
+
+// Health returns the latest health of every endpoint
+func (c *Client) Health() []Health {
+	c.pool.mu.RLock()
+	defer c.pool.mu.RUnlock()
+	health := make([]Health, len(c.pool.health))
+	copy(health, c.pool.health)
+	return health
+}
+
+// post sends a JSON-RPC request to the healthiest endpoint, failing over
+// to the next on a transport error or server failure.
+func (c *Client) post(req, res interface{}) error {
+	return c.postContext(context.Background(), req, res)
+}
+
+// postContext is post, giving up when ctx is done
+func (c *Client) postContext(ctx context.Context, req, res interface{}) error {
+	var lastErr error
+	for _, url := range c.pool.candidates() {
+		err := c.postTo(ctx, url, req, res)
+		if err == nil {
+			return nil
+		}
+		if ctx.Err() != nil {
+			return err
+		}
+		c.pool.markUnhealthy(url, err)
+		lastErr = err
+	}
+	return lastErr
+}
+
+func (c *Client) postTo(ctx context.Context, url string, req, res interface{}) error {
+	resp, err := c.client.R().SetContext(ctx).SetBody(req).SetResult(res).Post(url)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode() >= 500 {
+		return fmt.Errorf("%s: HTTP %d", url, resp.StatusCode())
+	}
+	return nil
+}
+
+func (c *Client) run() {
+	ticker := time.NewTicker(healthCheckPeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.pool.quit:
+			return
+		case <-ticker.C:
+			c.checkHealth()
+		}
+	}
+}
+
+type serverInfoHealth struct {
+	Result struct {
+		Info struct {
+			ServerState     string `json:"server_state"`
+			ValidatedLedger struct {
+				Age uint32 `json:"age"`
+				Seq uint32 `json:"seq"`
+			} `json:"validated_ledger"`
+		} `json:"info"`
+		Error string `json:"error,omitempty"`
+	} `json:"result"`
+}
+
+// checkHealth queries server_info on every endpoint concurrently and
+// marks those which are out of sync, stale or lagging as unhealthy.
+func (c *Client) checkHealth() {
+	c.pool.mu.RLock()
+	health := make([]Health, len(c.pool.health))
+	copy(health, c.pool.health)
+	c.pool.mu.RUnlock()
+
+	req := map[string]interface{}{
+		"method": "server_info",
+		"params": []interface{}{map[string]interface{}{}},
+	}
+	var wg sync.WaitGroup
+	for i := range health {
+		wg.Add(1)
+		go func(h *Health) {
+			defer wg.Done()
+			var res serverInfoHealth
+			*h = Health{URL: h.URL, Checked: time.Now()}
+			ctx, cancel := context.WithTimeout(context.Background(), healthCheckTimeout)
+			defer cancel()
+			err := c.postTo(ctx, h.URL, req, &res)
+			h.Latency = time.Since(h.Checked)
+			info := res.Result.Info
+			h.ServerState, h.ValidatedLedger, h.LedgerAge = info.ServerState, info.ValidatedLedger.Seq, info.ValidatedLedger.Age
+			switch {
+			case err != nil:
+				h.Err = err
+			case res.Result.Error != "":
+				h.Err = fmt.Errorf("server_info: %s", res.Result.Error)
+			case !syncedServerStates[h.ServerState]:
+				h.Err = fmt.Errorf("server state: %s", h.ServerState)
+			case h.ValidatedLedger == 0:
+				h.Err = fmt.Errorf("no validated ledger")
+			case h.LedgerAge > maxValidatedLedgerAge:
+				h.Err = fmt.Errorf("validated ledger is %d seconds old", h.LedgerAge)
+			default:
+				h.Healthy = true
+			}
+		}(&health[i])
+	}
+	wg.Wait()
+
+	var best uint32
+	for _, h := range health {
+		if h.Healthy && h.ValidatedLedger > best {
+			best = h.ValidatedLedger
+		}
+	}
+	for i := range health {
+		if health[i].Healthy && best-health[i].ValidatedLedger > maxValidatedLedgerLag {
+			health[i].Healthy = false
+			health[i].Err = fmt.Errorf("%d ledgers behind", best-health[i].ValidatedLedger)
+		}
+	}
+	c.pool.mu.Lock()
+	c.pool.health = health
+	c.pool.mu.Unlock()
+}
@@ -0,0 +1,241 @@
+package xrpclient
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// testNode is a JSON-RPC server standing in for rippled, which reports
+// the given validated ledger from server_info and counts the requests
+// it receives
+type testNode struct {
+	*httptest.Server
+	mu     sync.Mutex
+	state  string
+	seq    uint32
+	age    uint32
+	status int           // HTTP status to fail with, if non-zero
+	stall  time.Duration // Time to wait before answering
+	hits   map[string]int
+}
+
+func newTestNode(t *testing.T, seq, age uint32) *testNode {
+	n := &testNode{state: "full", seq: seq, age: age, hits: make(map[string]int)}
+	n.Server = httptest.NewServer(http.HandlerFunc(n.serve))
+	t.Cleanup(n.Close)
+	return n
+}
+
+func (n *testNode) serve(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Method string `json:"method"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	n.mu.Lock()
+	n.hits[req.Method]++
+	state, seq, age, status, stall := n.state, n.seq, n.age, n.status, n.stall
+	n.mu.Unlock()
+
+	if stall > 0 {
+		select {
+		case <-time.After(stall):
+		case <-r.Context().Done():
+			return
+		}
+	}
+	if status != 0 {
+		http.Error(w, "failing", status)
+		return
+	}
+	var result interface{}
+	switch req.Method {
+	case "server_info":
+		result = map[string]interface{}{
+			"info": map[string]interface{}{
+				"server_state": state,
+				"validated_ledger": map[string]interface{}{
+					"age": age,
+					"seq": seq,
+				},
+			},
+			"status": "success",
+		}
+	case "account_info":
+		result = map[string]interface{}{
+			"account_data": map[string]interface{}{"Balance": "25000000"},
+			"status":       "success",
+		}
+	default:
+		result = map[string]interface{}{
+			"error":  "unknownCmd",
+			"status": "error",
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{"result": result})
+}
+
+func (n *testNode) set(f func(n *testNode)) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	f(n)
+}
+
+func (n *testNode) count(method string) int {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.hits[method]
+}
+
+func healthOf(t *testing.T, c *Client, url string) Health {
+	t.Helper()
+	for _, h := range c.Health() {
+		if h.URL == url {
+			return h
+		}
+	}
+	t.Fatalf("No health for %s", url)
+	return Health{}
+}
+
+func TestFailoverClientHealth(t *testing.T) {
+	healthy := newTestNode(t, 1000, 2)
+	stale := newTestNode(t, 1000, maxValidatedLedgerAge+1)
+	lagging := newTestNode(t, 1000-maxValidatedLedgerLag-1, 2)
+	syncing := newTestNode(t, 1000, 2)
+	syncing.state = "syncing"
+	failing := newTestNode(t, 1000, 2)
+	failing.status = http.StatusServiceUnavailable
+
+	c, err := NewFailoverClient([]string{stale.URL, failing.URL, lagging.URL, syncing.URL, healthy.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	for _, test := range []struct {
+		node    *testNode
+		healthy bool
+		err     string
+	}{
+		{healthy, true, ""},
+		{stale, false, "seconds old"},
+		{lagging, false, "6 ledgers behind"},
+		{syncing, false, "server state: syncing"},
+		{failing, false, "HTTP 503"},
+	} {
+		h := healthOf(t, c, test.node.URL)
+		if h.Healthy != test.healthy {
+			t.Errorf("%s: expected healthy %t: %+v", test.node.URL, test.healthy, h)
+		}
+		if test.err != "" && (h.Err == nil || !strings.Contains(h.Err.Error(), test.err)) {
+			t.Errorf("%s: expected error containing %q, got %v", test.node.URL, test.err, h.Err)
+		}
+	}
+
+	balance, err := c.GetBalance("rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if balance.String() != "25000000" {
+		t.Errorf("Wrong balance: %s", balance)
+	}
+	if healthy.count("account_info") != 1 {
+		t.Errorf("Healthy endpoint got %d requests", healthy.count("account_info"))
+	}
+	for _, n := range []*testNode{stale, failing, lagging, syncing} {
+		if n.count("account_info") != 0 {
+			t.Errorf("Unhealthy endpoint %s was used", n.URL)
+		}
+	}
+}
+
+func TestFailoverClientFailsOver(t *testing.T) {
+	first := newTestNode(t, 1001, 2)
+	second := newTestNode(t, 1000, 2)
+	c, err := NewFailoverClient([]string{second.URL, first.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	// Ranked by validated ledger
+	if _, err := c.GetBalance("rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh"); err != nil {
+		t.Fatal(err)
+	}
+	if first.count("account_info") != 1 || second.count("account_info") != 0 {
+		t.Fatalf("Expected the most recent endpoint to be used: %d %d", first.count("account_info"), second.count("account_info"))
+	}
+
+	first.set(func(n *testNode) { n.status = http.StatusInternalServerError })
+	for i := 0; i < 2; i++ {
+		if _, err := c.GetBalance("rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if first.count("account_info") != 2 || second.count("account_info") != 2 {
+		t.Errorf("Expected one failure then failover: %d %d", first.count("account_info"), second.count("account_info"))
+	}
+	if h := healthOf(t, c, first.URL); h.Healthy || h.Err == nil {
+		t.Errorf("Failed endpoint still healthy: %+v", h)
+	}
+
+	// With every endpoint down the last error is returned
+	second.set(func(n *testNode) { n.status = http.StatusBadGateway })
+	if _, err := c.GetBalance("rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh"); err == nil || !strings.Contains(err.Error(), "HTTP") {
+		t.Errorf("Expected an HTTP error, got %v", err)
+	}
+}
+
+func TestClientTimeout(t *testing.T) {
+	c := NewClient("http://127.0.0.1:1")
+	if timeout := c.client.GetClient().Timeout; timeout != DefaultTimeout {
+		t.Errorf("Expected DefaultTimeout, got %s", timeout)
+	}
+
+	stalled := newTestNode(t, 1001, 2)
+	working := newTestNode(t, 1000, 2)
+	c, err := NewFailoverClient([]string{stalled.URL, working.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	// A stalled endpoint fails over once the request times out
+	stalled.set(func(n *testNode) { n.stall = time.Minute })
+	c.SetTimeout(100 * time.Millisecond)
+	start := time.Now()
+	if _, err := c.GetBalance("rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh"); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("Timeout not applied, took %s", elapsed)
+	}
+	if stalled.count("account_info") != 1 || working.count("account_info") != 1 {
+		t.Errorf("Expected failover after the timeout: %d %d", stalled.count("account_info"), working.count("account_info"))
+	}
+	if h := healthOf(t, c, stalled.URL); h.Healthy {
+		t.Errorf("Stalled endpoint still healthy: %+v", h)
+	}
+
+	// The caller giving up is not the endpoint's fault
+	working.set(func(n *testNode) { n.stall = time.Minute })
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	c.SetTimeout(DefaultTimeout)
+	if _, err := c.ServerInfoContext(ctx); err == nil {
+		t.Fatal("Expected the context to expire")
+	}
+	if h := healthOf(t, c, working.URL); !h.Healthy {
+		t.Errorf("Endpoint marked unhealthy when the caller gave up: %+v", h)
+	}
+}
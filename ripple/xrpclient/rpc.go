@@ -0,0 +1,624 @@
+package xrpclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/golang/glog"
+	"github.com/goodwood511/ripple_lib/ripple-sdk/data"
+	"github.com/goodwood511/ripple_lib/ripple-sdk/websockets"
+)
+
+// The methods in this file mirror those of websockets.Remote, sending the
+// same command structs over JSON-RPC and returning the same result types.
+
+// Fields of a websocket command which have no place in JSON-RPC params.
+// The command's own type is cleared before marshalling instead, as "type"
+// is also a param of commands such as account_objects.
+var websocketOnlyFields = []string{"id", "command", "status", "result", "Result"}
+
+func newCommand(name string) *websockets.Command {
+	return &websockets.Command{Name: name}
+}
+
+type rpcResponse struct {
+	Result json.RawMessage `json:"result"`
+}
+
+type rpcStatus struct {
+	*websockets.CommandError
+	Status string `json:"status"`
+}
+
+// call sends a websocket command struct as a JSON-RPC request and fills
+// in its Result. Errors reported by rippled are returned as
+// *websockets.CommandError, exactly as the Remote would return them.
+func (c *Client) call(ctx context.Context, base *websockets.Command, cmd interface{}) error {
+	base.Type = ""
+	b, err := json.Marshal(cmd)
+	if err != nil {
+		return err
+	}
+	var params map[string]json.RawMessage
+	if err := json.Unmarshal(b, &params); err != nil {
+		return err
+	}
+	for _, field := range websocketOnlyFields {
+		delete(params, field)
+	}
+	req := map[string]interface{}{
+		"method": base.Name,
+		"params": []interface{}{params},
+	}
+	var res rpcResponse
+	if err := c.postContext(ctx, req, &res); err != nil {
+		return err
+	}
+	if len(res.Result) == 0 {
+		return fmt.Errorf("%s: empty response", base.Name)
+	}
+	var status rpcStatus
+	if err := json.Unmarshal(res.Result, &status); err != nil {
+		return err
+	}
+	base.Status = status.Status
+	if status.CommandError != nil && status.CommandError.Name != "" {
+		base.CommandError = status.CommandError
+		return status.CommandError
+	}
+	return json.Unmarshal(append(append([]byte(`{"result":`), res.Result...), '}'), cmd)
+}
+
+// Synchronously gets a single transaction
+func (c *Client) Tx(hash data.Hash256) (*websockets.TxResult, error) {
+	return c.TxContext(context.Background(), hash)
+}
+
+// TxContext is Tx, giving up when ctx is done
+func (c *Client) TxContext(ctx context.Context, hash data.Hash256) (*websockets.TxResult, error) {
+	cmd := &websockets.TxCommand{
+		Command:     newCommand("tx"),
+		Transaction: hash,
+	}
+	if err := c.call(ctx, cmd.Command, cmd); err != nil {
+		return nil, err
+	}
+	return cmd.Result, nil
+}
+
+// AccountTxPage requests a single page of transactions for an account.
+// Pass the Marker of the previous page to continue, or nil to start.
+func (c *Client) AccountTxPage(ctx context.Context, account data.Account, pageSize int, marker map[string]interface{}, minLedger, maxLedger int64) (*websockets.AccountTxResult, error) {
+	cmd := &websockets.AccountTxCommand{
+		Command:   newCommand("account_tx"),
+		Account:   account,
+		MinLedger: minLedger,
+		MaxLedger: maxLedger,
+		Limit:     pageSize,
+		Marker:    marker,
+	}
+	if err := c.call(ctx, cmd.Command, cmd); err != nil {
+		return nil, err
+	}
+	return cmd.Result, nil
+}
+
+func (c *Client) accountTx(ctx context.Context, account data.Account, ch chan *data.TransactionWithMetaData, pageSize int, minLedger, maxLedger int64) {
+	defer close(ch)
+	var marker map[string]interface{}
+	for {
+		result, err := c.AccountTxPage(ctx, account, pageSize, marker, minLedger, maxLedger)
+		if err != nil {
+			glog.Errorln(err.Error())
+			return
+		}
+		for _, tx := range result.Transactions {
+			select {
+			case ch <- tx:
+			case <-ctx.Done():
+				return
+			}
+		}
+		if result.Marker == nil {
+			return
+		}
+		marker = result.Marker
+	}
+}
+
+// Retrieve all transactions for an account, calling account_tx as many
+// times as there are pages. Transactions are returned asynchronously to
+// the channel returned by this function.
+//
+// Use minLedger -1 for the earliest ledger available.
+// Use maxLedger -1 for the most recent validated ledger.
+func (c *Client) AccountTx(account data.Account, pageSize int, minLedger, maxLedger int64) chan *data.TransactionWithMetaData {
+	return c.AccountTxContext(context.Background(), account, pageSize, minLedger, maxLedger)
+}
+
+// AccountTxContext is AccountTx, closing the channel early when ctx is done
+func (c *Client) AccountTxContext(ctx context.Context, account data.Account, pageSize int, minLedger, maxLedger int64) chan *data.TransactionWithMetaData {
+	ch := make(chan *data.TransactionWithMetaData)
+	go c.accountTx(ctx, account, ch, pageSize, minLedger, maxLedger)
+	return ch
+}
+
+// Synchronously submit a single transaction
+func (c *Client) Submit(tx data.Transaction) (*websockets.SubmitResult, error) {
+	return c.SubmitContext(context.Background(), tx)
+}
+
+// SubmitContext is Submit, giving up when ctx is done. Note that a
+// transaction which has already been sent may still be applied.
+func (c *Client) SubmitContext(ctx context.Context, tx data.Transaction) (*websockets.SubmitResult, error) {
+	_, raw, err := data.Raw(tx)
+	if err != nil {
+		return nil, err
+	}
+	cmd := &websockets.SubmitCommand{
+		Command: newCommand("submit"),
+		TxBlob:  fmt.Sprintf("%X", raw),
+	}
+	if err := c.call(ctx, cmd.Command, cmd); err != nil {
+		return nil, err
+	}
+	return cmd.Result, nil
+}
+
+// Synchronously submit multiple transactions. Results for transactions
+// which failed are nil, and the first error is returned.
+func (c *Client) SubmitBatch(txs []data.Transaction) ([]*websockets.SubmitResult, error) {
+	return c.SubmitBatchContext(context.Background(), txs)
+}
+
+// SubmitBatchContext is SubmitBatch, giving up when ctx is done
+func (c *Client) SubmitBatchContext(ctx context.Context, txs []data.Transaction) ([]*websockets.SubmitResult, error) {
+	results := make([]*websockets.SubmitResult, len(txs))
+	var firstErr error
+	for i := range txs {
+		result, err := c.SubmitContext(ctx, txs[i])
+		switch {
+		case ctx.Err() != nil:
+			return results, ctx.Err()
+		case err != nil && firstErr == nil:
+			firstErr = err
+		}
+		results[i] = result
+	}
+	return results, firstErr
+}
+
+// Synchronously submit a multi-signed transaction
+func (c *Client) SubmitMultiSign(tx data.MultiSignTransaction) (*websockets.SubmitResult, error) {
+	return c.SubmitMultiSignContext(context.Background(), tx)
+}
+
+// SubmitMultiSignContext is SubmitMultiSign, giving up when ctx is done
+func (c *Client) SubmitMultiSignContext(ctx context.Context, tx data.MultiSignTransaction) (*websockets.SubmitResult, error) {
+	cmd := &websockets.SubmitMultiSignCommand{
+		Command: newCommand("submit_multisigned"),
+		TxBlob:  tx,
+	}
+	if err := c.call(ctx, cmd.Command, cmd); err != nil {
+		return nil, err
+	}
+	return cmd.Result, nil
+}
+
+// Synchronously gets ledger entries
+func (c *Client) LedgerData(ledger interface{}, marker *data.Hash256) (*websockets.LedgerDataResult, error) {
+	return c.LedgerDataContext(context.Background(), ledger, marker)
+}
+
+// LedgerDataContext is LedgerData, giving up when ctx is done
+func (c *Client) LedgerDataContext(ctx context.Context, ledger interface{}, marker *data.Hash256) (*websockets.LedgerDataResult, error) {
+	cmd := &websockets.LedgerDataCommand{
+		Command: newCommand("ledger_data"),
+		Ledger:  ledger,
+		Marker:  marker,
+	}
+	if err := c.call(ctx, cmd.Command, cmd); err != nil {
+		return nil, err
+	}
+	return cmd.Result, nil
+}
+
+func (c *Client) streamLedgerData(ctx context.Context, ledger interface{}, ch chan data.LedgerEntrySlice) {
+	defer close(ch)
+	var marker *data.Hash256
+	for {
+		cmd := &websockets.BinaryLedgerDataCommand{
+			Command: newCommand("ledger_data"),
+			Ledger:  ledger,
+			Binary:  true,
+			Marker:  marker,
+		}
+		if err := c.call(ctx, cmd.Command, cmd); err != nil {
+			glog.Errorln(err.Error())
+			return
+		}
+		les := make(data.LedgerEntrySlice, len(cmd.Result.State))
+		for i, state := range cmd.Result.State {
+			b, err := hex.DecodeString(state.Data + state.Index)
+			if err != nil {
+				glog.Errorln(err.Error())
+				return
+			}
+			les[i], err = data.ReadLedgerEntry(bytes.NewReader(b), data.Hash256{})
+			if err != nil {
+				// Keep it as it is rather than leave a gap
+				glog.Errorf("Keeping ledger entry %s unread: %s", state.Index, err)
+				if les[i], err = data.NewUnknowLedger(b); err != nil {
+					glog.Errorln(err.Error())
+					return
+				}
+			}
+		}
+		select {
+		case ch <- les:
+		case <-ctx.Done():
+			return
+		}
+		if cmd.Result.Marker == nil {
+			return
+		}
+		// Stay on the same ledger for every page
+		ledger, marker = cmd.Result.LedgerSequence, cmd.Result.Marker
+	}
+}
+
+// Asynchronously retrieve all data for a ledger using the binary form
+func (c *Client) StreamLedgerData(ledger interface{}) chan data.LedgerEntrySlice {
+	return c.StreamLedgerDataContext(context.Background(), ledger)
+}
+
+// StreamLedgerDataContext is StreamLedgerData, closing the channel early when ctx is done
+func (c *Client) StreamLedgerDataContext(ctx context.Context, ledger interface{}) chan data.LedgerEntrySlice {
+	ch := make(chan data.LedgerEntrySlice)
+	go c.streamLedgerData(ctx, ledger, ch)
+	return ch
+}
+
+// Synchronously gets a single ledger
+func (c *Client) Ledger(ledger interface{}, transactions bool) (*websockets.LedgerResult, error) {
+	return c.LedgerContext(context.Background(), ledger, transactions)
+}
+
+// LedgerContext is Ledger, giving up when ctx is done
+func (c *Client) LedgerContext(ctx context.Context, ledger interface{}, transactions bool) (*websockets.LedgerResult, error) {
+	cmd := &websockets.LedgerCommand{
+		Command:      newCommand("ledger"),
+		LedgerIndex:  ledger,
+		Transactions: transactions,
+		Expand:       true,
+	}
+	if err := c.call(ctx, cmd.Command, cmd); err != nil {
+		return nil, err
+	}
+	cmd.Result.Ledger.Transactions.Sort()
+	return cmd.Result, nil
+}
+
+// Synchronously gets a single ledger with only the hashes of its transactions
+func (c *Client) LedgerOnlyHash(ledger interface{}, transactions bool) (*websockets.LedgerResultOnlyHash, error) {
+	return c.LedgerOnlyHashContext(context.Background(), ledger, transactions)
+}
+
+// LedgerOnlyHashContext is LedgerOnlyHash, giving up when ctx is done
+func (c *Client) LedgerOnlyHashContext(ctx context.Context, ledger interface{}, transactions bool) (*websockets.LedgerResultOnlyHash, error) {
+	cmd := &websockets.LedgerCommandOnlyHash{
+		Command:      newCommand("ledger"),
+		LedgerIndex:  ledger,
+		Transactions: transactions,
+	}
+	if err := c.call(ctx, cmd.Command, cmd); err != nil {
+		return nil, err
+	}
+	return cmd.Result, nil
+}
+
+func (c *Client) LedgerHeader(ledger interface{}) (*websockets.LedgerHeaderResult, error) {
+	return c.LedgerHeaderContext(context.Background(), ledger)
+}
+
+// LedgerHeaderContext is LedgerHeader, giving up when ctx is done
+func (c *Client) LedgerHeaderContext(ctx context.Context, ledger interface{}) (*websockets.LedgerHeaderResult, error) {
+	cmd := &websockets.LedgerHeaderCommand{
+		Command: newCommand("ledger_header"),
+		Ledger:  ledger,
+	}
+	if err := c.call(ctx, cmd.Command, cmd); err != nil {
+		return nil, err
+	}
+	return cmd.Result, nil
+}
+
+// Synchronously requests paths
+func (c *Client) RipplePathFind(src, dest data.Account, amount data.Amount, srcCurr *[]data.Currency) (*websockets.RipplePathFindResult, error) {
+	return c.RipplePathFindContext(context.Background(), src, dest, amount, srcCurr)
+}
+
+// RipplePathFindContext is RipplePathFind, giving up when ctx is done
+func (c *Client) RipplePathFindContext(ctx context.Context, src, dest data.Account, amount data.Amount, srcCurr *[]data.Currency) (*websockets.RipplePathFindResult, error) {
+	cmd := &websockets.RipplePathFindCommand{
+		Command:       newCommand("ripple_path_find"),
+		SrcAccount:    src,
+		SrcCurrencies: srcCurr,
+		DestAccount:   dest,
+		DestAmount:    amount,
+	}
+	if err := c.call(ctx, cmd.Command, cmd); err != nil {
+		return nil, err
+	}
+	return cmd.Result, nil
+}
+
+// Synchronously requests account info
+func (c *Client) AccountInfo(a data.Account) (*websockets.AccountInfoResult, error) {
+	return c.AccountInfoContext(context.Background(), a)
+}
+
+// AccountInfoContext is AccountInfo, giving up when ctx is done
+func (c *Client) AccountInfoContext(ctx context.Context, a data.Account) (*websockets.AccountInfoResult, error) {
+	cmd := &websockets.AccountInfoCommand{
+		Command: newCommand("account_info"),
+		Account: a,
+	}
+	if err := c.call(ctx, cmd.Command, cmd); err != nil {
+		return nil, err
+	}
+	return cmd.Result, nil
+}
+
+// Synchronously requests account line info
+func (c *Client) AccountLines(account data.Account, ledgerIndex interface{}) (*websockets.AccountLinesResult, error) {
+	return c.AccountLinesContext(context.Background(), account, ledgerIndex)
+}
+
+// AccountLinesContext is AccountLines, giving up when ctx is done
+func (c *Client) AccountLinesContext(ctx context.Context, account data.Account, ledgerIndex interface{}) (*websockets.AccountLinesResult, error) {
+	var (
+		lines  data.AccountLineSlice
+		marker *data.Hash256
+	)
+	for {
+		cmd := &websockets.AccountLinesCommand{
+			Command:     newCommand("account_lines"),
+			Account:     account,
+			Limit:       400,
+			Marker:      marker,
+			LedgerIndex: ledgerIndex,
+		}
+		err := c.call(ctx, cmd.Command, cmd)
+		switch {
+		case err != nil:
+			return nil, err
+		case cmd.Result.Marker != nil:
+			lines = append(lines, cmd.Result.Lines...)
+			marker = cmd.Result.Marker
+			if cmd.Result.LedgerSequence != nil {
+				ledgerIndex = *cmd.Result.LedgerSequence
+			}
+		default:
+			cmd.Result.Lines = append(lines, cmd.Result.Lines...)
+			cmd.Result.Lines.SortByCurrencyAmount()
+			return cmd.Result, nil
+		}
+	}
+}
+
+// Synchronously requests account offers
+func (c *Client) AccountOffers(account data.Account, ledgerIndex interface{}) (*websockets.AccountOffersResult, error) {
+	return c.AccountOffersContext(context.Background(), account, ledgerIndex)
+}
+
+// AccountOffersContext is AccountOffers, giving up when ctx is done
+func (c *Client) AccountOffersContext(ctx context.Context, account data.Account, ledgerIndex interface{}) (*websockets.AccountOffersResult, error) {
+	var (
+		offers data.AccountOfferSlice
+		marker *data.Hash256
+	)
+	for {
+		cmd := &websockets.AccountOffersCommand{
+			Command:     newCommand("account_offers"),
+			Account:     account,
+			Limit:       400,
+			Marker:      marker,
+			LedgerIndex: ledgerIndex,
+		}
+		err := c.call(ctx, cmd.Command, cmd)
+		switch {
+		case err != nil:
+			return nil, err
+		case cmd.Result.Marker != nil:
+			offers = append(offers, cmd.Result.Offers...)
+			marker = cmd.Result.Marker
+			if cmd.Result.LedgerSequence != nil {
+				ledgerIndex = *cmd.Result.LedgerSequence
+			}
+		default:
+			cmd.Result.Offers = append(offers, cmd.Result.Offers...)
+			sort.Sort(cmd.Result.Offers)
+			return cmd.Result, nil
+		}
+	}
+}
+
+// AccountObjects requests the ledger entries owned by an account, of type
+// typ if it is not empty
+func (c *Client) AccountObjects(account data.Account, typ string, ledgerIndex interface{}) (*websockets.AccountObjectsResult, error) {
+	return c.AccountObjectsContext(context.Background(), account, typ, ledgerIndex)
+}
+
+// AccountObjectsContext is AccountObjects, giving up when ctx is done
+func (c *Client) AccountObjectsContext(ctx context.Context, account data.Account, typ string, ledgerIndex interface{}) (*websockets.AccountObjectsResult, error) {
+	return c.accountObjects(ctx, account, typ, false, ledgerIndex)
+}
+
+// AccountDeletionBlockers requests the ledger entries which stop an
+// account from being deleted
+func (c *Client) AccountDeletionBlockers(account data.Account, ledgerIndex interface{}) (*websockets.AccountObjectsResult, error) {
+	return c.AccountDeletionBlockersContext(context.Background(), account, ledgerIndex)
+}
+
+// AccountDeletionBlockersContext is AccountDeletionBlockers, giving up when
+// ctx is done
+func (c *Client) AccountDeletionBlockersContext(ctx context.Context, account data.Account, ledgerIndex interface{}) (*websockets.AccountObjectsResult, error) {
+	return c.accountObjects(ctx, account, "", true, ledgerIndex)
+}
+
+func (c *Client) accountObjects(ctx context.Context, account data.Account, typ string, blockersOnly bool, ledgerIndex interface{}) (*websockets.AccountObjectsResult, error) {
+	var (
+		objects data.LedgerEntrySlice
+		marker  interface{}
+	)
+	for {
+		cmd := &websockets.AccountObjectsCommand{
+			Command:              newCommand("account_objects"),
+			Account:              account,
+			Type:                 typ,
+			DeletionBlockersOnly: blockersOnly,
+			Limit:                400,
+			Marker:               marker,
+			LedgerIndex:          ledgerIndex,
+		}
+		err := c.call(ctx, cmd.Command, cmd)
+		switch {
+		case err != nil:
+			return nil, err
+		case cmd.Result.Marker != nil:
+			objects = append(objects, cmd.Result.AccountObjects...)
+			marker = cmd.Result.Marker
+			if cmd.Result.LedgerSequence != nil {
+				ledgerIndex = *cmd.Result.LedgerSequence
+			}
+		default:
+			cmd.Result.AccountObjects = append(objects, cmd.Result.AccountObjects...)
+			return cmd.Result, nil
+		}
+	}
+}
+
+// DepositAuthorized asks whether source may send to destination
+func (c *Client) DepositAuthorized(source, destination data.Account, ledgerIndex interface{}) (*websockets.DepositAuthorizedResult, error) {
+	return c.DepositAuthorizedContext(context.Background(), source, destination, ledgerIndex)
+}
+
+// DepositAuthorizedContext is DepositAuthorized, giving up when ctx is done
+func (c *Client) DepositAuthorizedContext(ctx context.Context, source, destination data.Account, ledgerIndex interface{}) (*websockets.DepositAuthorizedResult, error) {
+	cmd := &websockets.DepositAuthorizedCommand{
+		Command:            newCommand("deposit_authorized"),
+		SourceAccount:      source,
+		DestinationAccount: destination,
+		LedgerIndex:        ledgerIndex,
+	}
+	if err := c.call(ctx, cmd.Command, cmd); err != nil {
+		return nil, err
+	}
+	return cmd.Result, nil
+}
+
+// ServerDefinitions gets the server's codec definitions for
+// data.SetDefinitions
+func (c *Client) ServerDefinitions() (*data.Definitions, error) {
+	return c.ServerDefinitionsContext(context.Background())
+}
+
+// ServerDefinitionsContext is ServerDefinitions, giving up when ctx is done
+func (c *Client) ServerDefinitionsContext(ctx context.Context) (*data.Definitions, error) {
+	cmd := &websockets.ServerDefinitionsCommand{
+		Command: newCommand("server_definitions"),
+	}
+	if err := c.call(ctx, cmd.Command, cmd); err != nil {
+		return nil, err
+	}
+	return cmd.Result, nil
+}
+
+func (c *Client) BookOffers(taker data.Account, ledgerIndex interface{}, pays, gets data.Asset) (*websockets.BookOffersResult, error) {
+	return c.BookOffersContext(context.Background(), taker, ledgerIndex, pays, gets)
+}
+
+// BookOffersContext is BookOffers, giving up when ctx is done
+func (c *Client) BookOffersContext(ctx context.Context, taker data.Account, ledgerIndex interface{}, pays, gets data.Asset) (*websockets.BookOffersResult, error) {
+	cmd := &websockets.BookOffersCommand{
+		Command:     newCommand("book_offers"),
+		LedgerIndex: ledgerIndex,
+		Taker:       taker,
+		TakerPays:   pays,
+		TakerGets:   gets,
+		Limit:       5000,
+	}
+	if err := c.call(ctx, cmd.Command, cmd); err != nil {
+		return nil, err
+	}
+	return cmd.Result, nil
+}
+
+// AMMInfo requests the AMM for a pair of assets
+func (c *Client) AMMInfo(asset, asset2 data.Issue, ledgerIndex interface{}) (*websockets.AMMInfoResult, error) {
+	return c.AMMInfoContext(context.Background(), asset, asset2, ledgerIndex)
+}
+
+// AMMInfoContext is AMMInfo, giving up when ctx is done
+func (c *Client) AMMInfoContext(ctx context.Context, asset, asset2 data.Issue, ledgerIndex interface{}) (*websockets.AMMInfoResult, error) {
+	cmd := &websockets.AMMInfoCommand{
+		Command:     newCommand("amm_info"),
+		Asset:       &asset,
+		Asset2:      &asset2,
+		LedgerIndex: ledgerIndex,
+	}
+	if err := c.call(ctx, cmd.Command, cmd); err != nil {
+		return nil, err
+	}
+	return cmd.Result, nil
+}
+
+func (c *Client) Fee() (*websockets.FeeResult, error) {
+	return c.FeeContext(context.Background())
+}
+
+// FeeContext is Fee, giving up when ctx is done
+func (c *Client) FeeContext(ctx context.Context) (*websockets.FeeResult, error) {
+	cmd := &websockets.FeeCommand{
+		Command: newCommand("fee"),
+	}
+	if err := c.call(ctx, cmd.Command, cmd); err != nil {
+		return nil, err
+	}
+	return cmd.Result, nil
+}
+
+func (c *Client) ServerState() (*websockets.ServerStateResult, error) {
+	return c.ServerStateContext(context.Background())
+}
+
+// ServerStateContext is ServerState, giving up when ctx is done
+func (c *Client) ServerStateContext(ctx context.Context) (*websockets.ServerStateResult, error) {
+	cmd := &websockets.ServerStateCommand{
+		Command: newCommand("server_state"),
+	}
+	if err := c.call(ctx, cmd.Command, cmd); err != nil {
+		return nil, err
+	}
+	return cmd.Result, nil
+}
+
+func (c *Client) ServerInfo() (*websockets.ServerInfoResult, error) {
+	return c.ServerInfoContext(context.Background())
+}
+
+// ServerInfoContext is ServerInfo, giving up when ctx is done
+func (c *Client) ServerInfoContext(ctx context.Context) (*websockets.ServerInfoResult, error) {
+	cmd := &websockets.ServerInfoCommand{
+		Command: newCommand("server_info"),
+	}
+	if err := c.call(ctx, cmd.Command, cmd); err != nil {
+		return nil, err
+	}
+	return cmd.Result, nil
+}
@@ -0,0 +1,273 @@
+package xrpclient
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/goodwood511/ripple_lib/ripple-sdk/data"
+	"github.com/goodwood511/ripple_lib/ripple-sdk/websockets"
+)
+
+// A Payment from the xrpl.org submit example, as signed and serialized by rippled
+const testTxBlob = "1200002280000000240000000361D4838D7EA4C6800000000000000000000000000055534400000000004B4E9C06F24296074F7BC48F92A97916C6DC5EA968400000000000000A732103AB40A0490F9B7ED8DF29D246BF2D6269820A0EE7742ACDD457BEA7C7D0931EDB74473045022100D184EB4AE5956FF600E7536EE459345C7BBCF097A84CC61A93B9AF7197EDB98702201CEA8009B7BEEBAA2AACC0359B41C427C1C5B550A4CA4B80CF2174AF2D6D5DCE81144B4E9C06F24296074F7BC48F92A97916C6DC5EA983143E9D4A2B8AA0780F682D136F7A56D6724EF53754"
+
+const testTxHash = "82230B9D489370504B39BC2CE46216176CAC9E752E5C1774A8CBEC9FBB819208"
+
+// rpcServer answers each JSON-RPC request with the next of results, which
+// are the contents of the "result" field, and records the request bodies
+func rpcServer(t *testing.T, results ...string) (*Client, func() []string) {
+	var (
+		mu     sync.Mutex
+		bodies []string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		mu.Lock()
+		n := len(bodies)
+		bodies = append(bodies, string(b))
+		mu.Unlock()
+		if n >= len(results) {
+			t.Errorf("Unexpected request: %s", b)
+			http.Error(w, "unexpected", http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"result":%s}`, results[n])
+	}))
+	t.Cleanup(server.Close)
+	return NewClient(server.URL), func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]string(nil), bodies...)
+	}
+}
+
+// checkBodies compares request bodies to the expected JSON, ignoring
+// key order and whitespace
+func checkBodies(t *testing.T, bodies []string, expected ...string) {
+	t.Helper()
+	if len(bodies) != len(expected) {
+		t.Fatalf("Expected %d requests, got %d: %v", len(expected), len(bodies), bodies)
+	}
+	for i := range bodies {
+		var got, want interface{}
+		if err := json.Unmarshal([]byte(bodies[i]), &got); err != nil {
+			t.Fatal(err)
+		}
+		if err := json.Unmarshal([]byte(expected[i]), &want); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Request %d:\n got: %s\nwant: %s", i, bodies[i], expected[i])
+		}
+	}
+}
+
+func testAccount(t *testing.T, address string) data.Account {
+	account, err := data.NewAccountFromAddress(address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return *account
+}
+
+func TestCallAccountObjects(t *testing.T) {
+	c, bodies := rpcServer(t,
+		`{
+			"account": "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+			"account_objects": [{
+				"Account": "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+				"Flags": 0,
+				"LedgerEntryType": "Ticket",
+				"OwnerNode": "0",
+				"PreviousTxnID": "0AC7B3C3A8D22A0FA8E5A1B6E8F7A0E3B6C1D2E3F4A5B6C7D8E9F0A1B2C3D4E5",
+				"PreviousTxnLgrSeq": 90000000,
+				"TicketSequence": 11,
+				"index": "1BC5B4F1A3E2D0C9B8A7F6E5D4C3B2A1908F7E6D5C4B3A29180F7E6D5C4B3A29"
+			}],
+			"ledger_index": 90000010,
+			"limit": 1,
+			"marker": "F60ADF645E78B69857D2E4AEC8B7742FEABC8431BD8611D099B428C3E816DF93,0",
+			"validated": true,
+			"status": "success"
+		}`,
+		`{
+			"account": "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+			"account_objects": [],
+			"ledger_index": 90000010,
+			"validated": true,
+			"status": "success"
+		}`,
+	)
+	result, err := c.AccountObjects(testAccount(t, "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh"), "ticket", "validated")
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkBodies(t, bodies(),
+		`{"method":"account_objects","params":[{"account":"rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh","type":"ticket","limit":400,"ledger_index":"validated"}]}`,
+		`{"method":"account_objects","params":[{"account":"rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh","type":"ticket","limit":400,"ledger_index":90000010,"marker":"F60ADF645E78B69857D2E4AEC8B7742FEABC8431BD8611D099B428C3E816DF93,0"}]}`,
+	)
+	if len(result.AccountObjects) != 1 {
+		t.Fatalf("Expected 1 object, got %d", len(result.AccountObjects))
+	}
+	ticket, ok := result.AccountObjects[0].(*data.Ticket)
+	if !ok || ticket.TicketSequence == nil || *ticket.TicketSequence != 11 {
+		t.Errorf("Wrong ticket: %+v", result.AccountObjects[0])
+	}
+}
+
+func TestCallLedgerData(t *testing.T) {
+	c, bodies := rpcServer(t, `{
+		"ledger_hash": "4C99E5F63C0D0B1C2B7B2D7D6A8F1E3B2A9C8D7E6F5A4B3C2D1E0F9A8B7C6D5E",
+		"ledger_index": 90000010,
+		"marker": "0000041EFD027808D3F78C8352F97E324CB816318E00B977C74ECDDC7CD975B2",
+		"state": [],
+		"validated": true,
+		"status": "success"
+	}`)
+	marker, err := data.NewHash256("000003DD7DD9B37FC6ACB7C4A4E0C0A7F9D4F0B0B2A1C9D8E7F6A5B4C3D2E1F0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	result, err := c.LedgerData("validated", marker)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkBodies(t, bodies(),
+		`{"method":"ledger_data","params":[{"ledger":"validated","marker":"000003DD7DD9B37FC6ACB7C4A4E0C0A7F9D4F0B0B2A1C9D8E7F6A5B4C3D2E1F0"}]}`,
+	)
+	if result.LedgerSequence != 90000010 || result.Marker == nil || result.Marker.String() != "0000041EFD027808D3F78C8352F97E324CB816318E00B977C74ECDDC7CD975B2" {
+		t.Errorf("Wrong result: %+v", result)
+	}
+}
+
+func TestCallSubmit(t *testing.T) {
+	c, bodies := rpcServer(t, fmt.Sprintf(`{
+		"engine_result": "tesSUCCESS",
+		"engine_result_code": 0,
+		"engine_result_message": "The transaction was applied. Only final in a validated ledger.",
+		"tx_blob": %q,
+		"status": "success"
+	}`, testTxBlob))
+	blob, err := hex.DecodeString(testTxBlob)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx, err := data.ReadTransaction(bytes.NewReader(blob))
+	if err != nil {
+		t.Fatal(err)
+	}
+	result, err := c.Submit(tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkBodies(t, bodies(), fmt.Sprintf(`{"method":"submit","params":[{"tx_blob":%q}]}`, testTxBlob))
+	if !result.EngineResult.Success() || result.TxBlob != testTxBlob {
+		t.Errorf("Wrong result: %+v", result)
+	}
+}
+
+func TestCallError(t *testing.T) {
+	c, bodies := rpcServer(t, `{
+		"account": "rKp7KgcYjdEQepQLc27ZHz76ukLwE4S1CN",
+		"error": "actNotFound",
+		"error_code": 19,
+		"error_message": "Account not found.",
+		"ledger_index": 8629213,
+		"request": {
+			"account": "rKp7KgcYjdEQepQLc27ZHz76ukLwE4S1CN",
+			"command": "account_info",
+			"ledger_index": "validated"
+		},
+		"status": "error",
+		"validated": true
+	}`)
+	_, err := c.AccountInfo(testAccount(t, "rKp7KgcYjdEQepQLc27ZHz76ukLwE4S1CN"))
+	cmdErr, ok := err.(*websockets.CommandError)
+	if !ok {
+		t.Fatalf("Expected a CommandError, got %v", err)
+	}
+	if cmdErr.Name != "actNotFound" || cmdErr.Code != 19 || cmdErr.Message != "Account not found." {
+		t.Errorf("Wrong error: %+v", cmdErr)
+	}
+	checkBodies(t, bodies(), `{"method":"account_info","params":[{"account":"rKp7KgcYjdEQepQLc27ZHz76ukLwE4S1CN"}]}`)
+
+	// An error about the request says nothing about the endpoint
+	if h := c.Health()[0]; !h.Healthy {
+		t.Errorf("Endpoint marked unhealthy by a request error: %+v", h)
+	}
+}
+
+func TestCallTx(t *testing.T) {
+	blob, err := hex.DecodeString(testTxBlob)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx, err := data.ReadTransaction(bytes.NewReader(blob))
+	if err != nil {
+		t.Fatal(err)
+	}
+	txJSON, err := json.Marshal(tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// rippled returns the transaction's fields alongside these
+	var result map[string]interface{}
+	if err := json.Unmarshal(txJSON, &result); err != nil {
+		t.Fatal(err)
+	}
+	result["hash"] = testTxHash
+	result["ledger_index"] = 56865245
+	result["date"] = 648248020
+	result["validated"] = true
+	result["status"] = "success"
+	result["meta"] = map[string]interface{}{
+		"AffectedNodes":     []interface{}{},
+		"TransactionIndex":  4,
+		"TransactionResult": "tesSUCCESS",
+	}
+	response, err := json.Marshal(result)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c, bodies := rpcServer(t, string(response))
+	hash, err := data.NewHash256(testTxHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	txm, err := c.Tx(*hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkBodies(t, bodies(), fmt.Sprintf(`{"method":"tx","params":[{"transaction":%q}]}`, testTxHash))
+
+	if txm.GetType() != "Payment" || !txm.Validated || txm.LedgerSequence != 56865245 {
+		t.Errorf("Wrong transaction: %+v", txm)
+	}
+	if !txm.MetaData.TransactionResult.Success() || txm.MetaData.TransactionIndex != 4 {
+		t.Errorf("Wrong metadata: %+v", txm.MetaData)
+	}
+	if txm.GetHash().String() != testTxHash {
+		t.Errorf("Wrong hash: %s", txm.GetHash())
+	}
+	_, raw, err := data.Raw(txm.Transaction)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprintf("%X", raw) != testTxBlob {
+		t.Errorf("Decoded transaction does not reserialize:\n%X", raw)
+	}
+}
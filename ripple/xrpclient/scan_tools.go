@@ -13,7 +13,7 @@ func (c *Client) GetLatestLedgerIndex() (uint64, error) {
 			LedgerIndex uint64 `json:"ledger_current_index"`
 		} `json:"result"`
 	}
-	_, err := c.client.R().SetBody(req).SetResult(&res).Post(c.rpcURL)
+	err := c.post(req, &res)
 	if err != nil {
 		return 0, err
 	}
@@ -32,7 +32,7 @@ func (c *Client) GetLedgerTransactions(ledgerIndex uint64) ([]Transaction, time.
 	}
 	res := RspTransaction{}
 	//c.client.SetDebug(true)
-	_, err := c.client.R().SetBody(req).SetResult(&res).Post(c.rpcURL)
+	err := c.post(req, &res)
 	if err != nil {
 		return nil, time.Time{}, "", err
 	}
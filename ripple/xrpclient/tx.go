@@ -15,7 +15,7 @@ func (c *Client) GetTransaction(hash string) (map[string]interface{}, error) {
 		},
 	}
 	var res txResponse
-	_, err := c.client.R().SetBody(req).SetResult(&res).Post(c.rpcURL)
+	err := c.post(req, &res)
 	if err != nil {
 		return nil, err
 	}
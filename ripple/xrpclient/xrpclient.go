@@ -2,21 +2,53 @@ package xrpclient
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/go-resty/resty/v2"
 	"github.com/shopspring/decimal"
 )
 
+// DefaultTimeout bounds each HTTP request of a Client, so that a stalled
+// endpoint does not block its callers forever
+const DefaultTimeout = 30 * time.Second
+
 type Client struct {
-	rpcURL string
 	client *resty.Client
+	pool   *endpointPool
 }
 
 func NewClient(rpcURL string) *Client {
 	return &Client{
-		rpcURL: rpcURL,
-		client: resty.New(),
+		client: resty.New().SetTimeout(DefaultTimeout),
+		pool:   newEndpointPool([]string{rpcURL}),
+	}
+}
+
+// NewFailoverClient returns a Client which health checks every rpcURL with
+// server_info, sends each request to the healthiest and fails over to the
+// next when one errors or falls behind. Call Close to stop health checking.
+func NewFailoverClient(rpcURLs []string) (*Client, error) {
+	if len(rpcURLs) == 0 {
+		return nil, fmt.Errorf("no endpoints")
+	}
+	c := &Client{
+		client: resty.New().SetTimeout(DefaultTimeout),
+		pool:   newEndpointPool(rpcURLs),
 	}
+	c.checkHealth()
+	go c.run()
+	return c, nil
+}
+
+// SetTimeout replaces DefaultTimeout as the limit of each HTTP request
+func (c *Client) SetTimeout(timeout time.Duration) *Client {
+	c.client.SetTimeout(timeout)
+	return c
+}
+
+// Close stops health checking. It is safe to call on any Client.
+func (c *Client) Close() {
+	c.pool.close()
 }
 
 type accountInfoResponse struct {
@@ -62,7 +94,7 @@ func (c *Client) GetBalance(address string) (decimal.Decimal, error) {
 		},
 	}
 	var res accountInfoResponse
-	_, err := c.client.R().SetBody(req).SetResult(&res).Post(c.rpcURL)
+	err := c.post(req, &res)
 	if err != nil {
 		return decimal.Zero, err
 	}
@@ -100,7 +132,7 @@ func (c *Client) GetTokenBalances(address string) (map[string]float64, error) {
 		},
 	}
 	var res accountLinesResponse
-	_, err := c.client.R().SetBody(req).SetResult(&res).Post(c.rpcURL)
+	err := c.post(req, &res)
 	if err != nil {
 		return nil, err
 	}